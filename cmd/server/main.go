@@ -8,7 +8,13 @@ import (
 )
 
 func main() {
-	if err := cmd.RunServer(); err != nil {
+	run := cmd.RunServer
+	// "migrate" applies pending migrations and exits
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		run = cmd.RunMigrate
+	}
+
+	if err := run(); err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
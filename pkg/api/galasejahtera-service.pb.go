@@ -8,8 +8,10 @@ import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	empty "github.com/golang/protobuf/ptypes/empty"
+	_struct "github.com/golang/protobuf/ptypes/struct"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -290,8 +292,16 @@ func (m *Covid) GetNewsUrl() string {
 
 // district payload
 type District struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Total                int64    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Total int64  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// active cases within 14 days
+	Active int64 `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	// trend of active cases: up, down, flat, unknown if active cases of place or of previous day are unknown
+	Trend string `protobuf:"bytes,4,opt,name=trend,proto3" json:"trend,omitempty"`
+	// risk colour: green, yellow, red, unknown without snapshot of 14 days before or if the district is not in latest daily
+	Risk string `protobuf:"bytes,5,opt,name=risk,proto3" json:"risk,omitempty"`
+	// state name, same as name if the place is a state
+	State                string   `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -336,13 +346,129 @@ func (m *District) GetTotal() int64 {
 	return 0
 }
 
+func (m *District) GetActive() int64 {
+	if m != nil {
+		return m.Active
+	}
+	return 0
+}
+
+func (m *District) GetTrend() string {
+	if m != nil {
+		return m.Trend
+	}
+	return ""
+}
+
+func (m *District) GetRisk() string {
+	if m != nil {
+		return m.Risk
+	}
+	return ""
+}
+
+func (m *District) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+// state payload
+type State struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Total int64  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// active cases within 14 days
+	Active int64 `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	// trend of active cases: up, down, flat, unknown if active cases of place or of previous day are unknown
+	Trend string `protobuf:"bytes,4,opt,name=trend,proto3" json:"trend,omitempty"`
+	// risk colour: green, yellow, red, unknown without snapshot of 14 days before
+	Risk string `protobuf:"bytes,5,opt,name=risk,proto3" json:"risk,omitempty"`
+	// districts payload
+	Districts            []*District `protobuf:"bytes,6,rep,name=districts,proto3" json:"districts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *State) Reset()         { *m = State{} }
+func (m *State) String() string { return proto.CompactTextString(m) }
+func (*State) ProtoMessage()    {}
+func (*State) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{5}
+}
+
+func (m *State) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_State.Unmarshal(m, b)
+}
+func (m *State) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_State.Marshal(b, m, deterministic)
+}
+func (m *State) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_State.Merge(m, src)
+}
+func (m *State) XXX_Size() int {
+	return xxx_messageInfo_State.Size(m)
+}
+func (m *State) XXX_DiscardUnknown() {
+	xxx_messageInfo_State.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_State proto.InternalMessageInfo
+
+func (m *State) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *State) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *State) GetActive() int64 {
+	if m != nil {
+		return m.Active
+	}
+	return 0
+}
+
+func (m *State) GetTrend() string {
+	if m != nil {
+		return m.Trend
+	}
+	return ""
+}
+
+func (m *State) GetRisk() string {
+	if m != nil {
+		return m.Risk
+	}
+	return ""
+}
+
+func (m *State) GetDistricts() []*District {
+	if m != nil {
+		return m.Districts
+	}
+	return nil
+}
+
 // report payload
 type Report struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId               string   `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
-	CreatedAt            int64    `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	HasSymptom           bool     `protobuf:"varint,4,opt,name=hasSymptom,proto3" json:"hasSymptom,omitempty"`
-	Results              []bool   `protobuf:"varint,5,rep,packed,name=results,proto3" json:"results,omitempty"`
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId     string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	HasSymptom bool   `protobuf:"varint,4,opt,name=hasSymptom,proto3" json:"hasSymptom,omitempty"`
+	Results    []bool `protobuf:"varint,5,rep,packed,name=results,proto3" json:"results,omitempty"`
+	// version, incremented on every update, update is rejected unless it matches if set
+	Version int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// soft deletion time, 0 unless deleted, deleted reports are only returned by data export
+	DeletedAt            int64    `protobuf:"varint,7,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -352,7 +478,7 @@ func (m *Report) Reset()         { *m = Report{} }
 func (m *Report) String() string { return proto.CompactTextString(m) }
 func (*Report) ProtoMessage()    {}
 func (*Report) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{5}
+	return fileDescriptor_fe7d991659ed015b, []int{6}
 }
 
 func (m *Report) XXX_Unmarshal(b []byte) error {
@@ -408,6 +534,20 @@ func (m *Report) GetResults() []bool {
 	return nil
 }
 
+func (m *Report) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Report) GetDeletedAt() int64 {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return 0
+}
+
 // user payload
 type User struct {
 	// user id
@@ -425,9 +565,13 @@ type User struct {
 	// longitude
 	Long float64 `protobuf:"fixed64,10,opt,name=long,proto3" json:"long,omitempty"`
 	// time
-	Time                 int64    `protobuf:"varint,12,opt,name=time,proto3" json:"time,omitempty"`
-	IsActive             bool     `protobuf:"varint,13,opt,name=isActive,proto3" json:"isActive,omitempty"`
-	Name                 string   `protobuf:"bytes,14,opt,name=name,proto3" json:"name,omitempty"`
+	Time     int64  `protobuf:"varint,12,opt,name=time,proto3" json:"time,omitempty"`
+	IsActive bool   `protobuf:"varint,13,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	Name     string `protobuf:"bytes,14,opt,name=name,proto3" json:"name,omitempty"`
+	// distance in meter from requesting user, only set in nearby users
+	Distance float64 `protobuf:"fixed64,15,opt,name=distance,proto3" json:"distance,omitempty"`
+	// version, incremented on every update, update is rejected unless it matches if set
+	Version              int64    `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -437,7 +581,7 @@ func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{6}
+	return fileDescriptor_fe7d991659ed015b, []int{7}
 }
 
 func (m *User) XXX_Unmarshal(b []byte) error {
@@ -528,6 +672,20 @@ func (m *User) GetName() string {
 	return ""
 }
 
+func (m *User) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *User) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 // get password reset request payload
 type GetPasswordResetRequest struct {
 	// user id
@@ -543,7 +701,7 @@ func (m *GetPasswordResetRequest) Reset()         { *m = GetPasswordResetRequest
 func (m *GetPasswordResetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPasswordResetRequest) ProtoMessage()    {}
 func (*GetPasswordResetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{7}
+	return fileDescriptor_fe7d991659ed015b, []int{8}
 }
 
 func (m *GetPasswordResetRequest) XXX_Unmarshal(b []byte) error {
@@ -593,7 +751,7 @@ func (m *UpdatePasswordRequest) Reset()         { *m = UpdatePasswordRequest{} }
 func (m *UpdatePasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdatePasswordRequest) ProtoMessage()    {}
 func (*UpdatePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{8}
+	return fileDescriptor_fe7d991659ed015b, []int{9}
 }
 
 func (m *UpdatePasswordRequest) XXX_Unmarshal(b []byte) error {
@@ -641,7 +799,7 @@ func (m *GetPasswordResetResponse) Reset()         { *m = GetPasswordResetRespon
 func (m *GetPasswordResetResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPasswordResetResponse) ProtoMessage()    {}
 func (*GetPasswordResetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{9}
+	return fileDescriptor_fe7d991659ed015b, []int{10}
 }
 
 func (m *GetPasswordResetResponse) XXX_Unmarshal(b []byte) error {
@@ -684,7 +842,18 @@ type GetUsersRequest struct {
 	// value to filter
 	FilterValue string `protobuf:"bytes,6,opt,name=filterValue,proto3" json:"filterValue,omitempty"`
 	// user ids
-	Ids                  []string `protobuf:"bytes,7,rep,name=ids,proto3" json:"ids,omitempty"`
+	Ids []string `protobuf:"bytes,7,rep,name=ids,proto3" json:"ids,omitempty"`
+	// filters combined with and, as "field:operator:value" where operator is one of eq, ne, in, gt, gte, lt, lte, range and prefix,
+	// e.g. "hasSymptom:eq:true" or "createdAt:range:1588291200000,1588377600000", values of in and range are comma separated
+	Filters []string `protobuf:"bytes,8,rep,name=filters,proto3" json:"filters,omitempty"`
+	// sorts applied in order, as "field:order" where order is ASC or DESC, e.g. "createdAt:DESC"
+	Sorts []string `protobuf:"bytes,9,rep,name=sorts,proto3" json:"sorts,omitempty"`
+	// maximum number of users per page, pagination by page token is used instead of from and to if set
+	PageSize int64 `protobuf:"varint,10,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// nextPageToken of previous page, empty for first page, filters and sorts must not change between pages
+	PageToken string `protobuf:"bytes,11,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	// count total users, total is only set if true when paginating by page token
+	IncludeTotal         bool     `protobuf:"varint,12,opt,name=includeTotal,proto3" json:"includeTotal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -694,7 +863,7 @@ func (m *GetUsersRequest) Reset()         { *m = GetUsersRequest{} }
 func (m *GetUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetUsersRequest) ProtoMessage()    {}
 func (*GetUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{10}
+	return fileDescriptor_fe7d991659ed015b, []int{11}
 }
 
 func (m *GetUsersRequest) XXX_Unmarshal(b []byte) error {
@@ -764,6 +933,41 @@ func (m *GetUsersRequest) GetIds() []string {
 	return nil
 }
 
+func (m *GetUsersRequest) GetFilters() []string {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *GetUsersRequest) GetSorts() []string {
+	if m != nil {
+		return m.Sorts
+	}
+	return nil
+}
+
+func (m *GetUsersRequest) GetPageSize() int64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetUsersRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *GetUsersRequest) GetIncludeTotal() bool {
+	if m != nil {
+		return m.IncludeTotal
+	}
+	return false
+}
+
 // get user request payload
 type GetUserRequest struct {
 	// user id
@@ -777,7 +981,7 @@ func (m *GetUserRequest) Reset()         { *m = GetUserRequest{} }
 func (m *GetUserRequest) String() string { return proto.CompactTextString(m) }
 func (*GetUserRequest) ProtoMessage()    {}
 func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{11}
+	return fileDescriptor_fe7d991659ed015b, []int{12}
 }
 
 func (m *GetUserRequest) XXX_Unmarshal(b []byte) error {
@@ -820,7 +1024,7 @@ func (m *CreateUserRequest) Reset()         { *m = CreateUserRequest{} }
 func (m *CreateUserRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateUserRequest) ProtoMessage()    {}
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{12}
+	return fileDescriptor_fe7d991659ed015b, []int{13}
 }
 
 func (m *CreateUserRequest) XXX_Unmarshal(b []byte) error {
@@ -860,17 +1064,19 @@ type UpdateUserRequest struct {
 	// user id
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// user payload
-	Data                 *User    `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Data *User `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// fields of payload to update, all updatable fields if empty, set from body fields on PATCH
+	UpdateMask           *field_mask.FieldMask `protobuf:"bytes,3,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *UpdateUserRequest) Reset()         { *m = UpdateUserRequest{} }
 func (m *UpdateUserRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateUserRequest) ProtoMessage()    {}
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{13}
+	return fileDescriptor_fe7d991659ed015b, []int{14}
 }
 
 func (m *UpdateUserRequest) XXX_Unmarshal(b []byte) error {
@@ -905,22 +1111,33 @@ func (m *UpdateUserRequest) GetData() *User {
 	return nil
 }
 
+func (m *UpdateUserRequest) GetUpdateMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
 // update users request payload
 type UpdateUsersRequest struct {
 	// user ids
 	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 	// user payload
-	Data                 *User    `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Data *User `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// fields of payload to update, all updatable fields but email if empty, email can only be updated for a single user
+	UpdateMask *field_mask.FieldMask `protobuf:"bytes,3,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+	// version of user expected by client by user id, users not listed are updated at any version
+	Versions             map[string]int64 `protobuf:"bytes,4,rep,name=versions,proto3" json:"versions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *UpdateUsersRequest) Reset()         { *m = UpdateUsersRequest{} }
 func (m *UpdateUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateUsersRequest) ProtoMessage()    {}
 func (*UpdateUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{14}
+	return fileDescriptor_fe7d991659ed015b, []int{15}
 }
 
 func (m *UpdateUsersRequest) XXX_Unmarshal(b []byte) error {
@@ -955,6 +1172,20 @@ func (m *UpdateUsersRequest) GetData() *User {
 	return nil
 }
 
+func (m *UpdateUsersRequest) GetUpdateMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+func (m *UpdateUsersRequest) GetVersions() map[string]int64 {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
 // delete user request payload
 type DeleteUserRequest struct {
 	// user id
@@ -968,7 +1199,7 @@ func (m *DeleteUserRequest) Reset()         { *m = DeleteUserRequest{} }
 func (m *DeleteUserRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteUserRequest) ProtoMessage()    {}
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{15}
+	return fileDescriptor_fe7d991659ed015b, []int{16}
 }
 
 func (m *DeleteUserRequest) XXX_Unmarshal(b []byte) error {
@@ -1009,7 +1240,7 @@ func (m *DeleteUsersRequest) Reset()         { *m = DeleteUsersRequest{} }
 func (m *DeleteUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteUsersRequest) ProtoMessage()    {}
 func (*DeleteUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{16}
+	return fileDescriptor_fe7d991659ed015b, []int{17}
 }
 
 func (m *DeleteUsersRequest) XXX_Unmarshal(b []byte) error {
@@ -1050,7 +1281,7 @@ func (m *DeleteUserResponse) Reset()         { *m = DeleteUserResponse{} }
 func (m *DeleteUserResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteUserResponse) ProtoMessage()    {}
 func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{17}
+	return fileDescriptor_fe7d991659ed015b, []int{18}
 }
 
 func (m *DeleteUserResponse) XXX_Unmarshal(b []byte) error {
@@ -1083,7 +1314,9 @@ type GetUsersResponse struct {
 	// users payload
 	Data []*User `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	// total users
-	Total                int64    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Total int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// token of next page, empty if this is the last page
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1093,7 +1326,7 @@ func (m *GetUsersResponse) Reset()         { *m = GetUsersResponse{} }
 func (m *GetUsersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetUsersResponse) ProtoMessage()    {}
 func (*GetUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{18}
+	return fileDescriptor_fe7d991659ed015b, []int{19}
 }
 
 func (m *GetUsersResponse) XXX_Unmarshal(b []byte) error {
@@ -1128,6 +1361,13 @@ func (m *GetUsersResponse) GetTotal() int64 {
 	return 0
 }
 
+func (m *GetUsersResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 // get user response payload
 type GetUserResponse struct {
 	// user payload
@@ -1141,7 +1381,7 @@ func (m *GetUserResponse) Reset()         { *m = GetUserResponse{} }
 func (m *GetUserResponse) String() string { return proto.CompactTextString(m) }
 func (*GetUserResponse) ProtoMessage()    {}
 func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{19}
+	return fileDescriptor_fe7d991659ed015b, []int{20}
 }
 
 func (m *GetUserResponse) XXX_Unmarshal(b []byte) error {
@@ -1182,7 +1422,7 @@ func (m *CreateUserResponse) Reset()         { *m = CreateUserResponse{} }
 func (m *CreateUserResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateUserResponse) ProtoMessage()    {}
 func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{20}
+	return fileDescriptor_fe7d991659ed015b, []int{21}
 }
 
 func (m *CreateUserResponse) XXX_Unmarshal(b []byte) error {
@@ -1223,7 +1463,7 @@ func (m *UpdateUserResponse) Reset()         { *m = UpdateUserResponse{} }
 func (m *UpdateUserResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateUserResponse) ProtoMessage()    {}
 func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{21}
+	return fileDescriptor_fe7d991659ed015b, []int{22}
 }
 
 func (m *UpdateUserResponse) XXX_Unmarshal(b []byte) error {
@@ -1251,20 +1491,122 @@ func (m *UpdateUserResponse) GetData() *User {
 	return nil
 }
 
-// update users response payload
-type UpdateUsersResponse struct {
-	// user ids
-	Data                 []string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+// status detail of update rejected as resource has been modified since expected version
+type VersionConflict struct {
+	// current version
+	CurrentVersion       int64    `protobuf:"varint,1,opt,name=currentVersion,proto3" json:"currentVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VersionConflict) Reset()         { *m = VersionConflict{} }
+func (m *VersionConflict) String() string { return proto.CompactTextString(m) }
+func (*VersionConflict) ProtoMessage()    {}
+func (*VersionConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{23}
+}
+
+func (m *VersionConflict) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionConflict.Unmarshal(m, b)
+}
+func (m *VersionConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionConflict.Marshal(b, m, deterministic)
+}
+func (m *VersionConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionConflict.Merge(m, src)
+}
+func (m *VersionConflict) XXX_Size() int {
+	return xxx_messageInfo_VersionConflict.Size(m)
+}
+func (m *VersionConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionConflict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionConflict proto.InternalMessageInfo
+
+func (m *VersionConflict) GetCurrentVersion() int64 {
+	if m != nil {
+		return m.CurrentVersion
+	}
+	return 0
+}
+
+// result of batch operation on one id
+type BatchResult struct {
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// gRPC status code, 0 if succeeded
+	Code int32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// error message, empty if succeeded
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *BatchResult) Reset()         { *m = BatchResult{} }
+func (m *BatchResult) String() string { return proto.CompactTextString(m) }
+func (*BatchResult) ProtoMessage()    {}
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{24}
+}
+
+func (m *BatchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchResult.Unmarshal(m, b)
+}
+func (m *BatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchResult.Marshal(b, m, deterministic)
+}
+func (m *BatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResult.Merge(m, src)
+}
+func (m *BatchResult) XXX_Size() int {
+	return xxx_messageInfo_BatchResult.Size(m)
+}
+func (m *BatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResult proto.InternalMessageInfo
+
+func (m *BatchResult) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BatchResult) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *BatchResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// update users response payload
+type UpdateUsersResponse struct {
+	// user ids succeeded
+	Data []string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// result per user id
+	Results              []*BatchResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
 func (m *UpdateUsersResponse) Reset()         { *m = UpdateUsersResponse{} }
 func (m *UpdateUsersResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateUsersResponse) ProtoMessage()    {}
 func (*UpdateUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{22}
+	return fileDescriptor_fe7d991659ed015b, []int{25}
 }
 
 func (m *UpdateUsersResponse) XXX_Unmarshal(b []byte) error {
@@ -1292,20 +1634,29 @@ func (m *UpdateUsersResponse) GetData() []string {
 	return nil
 }
 
+func (m *UpdateUsersResponse) GetResults() []*BatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 // delete users response payload
 type DeleteUsersResponse struct {
-	// user ids
-	Data                 []string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// user ids succeeded
+	Data []string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// result per user id
+	Results              []*BatchResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *DeleteUsersResponse) Reset()         { *m = DeleteUsersResponse{} }
 func (m *DeleteUsersResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteUsersResponse) ProtoMessage()    {}
 func (*DeleteUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{23}
+	return fileDescriptor_fe7d991659ed015b, []int{26}
 }
 
 func (m *DeleteUsersResponse) XXX_Unmarshal(b []byte) error {
@@ -1333,1257 +1684,2954 @@ func (m *DeleteUsersResponse) GetData() []string {
 	return nil
 }
 
-// get nearby users request payload
-type GetNearbyUsersRequest struct {
-	// user
-	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+func (m *DeleteUsersResponse) GetResults() []*BatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// restore user request payload
+type RestoreUserRequest struct {
+	// user id
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetNearbyUsersRequest) Reset()         { *m = GetNearbyUsersRequest{} }
-func (m *GetNearbyUsersRequest) String() string { return proto.CompactTextString(m) }
-func (*GetNearbyUsersRequest) ProtoMessage()    {}
-func (*GetNearbyUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{24}
+func (m *RestoreUserRequest) Reset()         { *m = RestoreUserRequest{} }
+func (m *RestoreUserRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreUserRequest) ProtoMessage()    {}
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{27}
 }
 
-func (m *GetNearbyUsersRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetNearbyUsersRequest.Unmarshal(m, b)
+func (m *RestoreUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreUserRequest.Unmarshal(m, b)
 }
-func (m *GetNearbyUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetNearbyUsersRequest.Marshal(b, m, deterministic)
+func (m *RestoreUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreUserRequest.Marshal(b, m, deterministic)
 }
-func (m *GetNearbyUsersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetNearbyUsersRequest.Merge(m, src)
+func (m *RestoreUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreUserRequest.Merge(m, src)
 }
-func (m *GetNearbyUsersRequest) XXX_Size() int {
-	return xxx_messageInfo_GetNearbyUsersRequest.Size(m)
+func (m *RestoreUserRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreUserRequest.Size(m)
 }
-func (m *GetNearbyUsersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetNearbyUsersRequest.DiscardUnknown(m)
+func (m *RestoreUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreUserRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetNearbyUsersRequest proto.InternalMessageInfo
+var xxx_messageInfo_RestoreUserRequest proto.InternalMessageInfo
 
-func (m *GetNearbyUsersRequest) GetUser() *User {
+func (m *RestoreUserRequest) GetId() string {
 	if m != nil {
-		return m.User
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-// get nearby users response payload
-type GetNearbyUsersResponse struct {
-	// list of users
-	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	// number of nearby users
-	UserNum              int64    `protobuf:"varint,2,opt,name=userNum,proto3" json:"userNum,omitempty"`
+// restore user response payload
+type RestoreUserResponse struct {
+	// user payload
+	Data                 *User    `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetNearbyUsersResponse) Reset()         { *m = GetNearbyUsersResponse{} }
-func (m *GetNearbyUsersResponse) String() string { return proto.CompactTextString(m) }
-func (*GetNearbyUsersResponse) ProtoMessage()    {}
-func (*GetNearbyUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{25}
+func (m *RestoreUserResponse) Reset()         { *m = RestoreUserResponse{} }
+func (m *RestoreUserResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreUserResponse) ProtoMessage()    {}
+func (*RestoreUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{28}
 }
 
-func (m *GetNearbyUsersResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetNearbyUsersResponse.Unmarshal(m, b)
+func (m *RestoreUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreUserResponse.Unmarshal(m, b)
 }
-func (m *GetNearbyUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetNearbyUsersResponse.Marshal(b, m, deterministic)
+func (m *RestoreUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreUserResponse.Marshal(b, m, deterministic)
 }
-func (m *GetNearbyUsersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetNearbyUsersResponse.Merge(m, src)
+func (m *RestoreUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreUserResponse.Merge(m, src)
 }
-func (m *GetNearbyUsersResponse) XXX_Size() int {
-	return xxx_messageInfo_GetNearbyUsersResponse.Size(m)
+func (m *RestoreUserResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreUserResponse.Size(m)
 }
-func (m *GetNearbyUsersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetNearbyUsersResponse.DiscardUnknown(m)
+func (m *RestoreUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreUserResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetNearbyUsersResponse proto.InternalMessageInfo
+var xxx_messageInfo_RestoreUserResponse proto.InternalMessageInfo
 
-func (m *GetNearbyUsersResponse) GetUsers() []*User {
+func (m *RestoreUserResponse) GetData() *User {
 	if m != nil {
-		return m.Users
+		return m.Data
 	}
 	return nil
 }
 
-func (m *GetNearbyUsersResponse) GetUserNum() int64 {
-	if m != nil {
-		return m.UserNum
-	}
-	return 0
-}
-
-type General struct {
-	TotalConfirmed       int64    `protobuf:"varint,1,opt,name=totalConfirmed,proto3" json:"totalConfirmed,omitempty"`
-	ActiveCases          int64    `protobuf:"varint,2,opt,name=activeCases,proto3" json:"activeCases,omitempty"`
+// export my data request payload
+type ExportMyDataRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *General) Reset()         { *m = General{} }
-func (m *General) String() string { return proto.CompactTextString(m) }
-func (*General) ProtoMessage()    {}
-func (*General) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{26}
+func (m *ExportMyDataRequest) Reset()         { *m = ExportMyDataRequest{} }
+func (m *ExportMyDataRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportMyDataRequest) ProtoMessage()    {}
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{29}
 }
 
-func (m *General) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_General.Unmarshal(m, b)
-}
-func (m *General) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_General.Marshal(b, m, deterministic)
+func (m *ExportMyDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportMyDataRequest.Unmarshal(m, b)
 }
-func (m *General) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_General.Merge(m, src)
+func (m *ExportMyDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportMyDataRequest.Marshal(b, m, deterministic)
 }
-func (m *General) XXX_Size() int {
-	return xxx_messageInfo_General.Size(m)
+func (m *ExportMyDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMyDataRequest.Merge(m, src)
 }
-func (m *General) XXX_DiscardUnknown() {
-	xxx_messageInfo_General.DiscardUnknown(m)
+func (m *ExportMyDataRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportMyDataRequest.Size(m)
 }
-
-var xxx_messageInfo_General proto.InternalMessageInfo
-
-func (m *General) GetTotalConfirmed() int64 {
-	if m != nil {
-		return m.TotalConfirmed
-	}
-	return 0
+func (m *ExportMyDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMyDataRequest.DiscardUnknown(m)
 }
 
-func (m *General) GetActiveCases() int64 {
-	if m != nil {
-		return m.ActiveCases
-	}
-	return 0
-}
+var xxx_messageInfo_ExportMyDataRequest proto.InternalMessageInfo
 
-// get kases response payload
-type GetKasesResponse struct {
-	Data                 *General `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+// session payload
+type Session struct {
+	// token type: access, refresh or reset
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// expiry time
+	ExpiresAt            int64    `protobuf:"varint,2,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetKasesResponse) Reset()         { *m = GetKasesResponse{} }
-func (m *GetKasesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetKasesResponse) ProtoMessage()    {}
-func (*GetKasesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{27}
+func (m *Session) Reset()         { *m = Session{} }
+func (m *Session) String() string { return proto.CompactTextString(m) }
+func (*Session) ProtoMessage()    {}
+func (*Session) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{30}
 }
 
-func (m *GetKasesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetKasesResponse.Unmarshal(m, b)
+func (m *Session) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Session.Unmarshal(m, b)
 }
-func (m *GetKasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetKasesResponse.Marshal(b, m, deterministic)
+func (m *Session) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Session.Marshal(b, m, deterministic)
 }
-func (m *GetKasesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetKasesResponse.Merge(m, src)
+func (m *Session) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Session.Merge(m, src)
 }
-func (m *GetKasesResponse) XXX_Size() int {
-	return xxx_messageInfo_GetKasesResponse.Size(m)
+func (m *Session) XXX_Size() int {
+	return xxx_messageInfo_Session.Size(m)
 }
-func (m *GetKasesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetKasesResponse.DiscardUnknown(m)
+func (m *Session) XXX_DiscardUnknown() {
+	xxx_messageInfo_Session.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetKasesResponse proto.InternalMessageInfo
+var xxx_messageInfo_Session proto.InternalMessageInfo
 
-func (m *GetKasesResponse) GetData() *General {
+func (m *Session) GetType() string {
 	if m != nil {
-		return m.Data
+		return m.Type
 	}
-	return nil
+	return ""
 }
 
-// get recent kases response payload
-type GetRecentKasesResponse struct {
-	Data                 []*Kase  `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+func (m *Session) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// location history payload
+type LocationHistory struct {
+	// latitude
+	Lat float64 `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	// longitude
+	Long float64 `protobuf:"fixed64,2,opt,name=long,proto3" json:"long,omitempty"`
+	// geohash of location
+	Geohash string `protobuf:"bytes,3,opt,name=geohash,proto3" json:"geohash,omitempty"`
+	// recorded time
+	CreatedAt            int64    `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetRecentKasesResponse) Reset()         { *m = GetRecentKasesResponse{} }
-func (m *GetRecentKasesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetRecentKasesResponse) ProtoMessage()    {}
-func (*GetRecentKasesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{28}
+func (m *LocationHistory) Reset()         { *m = LocationHistory{} }
+func (m *LocationHistory) String() string { return proto.CompactTextString(m) }
+func (*LocationHistory) ProtoMessage()    {}
+func (*LocationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{31}
 }
 
-func (m *GetRecentKasesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRecentKasesResponse.Unmarshal(m, b)
+func (m *LocationHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocationHistory.Unmarshal(m, b)
 }
-func (m *GetRecentKasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRecentKasesResponse.Marshal(b, m, deterministic)
+func (m *LocationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocationHistory.Marshal(b, m, deterministic)
 }
-func (m *GetRecentKasesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRecentKasesResponse.Merge(m, src)
+func (m *LocationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocationHistory.Merge(m, src)
 }
-func (m *GetRecentKasesResponse) XXX_Size() int {
-	return xxx_messageInfo_GetRecentKasesResponse.Size(m)
+func (m *LocationHistory) XXX_Size() int {
+	return xxx_messageInfo_LocationHistory.Size(m)
 }
-func (m *GetRecentKasesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRecentKasesResponse.DiscardUnknown(m)
+func (m *LocationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocationHistory.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRecentKasesResponse proto.InternalMessageInfo
+var xxx_messageInfo_LocationHistory proto.InternalMessageInfo
 
-func (m *GetRecentKasesResponse) GetData() []*Kase {
+func (m *LocationHistory) GetLat() float64 {
 	if m != nil {
-		return m.Data
+		return m.Lat
 	}
-	return nil
+	return 0
 }
 
-// get district request payload
-type GetDistrictRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *LocationHistory) GetLong() float64 {
+	if m != nil {
+		return m.Long
+	}
+	return 0
 }
 
-func (m *GetDistrictRequest) Reset()         { *m = GetDistrictRequest{} }
-func (m *GetDistrictRequest) String() string { return proto.CompactTextString(m) }
-func (*GetDistrictRequest) ProtoMessage()    {}
-func (*GetDistrictRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{29}
+func (m *LocationHistory) GetGeohash() string {
+	if m != nil {
+		return m.Geohash
+	}
+	return ""
 }
 
-func (m *GetDistrictRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetDistrictRequest.Unmarshal(m, b)
+func (m *LocationHistory) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
 }
-func (m *GetDistrictRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetDistrictRequest.Marshal(b, m, deterministic)
+
+// export my data response payload, all personal data held of the user
+type ExportMyDataResponse struct {
+	// export time
+	ExportedAt int64 `protobuf:"varint,1,opt,name=exportedAt,proto3" json:"exportedAt,omitempty"`
+	// user payload, without password
+	User *User `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// reports payload
+	Reports []*Report `protobuf:"bytes,3,rep,name=reports,proto3" json:"reports,omitempty"`
+	// sessions payload
+	Sessions []*Session `protobuf:"bytes,4,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	// location history payload
+	Locations            []*LocationHistory `protobuf:"bytes,5,rep,name=locations,proto3" json:"locations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ExportMyDataResponse) Reset()         { *m = ExportMyDataResponse{} }
+func (m *ExportMyDataResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportMyDataResponse) ProtoMessage()    {}
+func (*ExportMyDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{32}
 }
-func (m *GetDistrictRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDistrictRequest.Merge(m, src)
+
+func (m *ExportMyDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportMyDataResponse.Unmarshal(m, b)
 }
-func (m *GetDistrictRequest) XXX_Size() int {
-	return xxx_messageInfo_GetDistrictRequest.Size(m)
+func (m *ExportMyDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportMyDataResponse.Marshal(b, m, deterministic)
 }
-func (m *GetDistrictRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDistrictRequest.DiscardUnknown(m)
+func (m *ExportMyDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMyDataResponse.Merge(m, src)
+}
+func (m *ExportMyDataResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportMyDataResponse.Size(m)
+}
+func (m *ExportMyDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMyDataResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDistrictRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExportMyDataResponse proto.InternalMessageInfo
 
-func (m *GetDistrictRequest) GetId() string {
+func (m *ExportMyDataResponse) GetExportedAt() int64 {
 	if m != nil {
-		return m.Id
+		return m.ExportedAt
 	}
-	return ""
+	return 0
 }
 
-// get district response payload
-type GetDistrictResponse struct {
-	// district payload
-	Data                 *District `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *ExportMyDataResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
 }
 
-func (m *GetDistrictResponse) Reset()         { *m = GetDistrictResponse{} }
-func (m *GetDistrictResponse) String() string { return proto.CompactTextString(m) }
-func (*GetDistrictResponse) ProtoMessage()    {}
-func (*GetDistrictResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{30}
+func (m *ExportMyDataResponse) GetReports() []*Report {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
 }
 
-func (m *GetDistrictResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetDistrictResponse.Unmarshal(m, b)
-}
-func (m *GetDistrictResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetDistrictResponse.Marshal(b, m, deterministic)
-}
-func (m *GetDistrictResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDistrictResponse.Merge(m, src)
-}
-func (m *GetDistrictResponse) XXX_Size() int {
-	return xxx_messageInfo_GetDistrictResponse.Size(m)
-}
-func (m *GetDistrictResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDistrictResponse.DiscardUnknown(m)
+func (m *ExportMyDataResponse) GetSessions() []*Session {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetDistrictResponse proto.InternalMessageInfo
-
-func (m *GetDistrictResponse) GetData() *District {
+func (m *ExportMyDataResponse) GetLocations() []*LocationHistory {
 	if m != nil {
-		return m.Data
+		return m.Locations
 	}
 	return nil
 }
 
-type Kase struct {
-	LastUpdated          string   `protobuf:"bytes,1,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	NewDeaths            int64    `protobuf:"varint,2,opt,name=new_deaths,json=newDeaths,proto3" json:"new_deaths,omitempty"`
-	NewInfections        int64    `protobuf:"varint,3,opt,name=new_infections,json=newInfections,proto3" json:"new_infections,omitempty"`
-	NewRecovered         int64    `protobuf:"varint,4,opt,name=new_recovered,json=newRecovered,proto3" json:"new_recovered,omitempty"`
+// erase my account request payload
+type EraseMyAccountRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Kase) Reset()         { *m = Kase{} }
-func (m *Kase) String() string { return proto.CompactTextString(m) }
-func (*Kase) ProtoMessage()    {}
-func (*Kase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{31}
+func (m *EraseMyAccountRequest) Reset()         { *m = EraseMyAccountRequest{} }
+func (m *EraseMyAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*EraseMyAccountRequest) ProtoMessage()    {}
+func (*EraseMyAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{33}
 }
 
-func (m *Kase) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Kase.Unmarshal(m, b)
+func (m *EraseMyAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EraseMyAccountRequest.Unmarshal(m, b)
 }
-func (m *Kase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Kase.Marshal(b, m, deterministic)
+func (m *EraseMyAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EraseMyAccountRequest.Marshal(b, m, deterministic)
 }
-func (m *Kase) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Kase.Merge(m, src)
+func (m *EraseMyAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EraseMyAccountRequest.Merge(m, src)
 }
-func (m *Kase) XXX_Size() int {
-	return xxx_messageInfo_Kase.Size(m)
+func (m *EraseMyAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_EraseMyAccountRequest.Size(m)
 }
-func (m *Kase) XXX_DiscardUnknown() {
-	xxx_messageInfo_Kase.DiscardUnknown(m)
+func (m *EraseMyAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EraseMyAccountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Kase proto.InternalMessageInfo
+var xxx_messageInfo_EraseMyAccountRequest proto.InternalMessageInfo
 
-func (m *Kase) GetLastUpdated() string {
-	if m != nil {
-		return m.LastUpdated
-	}
-	return ""
+// get nearby users request payload
+type GetNearbyUsersRequest struct {
+	// user
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// search radius in meter, optional, capped by server maximum
+	Radius float64 `protobuf:"fixed64,2,opt,name=radius,proto3" json:"radius,omitempty"`
+	// maximum age of nearby users location in milliseconds, optional, capped by server maximum
+	MaxAge               int64    `protobuf:"varint,3,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Kase) GetNewDeaths() int64 {
-	if m != nil {
-		return m.NewDeaths
-	}
+func (m *GetNearbyUsersRequest) Reset()         { *m = GetNearbyUsersRequest{} }
+func (m *GetNearbyUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNearbyUsersRequest) ProtoMessage()    {}
+func (*GetNearbyUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{34}
+}
+
+func (m *GetNearbyUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNearbyUsersRequest.Unmarshal(m, b)
+}
+func (m *GetNearbyUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNearbyUsersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetNearbyUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNearbyUsersRequest.Merge(m, src)
+}
+func (m *GetNearbyUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNearbyUsersRequest.Size(m)
+}
+func (m *GetNearbyUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNearbyUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNearbyUsersRequest proto.InternalMessageInfo
+
+func (m *GetNearbyUsersRequest) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *GetNearbyUsersRequest) GetRadius() float64 {
+	if m != nil {
+		return m.Radius
+	}
 	return 0
 }
 
-func (m *Kase) GetNewInfections() int64 {
+func (m *GetNearbyUsersRequest) GetMaxAge() int64 {
 	if m != nil {
-		return m.NewInfections
+		return m.MaxAge
 	}
 	return 0
 }
 
-func (m *Kase) GetNewRecovered() int64 {
+// get nearby users response payload
+type GetNearbyUsersResponse struct {
+	// number of nearby users
+	UserNum int64 `protobuf:"varint,2,opt,name=userNum,proto3" json:"userNum,omitempty"`
+	// nearby users grouped by geohash cell, ordered by distance
+	Cells                []*NearbyCell `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetNearbyUsersResponse) Reset()         { *m = GetNearbyUsersResponse{} }
+func (m *GetNearbyUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNearbyUsersResponse) ProtoMessage()    {}
+func (*GetNearbyUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{35}
+}
+
+func (m *GetNearbyUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNearbyUsersResponse.Unmarshal(m, b)
+}
+func (m *GetNearbyUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNearbyUsersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetNearbyUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNearbyUsersResponse.Merge(m, src)
+}
+func (m *GetNearbyUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetNearbyUsersResponse.Size(m)
+}
+func (m *GetNearbyUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNearbyUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNearbyUsersResponse proto.InternalMessageInfo
+
+func (m *GetNearbyUsersResponse) GetUserNum() int64 {
 	if m != nil {
-		return m.NewRecovered
+		return m.UserNum
 	}
 	return 0
 }
 
-// get covids request payload
-type GetCovidsRequest struct {
-	// item to sort
-	Item string `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
-	// order of item
-	Order string `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
-	// pagination from
-	From int64 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
-	// pagination to
-	To int64 `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
-	// item to filter
-	FilterItem string `protobuf:"bytes,5,opt,name=filterItem,proto3" json:"filterItem,omitempty"`
-	// value to filter
-	FilterValue string `protobuf:"bytes,6,opt,name=filterValue,proto3" json:"filterValue,omitempty"`
-	// covid ids
-	Ids                  []string `protobuf:"bytes,7,rep,name=ids,proto3" json:"ids,omitempty"`
+func (m *GetNearbyUsersResponse) GetCells() []*NearbyCell {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+// get heatmap request payload
+type GetHeatmapRequest struct {
+	// south edge of bounding box
+	MinLat float64 `protobuf:"fixed64,1,opt,name=minLat,proto3" json:"minLat,omitempty"`
+	// west edge of bounding box
+	MinLong float64 `protobuf:"fixed64,2,opt,name=minLong,proto3" json:"minLong,omitempty"`
+	// north edge of bounding box
+	MaxLat float64 `protobuf:"fixed64,3,opt,name=maxLat,proto3" json:"maxLat,omitempty"`
+	// east edge of bounding box
+	MaxLong float64 `protobuf:"fixed64,4,opt,name=maxLong,proto3" json:"maxLong,omitempty"`
+	// start time in milliseconds, defaults to 24 hours before end time
+	StartTime int64 `protobuf:"varint,5,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	// end time in milliseconds, defaults to now
+	EndTime int64 `protobuf:"varint,6,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	// geohash precision of heatmap cells, optional, capped by server maximum
+	Precision            int32    `protobuf:"varint,7,opt,name=precision,proto3" json:"precision,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCovidsRequest) Reset()         { *m = GetCovidsRequest{} }
-func (m *GetCovidsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCovidsRequest) ProtoMessage()    {}
-func (*GetCovidsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{32}
+func (m *GetHeatmapRequest) Reset()         { *m = GetHeatmapRequest{} }
+func (m *GetHeatmapRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHeatmapRequest) ProtoMessage()    {}
+func (*GetHeatmapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{36}
 }
 
-func (m *GetCovidsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCovidsRequest.Unmarshal(m, b)
+func (m *GetHeatmapRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHeatmapRequest.Unmarshal(m, b)
 }
-func (m *GetCovidsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCovidsRequest.Marshal(b, m, deterministic)
+func (m *GetHeatmapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHeatmapRequest.Marshal(b, m, deterministic)
 }
-func (m *GetCovidsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCovidsRequest.Merge(m, src)
+func (m *GetHeatmapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHeatmapRequest.Merge(m, src)
 }
-func (m *GetCovidsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCovidsRequest.Size(m)
+func (m *GetHeatmapRequest) XXX_Size() int {
+	return xxx_messageInfo_GetHeatmapRequest.Size(m)
 }
-func (m *GetCovidsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCovidsRequest.DiscardUnknown(m)
+func (m *GetHeatmapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHeatmapRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCovidsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetHeatmapRequest proto.InternalMessageInfo
 
-func (m *GetCovidsRequest) GetItem() string {
+func (m *GetHeatmapRequest) GetMinLat() float64 {
 	if m != nil {
-		return m.Item
+		return m.MinLat
 	}
-	return ""
+	return 0
 }
 
-func (m *GetCovidsRequest) GetOrder() string {
+func (m *GetHeatmapRequest) GetMinLong() float64 {
 	if m != nil {
-		return m.Order
+		return m.MinLong
 	}
-	return ""
+	return 0
 }
 
-func (m *GetCovidsRequest) GetFrom() int64 {
+func (m *GetHeatmapRequest) GetMaxLat() float64 {
 	if m != nil {
-		return m.From
+		return m.MaxLat
 	}
 	return 0
 }
 
-func (m *GetCovidsRequest) GetTo() int64 {
+func (m *GetHeatmapRequest) GetMaxLong() float64 {
 	if m != nil {
-		return m.To
+		return m.MaxLong
 	}
 	return 0
 }
 
-func (m *GetCovidsRequest) GetFilterItem() string {
+func (m *GetHeatmapRequest) GetStartTime() int64 {
 	if m != nil {
-		return m.FilterItem
+		return m.StartTime
 	}
-	return ""
+	return 0
 }
 
-func (m *GetCovidsRequest) GetFilterValue() string {
+func (m *GetHeatmapRequest) GetEndTime() int64 {
 	if m != nil {
-		return m.FilterValue
+		return m.EndTime
 	}
-	return ""
+	return 0
 }
 
-func (m *GetCovidsRequest) GetIds() []string {
+func (m *GetHeatmapRequest) GetPrecision() int32 {
 	if m != nil {
-		return m.Ids
+		return m.Precision
 	}
-	return nil
+	return 0
 }
 
-// get covid request payload
-type GetCovidRequest struct {
-	// covid id
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// number of active users within a geohash cell
+type HeatmapCell struct {
+	// geohash of the cell
+	Geohash string `protobuf:"bytes,1,opt,name=geohash,proto3" json:"geohash,omitempty"`
+	// latitude of cell center
+	Lat float64 `protobuf:"fixed64,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	// longitude of cell center
+	Long float64 `protobuf:"fixed64,3,opt,name=long,proto3" json:"long,omitempty"`
+	// number of distinct users located in the cell within time range
+	Count                int64    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCovidRequest) Reset()         { *m = GetCovidRequest{} }
-func (m *GetCovidRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCovidRequest) ProtoMessage()    {}
-func (*GetCovidRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{33}
+func (m *HeatmapCell) Reset()         { *m = HeatmapCell{} }
+func (m *HeatmapCell) String() string { return proto.CompactTextString(m) }
+func (*HeatmapCell) ProtoMessage()    {}
+func (*HeatmapCell) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{37}
 }
 
-func (m *GetCovidRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCovidRequest.Unmarshal(m, b)
+func (m *HeatmapCell) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeatmapCell.Unmarshal(m, b)
 }
-func (m *GetCovidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCovidRequest.Marshal(b, m, deterministic)
+func (m *HeatmapCell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeatmapCell.Marshal(b, m, deterministic)
 }
-func (m *GetCovidRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCovidRequest.Merge(m, src)
+func (m *HeatmapCell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeatmapCell.Merge(m, src)
 }
-func (m *GetCovidRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCovidRequest.Size(m)
+func (m *HeatmapCell) XXX_Size() int {
+	return xxx_messageInfo_HeatmapCell.Size(m)
 }
-func (m *GetCovidRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCovidRequest.DiscardUnknown(m)
+func (m *HeatmapCell) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeatmapCell.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCovidRequest proto.InternalMessageInfo
+var xxx_messageInfo_HeatmapCell proto.InternalMessageInfo
 
-func (m *GetCovidRequest) GetId() string {
+func (m *HeatmapCell) GetGeohash() string {
 	if m != nil {
-		return m.Id
+		return m.Geohash
 	}
 	return ""
 }
 
-// get covids response payload
-type GetCovidsResponse struct {
-	// covids payload
-	Data []*Covid `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	// total covids
-	Total                int64    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *GetCovidsResponse) Reset()         { *m = GetCovidsResponse{} }
-func (m *GetCovidsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCovidsResponse) ProtoMessage()    {}
-func (*GetCovidsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{34}
-}
-
-func (m *GetCovidsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCovidsResponse.Unmarshal(m, b)
-}
-func (m *GetCovidsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCovidsResponse.Marshal(b, m, deterministic)
-}
-func (m *GetCovidsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCovidsResponse.Merge(m, src)
-}
-func (m *GetCovidsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCovidsResponse.Size(m)
-}
-func (m *GetCovidsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCovidsResponse.DiscardUnknown(m)
+func (m *HeatmapCell) GetLat() float64 {
+	if m != nil {
+		return m.Lat
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetCovidsResponse proto.InternalMessageInfo
-
-func (m *GetCovidsResponse) GetData() []*Covid {
+func (m *HeatmapCell) GetLong() float64 {
 	if m != nil {
-		return m.Data
+		return m.Long
 	}
-	return nil
+	return 0
 }
 
-func (m *GetCovidsResponse) GetTotal() int64 {
+func (m *HeatmapCell) GetCount() int64 {
 	if m != nil {
-		return m.Total
+		return m.Count
 	}
 	return 0
 }
 
-// get covid response payload
-type GetCovidResponse struct {
-	// covid payload
-	Data                 *Covid   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// get heatmap response payload
+type GetHeatmapResponse struct {
+	// heatmap cells ordered by count
+	Data                 []*HeatmapCell `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *GetCovidResponse) Reset()         { *m = GetCovidResponse{} }
-func (m *GetCovidResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCovidResponse) ProtoMessage()    {}
-func (*GetCovidResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{35}
+func (m *GetHeatmapResponse) Reset()         { *m = GetHeatmapResponse{} }
+func (m *GetHeatmapResponse) String() string { return proto.CompactTextString(m) }
+func (*GetHeatmapResponse) ProtoMessage()    {}
+func (*GetHeatmapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{38}
 }
 
-func (m *GetCovidResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCovidResponse.Unmarshal(m, b)
+func (m *GetHeatmapResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHeatmapResponse.Unmarshal(m, b)
 }
-func (m *GetCovidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCovidResponse.Marshal(b, m, deterministic)
+func (m *GetHeatmapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHeatmapResponse.Marshal(b, m, deterministic)
 }
-func (m *GetCovidResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCovidResponse.Merge(m, src)
+func (m *GetHeatmapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHeatmapResponse.Merge(m, src)
 }
-func (m *GetCovidResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCovidResponse.Size(m)
+func (m *GetHeatmapResponse) XXX_Size() int {
+	return xxx_messageInfo_GetHeatmapResponse.Size(m)
 }
-func (m *GetCovidResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCovidResponse.DiscardUnknown(m)
+func (m *GetHeatmapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHeatmapResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCovidResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetHeatmapResponse proto.InternalMessageInfo
 
-func (m *GetCovidResponse) GetData() *Covid {
+func (m *GetHeatmapResponse) GetData() []*HeatmapCell {
 	if m != nil {
 		return m.Data
 	}
 	return nil
 }
 
-// get reports request payload
-type GetReportsRequest struct {
-	// item to sort
-	Item string `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
-	// order of item
-	Order string `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
-	// pagination from
-	From int64 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
-	// pagination to
-	To int64 `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
-	// item to filter
-	FilterItem string `protobuf:"bytes,5,opt,name=filterItem,proto3" json:"filterItem,omitempty"`
-	// value to filter
-	FilterValue string `protobuf:"bytes,6,opt,name=filterValue,proto3" json:"filterValue,omitempty"`
-	// report ids
-	Ids                  []string `protobuf:"bytes,7,rep,name=ids,proto3" json:"ids,omitempty"`
+// nearby users within a geohash cell
+type NearbyCell struct {
+	// geohash of the cell
+	Geohash string `protobuf:"bytes,1,opt,name=geohash,proto3" json:"geohash,omitempty"`
+	// latitude of a random point within the cell
+	Lat float64 `protobuf:"fixed64,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	// longitude of a random point within the cell
+	Long float64 `protobuf:"fixed64,3,opt,name=long,proto3" json:"long,omitempty"`
+	// number of nearby users in the cell
+	Count int64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// compass direction of cell center from requesting user: N, NE, E, SE, S, SW, W, NW
+	Direction string `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	// distance in meter of cell center from requesting user, rounded to 10 meter
+	Distance             float64  `protobuf:"fixed64,6,opt,name=distance,proto3" json:"distance,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetReportsRequest) Reset()         { *m = GetReportsRequest{} }
-func (m *GetReportsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetReportsRequest) ProtoMessage()    {}
-func (*GetReportsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{36}
+func (m *NearbyCell) Reset()         { *m = NearbyCell{} }
+func (m *NearbyCell) String() string { return proto.CompactTextString(m) }
+func (*NearbyCell) ProtoMessage()    {}
+func (*NearbyCell) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{39}
 }
 
-func (m *GetReportsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetReportsRequest.Unmarshal(m, b)
+func (m *NearbyCell) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NearbyCell.Unmarshal(m, b)
 }
-func (m *GetReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetReportsRequest.Marshal(b, m, deterministic)
+func (m *NearbyCell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NearbyCell.Marshal(b, m, deterministic)
 }
-func (m *GetReportsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetReportsRequest.Merge(m, src)
+func (m *NearbyCell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NearbyCell.Merge(m, src)
 }
-func (m *GetReportsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetReportsRequest.Size(m)
+func (m *NearbyCell) XXX_Size() int {
+	return xxx_messageInfo_NearbyCell.Size(m)
 }
-func (m *GetReportsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetReportsRequest.DiscardUnknown(m)
+func (m *NearbyCell) XXX_DiscardUnknown() {
+	xxx_messageInfo_NearbyCell.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetReportsRequest proto.InternalMessageInfo
+var xxx_messageInfo_NearbyCell proto.InternalMessageInfo
 
-func (m *GetReportsRequest) GetItem() string {
+func (m *NearbyCell) GetGeohash() string {
 	if m != nil {
-		return m.Item
-	}
-	return ""
-}
-
-func (m *GetReportsRequest) GetOrder() string {
-	if m != nil {
-		return m.Order
+		return m.Geohash
 	}
 	return ""
 }
 
-func (m *GetReportsRequest) GetFrom() int64 {
+func (m *NearbyCell) GetLat() float64 {
 	if m != nil {
-		return m.From
+		return m.Lat
 	}
 	return 0
 }
 
-func (m *GetReportsRequest) GetTo() int64 {
+func (m *NearbyCell) GetLong() float64 {
 	if m != nil {
-		return m.To
+		return m.Long
 	}
 	return 0
 }
 
-func (m *GetReportsRequest) GetFilterItem() string {
+func (m *NearbyCell) GetCount() int64 {
 	if m != nil {
-		return m.FilterItem
+		return m.Count
 	}
-	return ""
+	return 0
 }
 
-func (m *GetReportsRequest) GetFilterValue() string {
+func (m *NearbyCell) GetDirection() string {
 	if m != nil {
-		return m.FilterValue
+		return m.Direction
 	}
 	return ""
 }
 
-func (m *GetReportsRequest) GetIds() []string {
+func (m *NearbyCell) GetDistance() float64 {
 	if m != nil {
-		return m.Ids
+		return m.Distance
 	}
-	return nil
+	return 0
 }
 
-// get report request payload
-type GetReportRequest struct {
-	// report id
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type General struct {
+	TotalConfirmed       int64    `protobuf:"varint,1,opt,name=totalConfirmed,proto3" json:"totalConfirmed,omitempty"`
+	ActiveCases          int64    `protobuf:"varint,2,opt,name=activeCases,proto3" json:"activeCases,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetReportRequest) Reset()         { *m = GetReportRequest{} }
-func (m *GetReportRequest) String() string { return proto.CompactTextString(m) }
-func (*GetReportRequest) ProtoMessage()    {}
-func (*GetReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{37}
+func (m *General) Reset()         { *m = General{} }
+func (m *General) String() string { return proto.CompactTextString(m) }
+func (*General) ProtoMessage()    {}
+func (*General) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{40}
 }
 
-func (m *GetReportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetReportRequest.Unmarshal(m, b)
+func (m *General) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_General.Unmarshal(m, b)
 }
-func (m *GetReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetReportRequest.Marshal(b, m, deterministic)
+func (m *General) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_General.Marshal(b, m, deterministic)
 }
-func (m *GetReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetReportRequest.Merge(m, src)
+func (m *General) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_General.Merge(m, src)
 }
-func (m *GetReportRequest) XXX_Size() int {
-	return xxx_messageInfo_GetReportRequest.Size(m)
+func (m *General) XXX_Size() int {
+	return xxx_messageInfo_General.Size(m)
 }
-func (m *GetReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetReportRequest.DiscardUnknown(m)
+func (m *General) XXX_DiscardUnknown() {
+	xxx_messageInfo_General.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetReportRequest proto.InternalMessageInfo
+var xxx_messageInfo_General proto.InternalMessageInfo
 
-func (m *GetReportRequest) GetId() string {
+func (m *General) GetTotalConfirmed() int64 {
 	if m != nil {
-		return m.Id
+		return m.TotalConfirmed
 	}
-	return ""
+	return 0
 }
 
-// create report request payload
-type CreateReportRequest struct {
-	// report id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// report payload
-	Data                 *Report  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+func (m *General) GetActiveCases() int64 {
+	if m != nil {
+		return m.ActiveCases
+	}
+	return 0
+}
+
+// get kases response payload
+type GetKasesResponse struct {
+	Data                 *General `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateReportRequest) Reset()         { *m = CreateReportRequest{} }
-func (m *CreateReportRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateReportRequest) ProtoMessage()    {}
-func (*CreateReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{38}
+func (m *GetKasesResponse) Reset()         { *m = GetKasesResponse{} }
+func (m *GetKasesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetKasesResponse) ProtoMessage()    {}
+func (*GetKasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{41}
 }
 
-func (m *CreateReportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateReportRequest.Unmarshal(m, b)
+func (m *GetKasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKasesResponse.Unmarshal(m, b)
 }
-func (m *CreateReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateReportRequest.Marshal(b, m, deterministic)
+func (m *GetKasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKasesResponse.Marshal(b, m, deterministic)
 }
-func (m *CreateReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateReportRequest.Merge(m, src)
+func (m *GetKasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKasesResponse.Merge(m, src)
 }
-func (m *CreateReportRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateReportRequest.Size(m)
+func (m *GetKasesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetKasesResponse.Size(m)
 }
-func (m *CreateReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateReportRequest.DiscardUnknown(m)
+func (m *GetKasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKasesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateReportRequest proto.InternalMessageInfo
-
-func (m *CreateReportRequest) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
+var xxx_messageInfo_GetKasesResponse proto.InternalMessageInfo
 
-func (m *CreateReportRequest) GetData() *Report {
+func (m *GetKasesResponse) GetData() *General {
 	if m != nil {
 		return m.Data
 	}
 	return nil
 }
 
-// update report request payload
-type UpdateReportRequest struct {
-	// report id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// report payload
-	Data                 *Report  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+// get recent kases response payload
+type GetRecentKasesResponse struct {
+	Data                 []*Kase  `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateReportRequest) Reset()         { *m = UpdateReportRequest{} }
-func (m *UpdateReportRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateReportRequest) ProtoMessage()    {}
-func (*UpdateReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{39}
+func (m *GetRecentKasesResponse) Reset()         { *m = GetRecentKasesResponse{} }
+func (m *GetRecentKasesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRecentKasesResponse) ProtoMessage()    {}
+func (*GetRecentKasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{42}
 }
 
-func (m *UpdateReportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateReportRequest.Unmarshal(m, b)
+func (m *GetRecentKasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRecentKasesResponse.Unmarshal(m, b)
 }
-func (m *UpdateReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateReportRequest.Marshal(b, m, deterministic)
+func (m *GetRecentKasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRecentKasesResponse.Marshal(b, m, deterministic)
 }
-func (m *UpdateReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateReportRequest.Merge(m, src)
+func (m *GetRecentKasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRecentKasesResponse.Merge(m, src)
 }
-func (m *UpdateReportRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateReportRequest.Size(m)
+func (m *GetRecentKasesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRecentKasesResponse.Size(m)
 }
-func (m *UpdateReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateReportRequest.DiscardUnknown(m)
+func (m *GetRecentKasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRecentKasesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateReportRequest proto.InternalMessageInfo
-
-func (m *UpdateReportRequest) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
+var xxx_messageInfo_GetRecentKasesResponse proto.InternalMessageInfo
 
-func (m *UpdateReportRequest) GetData() *Report {
+func (m *GetRecentKasesResponse) GetData() []*Kase {
 	if m != nil {
 		return m.Data
 	}
 	return nil
 }
 
-// update reports request payload
-type UpdateReportsRequest struct {
-	// report ids
-	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
-	// report payload
-	Data                 *Report  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+// get district request payload
+type GetDistrictRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateReportsRequest) Reset()         { *m = UpdateReportsRequest{} }
-func (m *UpdateReportsRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateReportsRequest) ProtoMessage()    {}
-func (*UpdateReportsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{40}
+func (m *GetDistrictRequest) Reset()         { *m = GetDistrictRequest{} }
+func (m *GetDistrictRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDistrictRequest) ProtoMessage()    {}
+func (*GetDistrictRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{43}
 }
 
-func (m *UpdateReportsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateReportsRequest.Unmarshal(m, b)
+func (m *GetDistrictRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDistrictRequest.Unmarshal(m, b)
 }
-func (m *UpdateReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateReportsRequest.Marshal(b, m, deterministic)
+func (m *GetDistrictRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDistrictRequest.Marshal(b, m, deterministic)
 }
-func (m *UpdateReportsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateReportsRequest.Merge(m, src)
+func (m *GetDistrictRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDistrictRequest.Merge(m, src)
 }
-func (m *UpdateReportsRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateReportsRequest.Size(m)
+func (m *GetDistrictRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDistrictRequest.Size(m)
 }
-func (m *UpdateReportsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateReportsRequest.DiscardUnknown(m)
+func (m *GetDistrictRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDistrictRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateReportsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetDistrictRequest proto.InternalMessageInfo
 
-func (m *UpdateReportsRequest) GetIds() []string {
+func (m *GetDistrictRequest) GetId() string {
 	if m != nil {
-		return m.Ids
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-func (m *UpdateReportsRequest) GetData() *Report {
-	if m != nil {
-		return m.Data
-	}
-	return nil
+// get district response payload
+type GetDistrictResponse struct {
+	// district payload, empty if place name is ambiguous
+	Data *District `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// candidate districts if place name is ambiguous
+	Suggestions          []*District `protobuf:"bytes,2,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-// delete report request payload
-type DeleteReportRequest struct {
-	// report id
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetDistrictResponse) Reset()         { *m = GetDistrictResponse{} }
+func (m *GetDistrictResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDistrictResponse) ProtoMessage()    {}
+func (*GetDistrictResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{44}
 }
 
-func (m *DeleteReportRequest) Reset()         { *m = DeleteReportRequest{} }
-func (m *DeleteReportRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteReportRequest) ProtoMessage()    {}
-func (*DeleteReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{41}
+func (m *GetDistrictResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDistrictResponse.Unmarshal(m, b)
 }
-
-func (m *DeleteReportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteReportRequest.Unmarshal(m, b)
+func (m *GetDistrictResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDistrictResponse.Marshal(b, m, deterministic)
 }
-func (m *DeleteReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteReportRequest.Marshal(b, m, deterministic)
+func (m *GetDistrictResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDistrictResponse.Merge(m, src)
+}
+func (m *GetDistrictResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDistrictResponse.Size(m)
+}
+func (m *GetDistrictResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDistrictResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDistrictResponse proto.InternalMessageInfo
+
+func (m *GetDistrictResponse) GetData() *District {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GetDistrictResponse) GetSuggestions() []*District {
+	if m != nil {
+		return m.Suggestions
+	}
+	return nil
+}
+
+// get my district request payload
+type GetMyDistrictRequest struct {
+	// user id, only used when authentication is disabled
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMyDistrictRequest) Reset()         { *m = GetMyDistrictRequest{} }
+func (m *GetMyDistrictRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMyDistrictRequest) ProtoMessage()    {}
+func (*GetMyDistrictRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{45}
+}
+
+func (m *GetMyDistrictRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMyDistrictRequest.Unmarshal(m, b)
+}
+func (m *GetMyDistrictRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMyDistrictRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMyDistrictRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMyDistrictRequest.Merge(m, src)
+}
+func (m *GetMyDistrictRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMyDistrictRequest.Size(m)
+}
+func (m *GetMyDistrictRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMyDistrictRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMyDistrictRequest proto.InternalMessageInfo
+
+func (m *GetMyDistrictRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// get my district response payload
+type GetMyDistrictResponse struct {
+	// state name
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// district payload
+	Data                 *District `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetMyDistrictResponse) Reset()         { *m = GetMyDistrictResponse{} }
+func (m *GetMyDistrictResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMyDistrictResponse) ProtoMessage()    {}
+func (*GetMyDistrictResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{46}
+}
+
+func (m *GetMyDistrictResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMyDistrictResponse.Unmarshal(m, b)
+}
+func (m *GetMyDistrictResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMyDistrictResponse.Marshal(b, m, deterministic)
+}
+func (m *GetMyDistrictResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMyDistrictResponse.Merge(m, src)
+}
+func (m *GetMyDistrictResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMyDistrictResponse.Size(m)
+}
+func (m *GetMyDistrictResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMyDistrictResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMyDistrictResponse proto.InternalMessageInfo
+
+func (m *GetMyDistrictResponse) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *GetMyDistrictResponse) GetData() *District {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// list districts response payload
+type ListDistrictsResponse struct {
+	// states payload
+	Data []*State `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// last updated time in milliseconds
+	LastUpdated          int64    `protobuf:"varint,2,opt,name=lastUpdated,proto3" json:"lastUpdated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDistrictsResponse) Reset()         { *m = ListDistrictsResponse{} }
+func (m *ListDistrictsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDistrictsResponse) ProtoMessage()    {}
+func (*ListDistrictsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{47}
+}
+
+func (m *ListDistrictsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDistrictsResponse.Unmarshal(m, b)
+}
+func (m *ListDistrictsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDistrictsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDistrictsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDistrictsResponse.Merge(m, src)
+}
+func (m *ListDistrictsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDistrictsResponse.Size(m)
+}
+func (m *ListDistrictsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDistrictsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDistrictsResponse proto.InternalMessageInfo
+
+func (m *ListDistrictsResponse) GetData() []*State {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ListDistrictsResponse) GetLastUpdated() int64 {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return 0
+}
+
+// get district history request payload
+type GetDistrictHistoryRequest struct {
+	// district or state name
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// start time in milliseconds, defaults to 30 days before endTime and is capped to 90 days before endTime
+	StartTime int64 `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	// end time in milliseconds, defaults to now
+	EndTime              int64    `protobuf:"varint,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDistrictHistoryRequest) Reset()         { *m = GetDistrictHistoryRequest{} }
+func (m *GetDistrictHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDistrictHistoryRequest) ProtoMessage()    {}
+func (*GetDistrictHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{48}
+}
+
+func (m *GetDistrictHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDistrictHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetDistrictHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDistrictHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDistrictHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDistrictHistoryRequest.Merge(m, src)
+}
+func (m *GetDistrictHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDistrictHistoryRequest.Size(m)
+}
+func (m *GetDistrictHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDistrictHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDistrictHistoryRequest proto.InternalMessageInfo
+
+func (m *GetDistrictHistoryRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetDistrictHistoryRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *GetDistrictHistoryRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// district history point payload
+type DistrictHistory struct {
+	// snapshot time in milliseconds
+	LastUpdated int64 `protobuf:"varint,1,opt,name=lastUpdated,proto3" json:"lastUpdated,omitempty"`
+	// total cases
+	Total int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// change in total cases since previous calendar day, 0 if previous day has no snapshot
+	Delta int64 `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	// 7-day rolling average of total cases
+	Average              float64  `protobuf:"fixed64,4,opt,name=average,proto3" json:"average,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DistrictHistory) Reset()         { *m = DistrictHistory{} }
+func (m *DistrictHistory) String() string { return proto.CompactTextString(m) }
+func (*DistrictHistory) ProtoMessage()    {}
+func (*DistrictHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{49}
+}
+
+func (m *DistrictHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DistrictHistory.Unmarshal(m, b)
+}
+func (m *DistrictHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DistrictHistory.Marshal(b, m, deterministic)
+}
+func (m *DistrictHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistrictHistory.Merge(m, src)
+}
+func (m *DistrictHistory) XXX_Size() int {
+	return xxx_messageInfo_DistrictHistory.Size(m)
+}
+func (m *DistrictHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistrictHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistrictHistory proto.InternalMessageInfo
+
+func (m *DistrictHistory) GetLastUpdated() int64 {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return 0
+}
+
+func (m *DistrictHistory) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *DistrictHistory) GetDelta() int64 {
+	if m != nil {
+		return m.Delta
+	}
+	return 0
+}
+
+func (m *DistrictHistory) GetAverage() float64 {
+	if m != nil {
+		return m.Average
+	}
+	return 0
+}
+
+// get district history response payload
+type GetDistrictHistoryResponse struct {
+	// district or state name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// history payload, ordered by time ascending
+	Data                 []*DistrictHistory `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetDistrictHistoryResponse) Reset()         { *m = GetDistrictHistoryResponse{} }
+func (m *GetDistrictHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDistrictHistoryResponse) ProtoMessage()    {}
+func (*GetDistrictHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{50}
+}
+
+func (m *GetDistrictHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDistrictHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetDistrictHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDistrictHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDistrictHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDistrictHistoryResponse.Merge(m, src)
+}
+func (m *GetDistrictHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDistrictHistoryResponse.Size(m)
+}
+func (m *GetDistrictHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDistrictHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDistrictHistoryResponse proto.InternalMessageInfo
+
+func (m *GetDistrictHistoryResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetDistrictHistoryResponse) GetData() []*DistrictHistory {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type Kase struct {
+	LastUpdated          string   `protobuf:"bytes,1,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	NewDeaths            int64    `protobuf:"varint,2,opt,name=new_deaths,json=newDeaths,proto3" json:"new_deaths,omitempty"`
+	NewInfections        int64    `protobuf:"varint,3,opt,name=new_infections,json=newInfections,proto3" json:"new_infections,omitempty"`
+	NewRecovered         int64    `protobuf:"varint,4,opt,name=new_recovered,json=newRecovered,proto3" json:"new_recovered,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Kase) Reset()         { *m = Kase{} }
+func (m *Kase) String() string { return proto.CompactTextString(m) }
+func (*Kase) ProtoMessage()    {}
+func (*Kase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{51}
+}
+
+func (m *Kase) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Kase.Unmarshal(m, b)
+}
+func (m *Kase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Kase.Marshal(b, m, deterministic)
+}
+func (m *Kase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Kase.Merge(m, src)
+}
+func (m *Kase) XXX_Size() int {
+	return xxx_messageInfo_Kase.Size(m)
+}
+func (m *Kase) XXX_DiscardUnknown() {
+	xxx_messageInfo_Kase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Kase proto.InternalMessageInfo
+
+func (m *Kase) GetLastUpdated() string {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return ""
+}
+
+func (m *Kase) GetNewDeaths() int64 {
+	if m != nil {
+		return m.NewDeaths
+	}
+	return 0
+}
+
+func (m *Kase) GetNewInfections() int64 {
+	if m != nil {
+		return m.NewInfections
+	}
+	return 0
+}
+
+func (m *Kase) GetNewRecovered() int64 {
+	if m != nil {
+		return m.NewRecovered
+	}
+	return 0
+}
+
+// get covids request payload
+type GetCovidsRequest struct {
+	// item to sort
+	Item string `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// order of item
+	Order string `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	// pagination from
+	From int64 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	// pagination to
+	To int64 `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	// item to filter
+	FilterItem string `protobuf:"bytes,5,opt,name=filterItem,proto3" json:"filterItem,omitempty"`
+	// value to filter
+	FilterValue string `protobuf:"bytes,6,opt,name=filterValue,proto3" json:"filterValue,omitempty"`
+	// covid ids
+	Ids []string `protobuf:"bytes,7,rep,name=ids,proto3" json:"ids,omitempty"`
+	// filters combined with and, as "field:operator:value" where operator is one of eq, ne, in, gt, gte, lt, lte, range and prefix,
+	// e.g. "hasSymptom:eq:true" or "createdAt:range:1588291200000,1588377600000", values of in and range are comma separated
+	Filters []string `protobuf:"bytes,8,rep,name=filters,proto3" json:"filters,omitempty"`
+	// sorts applied in order, as "field:order" where order is ASC or DESC, e.g. "createdAt:DESC"
+	Sorts []string `protobuf:"bytes,9,rep,name=sorts,proto3" json:"sorts,omitempty"`
+	// maximum number of covids per page, pagination by page token is used instead of from and to if set
+	PageSize int64 `protobuf:"varint,10,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// nextPageToken of previous page, empty for first page, filters and sorts must not change between pages
+	PageToken string `protobuf:"bytes,11,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	// count total covids, total is only set if true when paginating by page token
+	IncludeTotal         bool     `protobuf:"varint,12,opt,name=includeTotal,proto3" json:"includeTotal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCovidsRequest) Reset()         { *m = GetCovidsRequest{} }
+func (m *GetCovidsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCovidsRequest) ProtoMessage()    {}
+func (*GetCovidsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{52}
+}
+
+func (m *GetCovidsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCovidsRequest.Unmarshal(m, b)
+}
+func (m *GetCovidsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCovidsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCovidsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCovidsRequest.Merge(m, src)
+}
+func (m *GetCovidsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCovidsRequest.Size(m)
+}
+func (m *GetCovidsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCovidsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCovidsRequest proto.InternalMessageInfo
+
+func (m *GetCovidsRequest) GetItem() string {
+	if m != nil {
+		return m.Item
+	}
+	return ""
+}
+
+func (m *GetCovidsRequest) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+func (m *GetCovidsRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *GetCovidsRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *GetCovidsRequest) GetFilterItem() string {
+	if m != nil {
+		return m.FilterItem
+	}
+	return ""
+}
+
+func (m *GetCovidsRequest) GetFilterValue() string {
+	if m != nil {
+		return m.FilterValue
+	}
+	return ""
+}
+
+func (m *GetCovidsRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *GetCovidsRequest) GetFilters() []string {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *GetCovidsRequest) GetSorts() []string {
+	if m != nil {
+		return m.Sorts
+	}
+	return nil
+}
+
+func (m *GetCovidsRequest) GetPageSize() int64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetCovidsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *GetCovidsRequest) GetIncludeTotal() bool {
+	if m != nil {
+		return m.IncludeTotal
+	}
+	return false
+}
+
+// get covid request payload
+type GetCovidRequest struct {
+	// covid id
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCovidRequest) Reset()         { *m = GetCovidRequest{} }
+func (m *GetCovidRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCovidRequest) ProtoMessage()    {}
+func (*GetCovidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{53}
+}
+
+func (m *GetCovidRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCovidRequest.Unmarshal(m, b)
+}
+func (m *GetCovidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCovidRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCovidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCovidRequest.Merge(m, src)
+}
+func (m *GetCovidRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCovidRequest.Size(m)
+}
+func (m *GetCovidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCovidRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCovidRequest proto.InternalMessageInfo
+
+func (m *GetCovidRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// get covids response payload
+type GetCovidsResponse struct {
+	// covids payload
+	Data []*Covid `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// total covids
+	Total int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// token of next page, empty if this is the last page
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCovidsResponse) Reset()         { *m = GetCovidsResponse{} }
+func (m *GetCovidsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCovidsResponse) ProtoMessage()    {}
+func (*GetCovidsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{54}
+}
+
+func (m *GetCovidsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCovidsResponse.Unmarshal(m, b)
+}
+func (m *GetCovidsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCovidsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCovidsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCovidsResponse.Merge(m, src)
+}
+func (m *GetCovidsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCovidsResponse.Size(m)
+}
+func (m *GetCovidsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCovidsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCovidsResponse proto.InternalMessageInfo
+
+func (m *GetCovidsResponse) GetData() []*Covid {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GetCovidsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *GetCovidsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// get covid response payload
+type GetCovidResponse struct {
+	// covid payload
+	Data                 *Covid   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCovidResponse) Reset()         { *m = GetCovidResponse{} }
+func (m *GetCovidResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCovidResponse) ProtoMessage()    {}
+func (*GetCovidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{55}
+}
+
+func (m *GetCovidResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCovidResponse.Unmarshal(m, b)
+}
+func (m *GetCovidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCovidResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCovidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCovidResponse.Merge(m, src)
+}
+func (m *GetCovidResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCovidResponse.Size(m)
+}
+func (m *GetCovidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCovidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCovidResponse proto.InternalMessageInfo
+
+func (m *GetCovidResponse) GetData() *Covid {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// get reports request payload
+type GetReportsRequest struct {
+	// item to sort
+	Item string `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// order of item
+	Order string `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	// pagination from
+	From int64 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	// pagination to
+	To int64 `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	// item to filter
+	FilterItem string `protobuf:"bytes,5,opt,name=filterItem,proto3" json:"filterItem,omitempty"`
+	// value to filter
+	FilterValue string `protobuf:"bytes,6,opt,name=filterValue,proto3" json:"filterValue,omitempty"`
+	// report ids
+	Ids []string `protobuf:"bytes,7,rep,name=ids,proto3" json:"ids,omitempty"`
+	// filters combined with and, as "field:operator:value" where operator is one of eq, ne, in, gt, gte, lt, lte, range and prefix,
+	// e.g. "hasSymptom:eq:true" or "createdAt:range:1588291200000,1588377600000", values of in and range are comma separated
+	Filters []string `protobuf:"bytes,8,rep,name=filters,proto3" json:"filters,omitempty"`
+	// sorts applied in order, as "field:order" where order is ASC or DESC, e.g. "createdAt:DESC"
+	Sorts []string `protobuf:"bytes,9,rep,name=sorts,proto3" json:"sorts,omitempty"`
+	// maximum number of reports per page, pagination by page token is used instead of from and to if set
+	PageSize int64 `protobuf:"varint,10,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// nextPageToken of previous page, empty for first page, filters and sorts must not change between pages
+	PageToken string `protobuf:"bytes,11,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	// count total reports, total is only set if true when paginating by page token
+	IncludeTotal         bool     `protobuf:"varint,12,opt,name=includeTotal,proto3" json:"includeTotal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReportsRequest) Reset()         { *m = GetReportsRequest{} }
+func (m *GetReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReportsRequest) ProtoMessage()    {}
+func (*GetReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{56}
+}
+
+func (m *GetReportsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReportsRequest.Unmarshal(m, b)
+}
+func (m *GetReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReportsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReportsRequest.Merge(m, src)
+}
+func (m *GetReportsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReportsRequest.Size(m)
+}
+func (m *GetReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReportsRequest proto.InternalMessageInfo
+
+func (m *GetReportsRequest) GetItem() string {
+	if m != nil {
+		return m.Item
+	}
+	return ""
+}
+
+func (m *GetReportsRequest) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+func (m *GetReportsRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *GetReportsRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *GetReportsRequest) GetFilterItem() string {
+	if m != nil {
+		return m.FilterItem
+	}
+	return ""
+}
+
+func (m *GetReportsRequest) GetFilterValue() string {
+	if m != nil {
+		return m.FilterValue
+	}
+	return ""
+}
+
+func (m *GetReportsRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *GetReportsRequest) GetFilters() []string {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *GetReportsRequest) GetSorts() []string {
+	if m != nil {
+		return m.Sorts
+	}
+	return nil
+}
+
+func (m *GetReportsRequest) GetPageSize() int64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetReportsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *GetReportsRequest) GetIncludeTotal() bool {
+	if m != nil {
+		return m.IncludeTotal
+	}
+	return false
+}
+
+// get report request payload
+type GetReportRequest struct {
+	// report id
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReportRequest) Reset()         { *m = GetReportRequest{} }
+func (m *GetReportRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReportRequest) ProtoMessage()    {}
+func (*GetReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{57}
+}
+
+func (m *GetReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReportRequest.Unmarshal(m, b)
+}
+func (m *GetReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReportRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReportRequest.Merge(m, src)
+}
+func (m *GetReportRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReportRequest.Size(m)
+}
+func (m *GetReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReportRequest proto.InternalMessageInfo
+
+func (m *GetReportRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// create report request payload
+type CreateReportRequest struct {
+	// report id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// report payload
+	Data                 *Report  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateReportRequest) Reset()         { *m = CreateReportRequest{} }
+func (m *CreateReportRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateReportRequest) ProtoMessage()    {}
+func (*CreateReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{58}
+}
+
+func (m *CreateReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateReportRequest.Unmarshal(m, b)
+}
+func (m *CreateReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateReportRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateReportRequest.Merge(m, src)
+}
+func (m *CreateReportRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateReportRequest.Size(m)
+}
+func (m *CreateReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateReportRequest proto.InternalMessageInfo
+
+func (m *CreateReportRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CreateReportRequest) GetData() *Report {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// update report request payload
+type UpdateReportRequest struct {
+	// report id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// report payload
+	Data *Report `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// fields of payload to update, all updatable fields if empty, set from body fields on PATCH
+	UpdateMask           *field_mask.FieldMask `protobuf:"bytes,3,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpdateReportRequest) Reset()         { *m = UpdateReportRequest{} }
+func (m *UpdateReportRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateReportRequest) ProtoMessage()    {}
+func (*UpdateReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{59}
+}
+
+func (m *UpdateReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateReportRequest.Unmarshal(m, b)
+}
+func (m *UpdateReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateReportRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateReportRequest.Merge(m, src)
+}
+func (m *UpdateReportRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateReportRequest.Size(m)
+}
+func (m *UpdateReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateReportRequest proto.InternalMessageInfo
+
+func (m *UpdateReportRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateReportRequest) GetData() *Report {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *UpdateReportRequest) GetUpdateMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+// update reports request payload
+type UpdateReportsRequest struct {
+	// report ids
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// report payload
+	Data *Report `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// version of report expected by client by report id, reports not listed are updated at any version
+	Versions             map[string]int64 `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UpdateReportsRequest) Reset()         { *m = UpdateReportsRequest{} }
+func (m *UpdateReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateReportsRequest) ProtoMessage()    {}
+func (*UpdateReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{60}
+}
+
+func (m *UpdateReportsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateReportsRequest.Unmarshal(m, b)
+}
+func (m *UpdateReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateReportsRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateReportsRequest.Merge(m, src)
+}
+func (m *UpdateReportsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateReportsRequest.Size(m)
+}
+func (m *UpdateReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateReportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateReportsRequest proto.InternalMessageInfo
+
+func (m *UpdateReportsRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *UpdateReportsRequest) GetData() *Report {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *UpdateReportsRequest) GetVersions() map[string]int64 {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+// delete report request payload
+type DeleteReportRequest struct {
+	// report id
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteReportRequest) Reset()         { *m = DeleteReportRequest{} }
+func (m *DeleteReportRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteReportRequest) ProtoMessage()    {}
+func (*DeleteReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{61}
+}
+
+func (m *DeleteReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteReportRequest.Unmarshal(m, b)
+}
+func (m *DeleteReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteReportRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteReportRequest.Merge(m, src)
+}
+func (m *DeleteReportRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteReportRequest.Size(m)
+}
+func (m *DeleteReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteReportRequest proto.InternalMessageInfo
+
+func (m *DeleteReportRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// delete reports request payload
+type DeleteReportsRequest struct {
+	// report ids
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteReportsRequest) Reset()         { *m = DeleteReportsRequest{} }
+func (m *DeleteReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteReportsRequest) ProtoMessage()    {}
+func (*DeleteReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{62}
+}
+
+func (m *DeleteReportsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteReportsRequest.Unmarshal(m, b)
+}
+func (m *DeleteReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteReportsRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteReportsRequest.Merge(m, src)
+}
+func (m *DeleteReportsRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteReportsRequest.Size(m)
+}
+func (m *DeleteReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteReportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteReportsRequest proto.InternalMessageInfo
+
+func (m *DeleteReportsRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+// delete report response payload
+type DeleteReportResponse struct {
+	// report payload
+	Data                 *Report  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteReportResponse) Reset()         { *m = DeleteReportResponse{} }
+func (m *DeleteReportResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteReportResponse) ProtoMessage()    {}
+func (*DeleteReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{63}
+}
+
+func (m *DeleteReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteReportResponse.Unmarshal(m, b)
+}
+func (m *DeleteReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteReportResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteReportResponse.Merge(m, src)
+}
+func (m *DeleteReportResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteReportResponse.Size(m)
+}
+func (m *DeleteReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteReportResponse proto.InternalMessageInfo
+
+func (m *DeleteReportResponse) GetData() *Report {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// get reports response payload
+type GetReportsResponse struct {
+	// reports payload
+	Data []*Report `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// total reports
+	Total int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// token of next page, empty if this is the last page
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReportsResponse) Reset()         { *m = GetReportsResponse{} }
+func (m *GetReportsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReportsResponse) ProtoMessage()    {}
+func (*GetReportsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{64}
+}
+
+func (m *GetReportsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReportsResponse.Unmarshal(m, b)
+}
+func (m *GetReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReportsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReportsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReportsResponse.Merge(m, src)
+}
+func (m *GetReportsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReportsResponse.Size(m)
+}
+func (m *GetReportsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReportsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReportsResponse proto.InternalMessageInfo
+
+func (m *GetReportsResponse) GetData() []*Report {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GetReportsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *GetReportsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// get report response payload
+type GetReportResponse struct {
+	// report payload
+	Data                 *Report  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReportResponse) Reset()         { *m = GetReportResponse{} }
+func (m *GetReportResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReportResponse) ProtoMessage()    {}
+func (*GetReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{65}
+}
+
+func (m *GetReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReportResponse.Unmarshal(m, b)
+}
+func (m *GetReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReportResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReportResponse.Merge(m, src)
+}
+func (m *GetReportResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReportResponse.Size(m)
+}
+func (m *GetReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReportResponse proto.InternalMessageInfo
+
+func (m *GetReportResponse) GetData() *Report {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
-func (m *DeleteReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteReportRequest.Merge(m, src)
+
+// create report response payload
+type CreateReportResponse struct {
+	// report payload
+	Data                 *Report  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *DeleteReportRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteReportRequest.Size(m)
+
+func (m *CreateReportResponse) Reset()         { *m = CreateReportResponse{} }
+func (m *CreateReportResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateReportResponse) ProtoMessage()    {}
+func (*CreateReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{66}
 }
-func (m *DeleteReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteReportRequest.DiscardUnknown(m)
+
+func (m *CreateReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateReportResponse.Unmarshal(m, b)
+}
+func (m *CreateReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateReportResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateReportResponse.Merge(m, src)
+}
+func (m *CreateReportResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateReportResponse.Size(m)
+}
+func (m *CreateReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateReportResponse proto.InternalMessageInfo
+
+func (m *CreateReportResponse) GetData() *Report {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// update report response payload
+type UpdateReportResponse struct {
+	// report payload
+	Data                 *Report  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateReportResponse) Reset()         { *m = UpdateReportResponse{} }
+func (m *UpdateReportResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateReportResponse) ProtoMessage()    {}
+func (*UpdateReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{67}
+}
+
+func (m *UpdateReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateReportResponse.Unmarshal(m, b)
+}
+func (m *UpdateReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateReportResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateReportResponse.Merge(m, src)
+}
+func (m *UpdateReportResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateReportResponse.Size(m)
+}
+func (m *UpdateReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateReportResponse proto.InternalMessageInfo
+
+func (m *UpdateReportResponse) GetData() *Report {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// update reports response payload
+type UpdateReportsResponse struct {
+	// report ids succeeded
+	Data []string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// result per report id
+	Results              []*BatchResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UpdateReportsResponse) Reset()         { *m = UpdateReportsResponse{} }
+func (m *UpdateReportsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateReportsResponse) ProtoMessage()    {}
+func (*UpdateReportsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{68}
+}
+
+func (m *UpdateReportsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateReportsResponse.Unmarshal(m, b)
+}
+func (m *UpdateReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateReportsResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateReportsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateReportsResponse.Merge(m, src)
+}
+func (m *UpdateReportsResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateReportsResponse.Size(m)
+}
+func (m *UpdateReportsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateReportsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateReportsResponse proto.InternalMessageInfo
+
+func (m *UpdateReportsResponse) GetData() []string {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *UpdateReportsResponse) GetResults() []*BatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// delete reports response payload
+type DeleteReportsResponse struct {
+	// report ids succeeded
+	Data []string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// result per report id
+	Results              []*BatchResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DeleteReportsResponse) Reset()         { *m = DeleteReportsResponse{} }
+func (m *DeleteReportsResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteReportsResponse) ProtoMessage()    {}
+func (*DeleteReportsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{69}
+}
+
+func (m *DeleteReportsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteReportsResponse.Unmarshal(m, b)
+}
+func (m *DeleteReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteReportsResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteReportsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteReportsResponse.Merge(m, src)
+}
+func (m *DeleteReportsResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteReportsResponse.Size(m)
+}
+func (m *DeleteReportsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteReportsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteReportsResponse proto.InternalMessageInfo
+
+func (m *DeleteReportsResponse) GetData() []string {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DeleteReportsResponse) GetResults() []*BatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// restore report request payload
+type RestoreReportRequest struct {
+	// report id
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreReportRequest) Reset()         { *m = RestoreReportRequest{} }
+func (m *RestoreReportRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreReportRequest) ProtoMessage()    {}
+func (*RestoreReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{70}
+}
+
+func (m *RestoreReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreReportRequest.Unmarshal(m, b)
+}
+func (m *RestoreReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreReportRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreReportRequest.Merge(m, src)
+}
+func (m *RestoreReportRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreReportRequest.Size(m)
+}
+func (m *RestoreReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreReportRequest proto.InternalMessageInfo
+
+func (m *RestoreReportRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// restore report response payload
+type RestoreReportResponse struct {
+	// report payload
+	Data                 *Report  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreReportResponse) Reset()         { *m = RestoreReportResponse{} }
+func (m *RestoreReportResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreReportResponse) ProtoMessage()    {}
+func (*RestoreReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{71}
+}
+
+func (m *RestoreReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreReportResponse.Unmarshal(m, b)
+}
+func (m *RestoreReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreReportResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreReportResponse.Merge(m, src)
+}
+func (m *RestoreReportResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreReportResponse.Size(m)
+}
+func (m *RestoreReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreReportResponse proto.InternalMessageInfo
+
+func (m *RestoreReportResponse) GetData() *Report {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// scheduled job payload
+type Job struct {
+	// job name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// job schedule, cron expression or @every interval
+	Spec string `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// run timeout in milliseconds
+	Timeout int64 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// last run start time in milliseconds
+	LastRun int64 `protobuf:"varint,4,opt,name=lastRun,proto3" json:"lastRun,omitempty"`
+	// last run duration in milliseconds
+	LastDuration int64 `protobuf:"varint,5,opt,name=lastDuration,proto3" json:"lastDuration,omitempty"`
+	// last run error, empty if succeeded
+	LastError string `protobuf:"bytes,6,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	// next scheduled run time in milliseconds on this replica
+	NextRun int64 `protobuf:"varint,7,opt,name=nextRun,proto3" json:"nextRun,omitempty"`
+	// whether job is running on this replica
+	Running bool `protobuf:"varint,8,opt,name=running,proto3" json:"running,omitempty"`
+	// replica holding job lease
+	Owner string `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty"`
+	// number of completed runs
+	RunCount             int64    `protobuf:"varint,10,opt,name=runCount,proto3" json:"runCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Job) Reset()         { *m = Job{} }
+func (m *Job) String() string { return proto.CompactTextString(m) }
+func (*Job) ProtoMessage()    {}
+func (*Job) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{72}
+}
+
+func (m *Job) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Job.Unmarshal(m, b)
+}
+func (m *Job) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Job.Marshal(b, m, deterministic)
+}
+func (m *Job) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Job.Merge(m, src)
+}
+func (m *Job) XXX_Size() int {
+	return xxx_messageInfo_Job.Size(m)
+}
+func (m *Job) XXX_DiscardUnknown() {
+	xxx_messageInfo_Job.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Job proto.InternalMessageInfo
+
+func (m *Job) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Job) GetSpec() string {
+	if m != nil {
+		return m.Spec
+	}
+	return ""
+}
+
+func (m *Job) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *Job) GetLastRun() int64 {
+	if m != nil {
+		return m.LastRun
+	}
+	return 0
+}
+
+func (m *Job) GetLastDuration() int64 {
+	if m != nil {
+		return m.LastDuration
+	}
+	return 0
+}
+
+func (m *Job) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *Job) GetNextRun() int64 {
+	if m != nil {
+		return m.NextRun
+	}
+	return 0
 }
 
-var xxx_messageInfo_DeleteReportRequest proto.InternalMessageInfo
+func (m *Job) GetRunning() bool {
+	if m != nil {
+		return m.Running
+	}
+	return false
+}
 
-func (m *DeleteReportRequest) GetId() string {
+func (m *Job) GetOwner() string {
 	if m != nil {
-		return m.Id
+		return m.Owner
 	}
 	return ""
 }
 
-// delete reports request payload
-type DeleteReportsRequest struct {
-	// report ids
-	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+func (m *Job) GetRunCount() int64 {
+	if m != nil {
+		return m.RunCount
+	}
+	return 0
+}
+
+// list jobs response payload
+type ListJobsResponse struct {
+	// jobs payload
+	Data                 []*Job   `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteReportsRequest) Reset()         { *m = DeleteReportsRequest{} }
-func (m *DeleteReportsRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteReportsRequest) ProtoMessage()    {}
-func (*DeleteReportsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{42}
+func (m *ListJobsResponse) Reset()         { *m = ListJobsResponse{} }
+func (m *ListJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListJobsResponse) ProtoMessage()    {}
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{73}
 }
 
-func (m *DeleteReportsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteReportsRequest.Unmarshal(m, b)
+func (m *ListJobsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListJobsResponse.Unmarshal(m, b)
 }
-func (m *DeleteReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteReportsRequest.Marshal(b, m, deterministic)
+func (m *ListJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListJobsResponse.Marshal(b, m, deterministic)
 }
-func (m *DeleteReportsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteReportsRequest.Merge(m, src)
+func (m *ListJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobsResponse.Merge(m, src)
 }
-func (m *DeleteReportsRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteReportsRequest.Size(m)
+func (m *ListJobsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListJobsResponse.Size(m)
 }
-func (m *DeleteReportsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteReportsRequest.DiscardUnknown(m)
+func (m *ListJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteReportsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListJobsResponse proto.InternalMessageInfo
 
-func (m *DeleteReportsRequest) GetIds() []string {
+func (m *ListJobsResponse) GetData() []*Job {
 	if m != nil {
-		return m.Ids
+		return m.Data
 	}
 	return nil
 }
 
-// delete report response payload
-type DeleteReportResponse struct {
-	// report payload
-	Data                 *Report  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+// trigger job request payload
+type TriggerJobRequest struct {
+	// job name
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteReportResponse) Reset()         { *m = DeleteReportResponse{} }
-func (m *DeleteReportResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteReportResponse) ProtoMessage()    {}
-func (*DeleteReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{43}
+func (m *TriggerJobRequest) Reset()         { *m = TriggerJobRequest{} }
+func (m *TriggerJobRequest) String() string { return proto.CompactTextString(m) }
+func (*TriggerJobRequest) ProtoMessage()    {}
+func (*TriggerJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{74}
 }
 
-func (m *DeleteReportResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteReportResponse.Unmarshal(m, b)
+func (m *TriggerJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerJobRequest.Unmarshal(m, b)
 }
-func (m *DeleteReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteReportResponse.Marshal(b, m, deterministic)
+func (m *TriggerJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerJobRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteReportResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteReportResponse.Merge(m, src)
+func (m *TriggerJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerJobRequest.Merge(m, src)
 }
-func (m *DeleteReportResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteReportResponse.Size(m)
+func (m *TriggerJobRequest) XXX_Size() int {
+	return xxx_messageInfo_TriggerJobRequest.Size(m)
 }
-func (m *DeleteReportResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteReportResponse.DiscardUnknown(m)
+func (m *TriggerJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerJobRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteReportResponse proto.InternalMessageInfo
+var xxx_messageInfo_TriggerJobRequest proto.InternalMessageInfo
 
-func (m *DeleteReportResponse) GetData() *Report {
+func (m *TriggerJobRequest) GetName() string {
 	if m != nil {
-		return m.Data
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-// get reports response payload
-type GetReportsResponse struct {
-	// reports payload
-	Data []*Report `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	// total reports
-	Total                int64    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+// trigger job response payload
+type TriggerJobResponse struct {
+	// job payload
+	Data                 *Job     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetReportsResponse) Reset()         { *m = GetReportsResponse{} }
-func (m *GetReportsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetReportsResponse) ProtoMessage()    {}
-func (*GetReportsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{44}
+func (m *TriggerJobResponse) Reset()         { *m = TriggerJobResponse{} }
+func (m *TriggerJobResponse) String() string { return proto.CompactTextString(m) }
+func (*TriggerJobResponse) ProtoMessage()    {}
+func (*TriggerJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{75}
 }
 
-func (m *GetReportsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetReportsResponse.Unmarshal(m, b)
+func (m *TriggerJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerJobResponse.Unmarshal(m, b)
 }
-func (m *GetReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetReportsResponse.Marshal(b, m, deterministic)
+func (m *TriggerJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerJobResponse.Marshal(b, m, deterministic)
 }
-func (m *GetReportsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetReportsResponse.Merge(m, src)
+func (m *TriggerJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerJobResponse.Merge(m, src)
 }
-func (m *GetReportsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetReportsResponse.Size(m)
+func (m *TriggerJobResponse) XXX_Size() int {
+	return xxx_messageInfo_TriggerJobResponse.Size(m)
 }
-func (m *GetReportsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetReportsResponse.DiscardUnknown(m)
+func (m *TriggerJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerJobResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetReportsResponse proto.InternalMessageInfo
+var xxx_messageInfo_TriggerJobResponse proto.InternalMessageInfo
 
-func (m *GetReportsResponse) GetData() []*Report {
+func (m *TriggerJobResponse) GetData() *Job {
 	if m != nil {
 		return m.Data
 	}
 	return nil
 }
 
-func (m *GetReportsResponse) GetTotal() int64 {
-	if m != nil {
-		return m.Total
-	}
-	return 0
-}
-
-// get report response payload
-type GetReportResponse struct {
-	// report payload
-	Data                 *Report  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+// audit record payload
+type Audit struct {
+	// audit record id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// id of user taking action, empty if authentication is disabled
+	Actor string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// action, e.g. user.update, user.delete or report.delete
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// id of user or report acted on
+	Target string `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	// values of fields changed by action before it
+	Before *_struct.Struct `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	// values of fields changed by action after it
+	After *_struct.Struct `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	// id of request taking action
+	RequestId string `protobuf:"bytes,7,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	// time of action
+	CreatedAt            int64    `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetReportResponse) Reset()         { *m = GetReportResponse{} }
-func (m *GetReportResponse) String() string { return proto.CompactTextString(m) }
-func (*GetReportResponse) ProtoMessage()    {}
-func (*GetReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{45}
+func (m *Audit) Reset()         { *m = Audit{} }
+func (m *Audit) String() string { return proto.CompactTextString(m) }
+func (*Audit) ProtoMessage()    {}
+func (*Audit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{76}
 }
 
-func (m *GetReportResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetReportResponse.Unmarshal(m, b)
+func (m *Audit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Audit.Unmarshal(m, b)
 }
-func (m *GetReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetReportResponse.Marshal(b, m, deterministic)
+func (m *Audit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Audit.Marshal(b, m, deterministic)
 }
-func (m *GetReportResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetReportResponse.Merge(m, src)
+func (m *Audit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Audit.Merge(m, src)
 }
-func (m *GetReportResponse) XXX_Size() int {
-	return xxx_messageInfo_GetReportResponse.Size(m)
+func (m *Audit) XXX_Size() int {
+	return xxx_messageInfo_Audit.Size(m)
 }
-func (m *GetReportResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetReportResponse.DiscardUnknown(m)
+func (m *Audit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Audit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetReportResponse proto.InternalMessageInfo
+var xxx_messageInfo_Audit proto.InternalMessageInfo
 
-func (m *GetReportResponse) GetData() *Report {
+func (m *Audit) GetId() string {
 	if m != nil {
-		return m.Data
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-// create report response payload
-type CreateReportResponse struct {
-	// report payload
-	Data                 *Report  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Audit) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
 }
 
-func (m *CreateReportResponse) Reset()         { *m = CreateReportResponse{} }
-func (m *CreateReportResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateReportResponse) ProtoMessage()    {}
-func (*CreateReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{46}
+func (m *Audit) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
 }
 
-func (m *CreateReportResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateReportResponse.Unmarshal(m, b)
-}
-func (m *CreateReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateReportResponse.Marshal(b, m, deterministic)
-}
-func (m *CreateReportResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateReportResponse.Merge(m, src)
+func (m *Audit) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
 }
-func (m *CreateReportResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateReportResponse.Size(m)
+
+func (m *Audit) GetBefore() *_struct.Struct {
+	if m != nil {
+		return m.Before
+	}
+	return nil
 }
-func (m *CreateReportResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateReportResponse.DiscardUnknown(m)
+
+func (m *Audit) GetAfter() *_struct.Struct {
+	if m != nil {
+		return m.After
+	}
+	return nil
 }
 
-var xxx_messageInfo_CreateReportResponse proto.InternalMessageInfo
+func (m *Audit) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
 
-func (m *CreateReportResponse) GetData() *Report {
+func (m *Audit) GetCreatedAt() int64 {
 	if m != nil {
-		return m.Data
+		return m.CreatedAt
 	}
-	return nil
+	return 0
 }
 
-// update report response payload
-type UpdateReportResponse struct {
-	// report payload
-	Data                 *Report  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+// get audit log request payload
+type GetAuditLogRequest struct {
+	// filters combined with and, as "field:operator:value", fields are actor, action, target, requestId and createdAt,
+	// e.g. "target:eq:<user id>" or "createdAt:range:1588291200000,1588377600000"
+	Filters []string `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	// sorts applied in order, as "field:order" where order is ASC or DESC, newest first by default
+	Sorts []string `protobuf:"bytes,2,rep,name=sorts,proto3" json:"sorts,omitempty"`
+	// maximum number of audit records per page
+	PageSize int64 `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// nextPageToken of previous page, empty for first page, filters and sorts must not change between pages
+	PageToken string `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	// count total audit records
+	IncludeTotal         bool     `protobuf:"varint,5,opt,name=includeTotal,proto3" json:"includeTotal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateReportResponse) Reset()         { *m = UpdateReportResponse{} }
-func (m *UpdateReportResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateReportResponse) ProtoMessage()    {}
-func (*UpdateReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{47}
+func (m *GetAuditLogRequest) Reset()         { *m = GetAuditLogRequest{} }
+func (m *GetAuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAuditLogRequest) ProtoMessage()    {}
+func (*GetAuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{77}
 }
 
-func (m *UpdateReportResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateReportResponse.Unmarshal(m, b)
+func (m *GetAuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditLogRequest.Unmarshal(m, b)
 }
-func (m *UpdateReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateReportResponse.Marshal(b, m, deterministic)
+func (m *GetAuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditLogRequest.Marshal(b, m, deterministic)
 }
-func (m *UpdateReportResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateReportResponse.Merge(m, src)
+func (m *GetAuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditLogRequest.Merge(m, src)
 }
-func (m *UpdateReportResponse) XXX_Size() int {
-	return xxx_messageInfo_UpdateReportResponse.Size(m)
+func (m *GetAuditLogRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAuditLogRequest.Size(m)
 }
-func (m *UpdateReportResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateReportResponse.DiscardUnknown(m)
+func (m *GetAuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditLogRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateReportResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetAuditLogRequest proto.InternalMessageInfo
 
-func (m *UpdateReportResponse) GetData() *Report {
+func (m *GetAuditLogRequest) GetFilters() []string {
 	if m != nil {
-		return m.Data
+		return m.Filters
 	}
 	return nil
 }
 
-// update reports response payload
-type UpdateReportsResponse struct {
-	// report ids
-	Data                 []string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetAuditLogRequest) GetSorts() []string {
+	if m != nil {
+		return m.Sorts
+	}
+	return nil
 }
 
-func (m *UpdateReportsResponse) Reset()         { *m = UpdateReportsResponse{} }
-func (m *UpdateReportsResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateReportsResponse) ProtoMessage()    {}
-func (*UpdateReportsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{48}
+func (m *GetAuditLogRequest) GetPageSize() int64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
 }
 
-func (m *UpdateReportsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateReportsResponse.Unmarshal(m, b)
-}
-func (m *UpdateReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateReportsResponse.Marshal(b, m, deterministic)
-}
-func (m *UpdateReportsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateReportsResponse.Merge(m, src)
-}
-func (m *UpdateReportsResponse) XXX_Size() int {
-	return xxx_messageInfo_UpdateReportsResponse.Size(m)
-}
-func (m *UpdateReportsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateReportsResponse.DiscardUnknown(m)
+func (m *GetAuditLogRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
 }
 
-var xxx_messageInfo_UpdateReportsResponse proto.InternalMessageInfo
-
-func (m *UpdateReportsResponse) GetData() []string {
+func (m *GetAuditLogRequest) GetIncludeTotal() bool {
 	if m != nil {
-		return m.Data
+		return m.IncludeTotal
 	}
-	return nil
+	return false
 }
 
-// delete reports response payload
-type DeleteReportsResponse struct {
-	// report ids
-	Data                 []string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+// get audit log response payload
+type GetAuditLogResponse struct {
+	// audit records payload
+	Data []*Audit `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// total audit records, only set if includeTotal is true
+	Total int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// token of next page, empty if this is the last page
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteReportsResponse) Reset()         { *m = DeleteReportsResponse{} }
-func (m *DeleteReportsResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteReportsResponse) ProtoMessage()    {}
-func (*DeleteReportsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe7d991659ed015b, []int{49}
+func (m *GetAuditLogResponse) Reset()         { *m = GetAuditLogResponse{} }
+func (m *GetAuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAuditLogResponse) ProtoMessage()    {}
+func (*GetAuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7d991659ed015b, []int{78}
 }
 
-func (m *DeleteReportsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteReportsResponse.Unmarshal(m, b)
+func (m *GetAuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditLogResponse.Unmarshal(m, b)
 }
-func (m *DeleteReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteReportsResponse.Marshal(b, m, deterministic)
+func (m *GetAuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditLogResponse.Marshal(b, m, deterministic)
 }
-func (m *DeleteReportsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteReportsResponse.Merge(m, src)
+func (m *GetAuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditLogResponse.Merge(m, src)
 }
-func (m *DeleteReportsResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteReportsResponse.Size(m)
+func (m *GetAuditLogResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAuditLogResponse.Size(m)
 }
-func (m *DeleteReportsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteReportsResponse.DiscardUnknown(m)
+func (m *GetAuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuditLogResponse proto.InternalMessageInfo
+
+func (m *GetAuditLogResponse) GetData() []*Audit {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-var xxx_messageInfo_DeleteReportsResponse proto.InternalMessageInfo
+func (m *GetAuditLogResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
 
-func (m *DeleteReportsResponse) GetData() []string {
+func (m *GetAuditLogResponse) GetNextPageToken() string {
 	if m != nil {
-		return m.Data
+		return m.NextPageToken
 	}
-	return nil
+	return ""
 }
 
 func init() {
@@ -2592,6 +4640,7 @@ func init() {
 	proto.RegisterType((*RefreshResponse)(nil), "pb.RefreshResponse")
 	proto.RegisterType((*Covid)(nil), "pb.Covid")
 	proto.RegisterType((*District)(nil), "pb.District")
+	proto.RegisterType((*State)(nil), "pb.State")
 	proto.RegisterType((*Report)(nil), "pb.Report")
 	proto.RegisterType((*User)(nil), "pb.User")
 	proto.RegisterType((*GetPasswordResetRequest)(nil), "pb.GetPasswordResetRequest")
@@ -2602,6 +4651,7 @@ func init() {
 	proto.RegisterType((*CreateUserRequest)(nil), "pb.CreateUserRequest")
 	proto.RegisterType((*UpdateUserRequest)(nil), "pb.UpdateUserRequest")
 	proto.RegisterType((*UpdateUsersRequest)(nil), "pb.UpdateUsersRequest")
+	proto.RegisterMapType((map[string]int64)(nil), "pb.UpdateUsersRequest.VersionsEntry")
 	proto.RegisterType((*DeleteUserRequest)(nil), "pb.DeleteUserRequest")
 	proto.RegisterType((*DeleteUsersRequest)(nil), "pb.DeleteUsersRequest")
 	proto.RegisterType((*DeleteUserResponse)(nil), "pb.DeleteUserResponse")
@@ -2609,15 +4659,34 @@ func init() {
 	proto.RegisterType((*GetUserResponse)(nil), "pb.GetUserResponse")
 	proto.RegisterType((*CreateUserResponse)(nil), "pb.CreateUserResponse")
 	proto.RegisterType((*UpdateUserResponse)(nil), "pb.UpdateUserResponse")
+	proto.RegisterType((*VersionConflict)(nil), "pb.VersionConflict")
+	proto.RegisterType((*BatchResult)(nil), "pb.BatchResult")
 	proto.RegisterType((*UpdateUsersResponse)(nil), "pb.UpdateUsersResponse")
 	proto.RegisterType((*DeleteUsersResponse)(nil), "pb.DeleteUsersResponse")
+	proto.RegisterType((*RestoreUserRequest)(nil), "pb.RestoreUserRequest")
+	proto.RegisterType((*RestoreUserResponse)(nil), "pb.RestoreUserResponse")
+	proto.RegisterType((*ExportMyDataRequest)(nil), "pb.ExportMyDataRequest")
+	proto.RegisterType((*Session)(nil), "pb.Session")
+	proto.RegisterType((*LocationHistory)(nil), "pb.LocationHistory")
+	proto.RegisterType((*ExportMyDataResponse)(nil), "pb.ExportMyDataResponse")
+	proto.RegisterType((*EraseMyAccountRequest)(nil), "pb.EraseMyAccountRequest")
 	proto.RegisterType((*GetNearbyUsersRequest)(nil), "pb.GetNearbyUsersRequest")
 	proto.RegisterType((*GetNearbyUsersResponse)(nil), "pb.GetNearbyUsersResponse")
+	proto.RegisterType((*GetHeatmapRequest)(nil), "pb.GetHeatmapRequest")
+	proto.RegisterType((*HeatmapCell)(nil), "pb.HeatmapCell")
+	proto.RegisterType((*GetHeatmapResponse)(nil), "pb.GetHeatmapResponse")
+	proto.RegisterType((*NearbyCell)(nil), "pb.NearbyCell")
 	proto.RegisterType((*General)(nil), "pb.General")
 	proto.RegisterType((*GetKasesResponse)(nil), "pb.GetKasesResponse")
 	proto.RegisterType((*GetRecentKasesResponse)(nil), "pb.GetRecentKasesResponse")
 	proto.RegisterType((*GetDistrictRequest)(nil), "pb.GetDistrictRequest")
 	proto.RegisterType((*GetDistrictResponse)(nil), "pb.GetDistrictResponse")
+	proto.RegisterType((*GetMyDistrictRequest)(nil), "pb.GetMyDistrictRequest")
+	proto.RegisterType((*GetMyDistrictResponse)(nil), "pb.GetMyDistrictResponse")
+	proto.RegisterType((*ListDistrictsResponse)(nil), "pb.ListDistrictsResponse")
+	proto.RegisterType((*GetDistrictHistoryRequest)(nil), "pb.GetDistrictHistoryRequest")
+	proto.RegisterType((*DistrictHistory)(nil), "pb.DistrictHistory")
+	proto.RegisterType((*GetDistrictHistoryResponse)(nil), "pb.GetDistrictHistoryResponse")
 	proto.RegisterType((*Kase)(nil), "pb.Kase")
 	proto.RegisterType((*GetCovidsRequest)(nil), "pb.GetCovidsRequest")
 	proto.RegisterType((*GetCovidRequest)(nil), "pb.GetCovidRequest")
@@ -2628,6 +4697,7 @@ func init() {
 	proto.RegisterType((*CreateReportRequest)(nil), "pb.CreateReportRequest")
 	proto.RegisterType((*UpdateReportRequest)(nil), "pb.UpdateReportRequest")
 	proto.RegisterType((*UpdateReportsRequest)(nil), "pb.UpdateReportsRequest")
+	proto.RegisterMapType((map[string]int64)(nil), "pb.UpdateReportsRequest.VersionsEntry")
 	proto.RegisterType((*DeleteReportRequest)(nil), "pb.DeleteReportRequest")
 	proto.RegisterType((*DeleteReportsRequest)(nil), "pb.DeleteReportsRequest")
 	proto.RegisterType((*DeleteReportResponse)(nil), "pb.DeleteReportResponse")
@@ -2637,136 +4707,239 @@ func init() {
 	proto.RegisterType((*UpdateReportResponse)(nil), "pb.UpdateReportResponse")
 	proto.RegisterType((*UpdateReportsResponse)(nil), "pb.UpdateReportsResponse")
 	proto.RegisterType((*DeleteReportsResponse)(nil), "pb.DeleteReportsResponse")
+	proto.RegisterType((*RestoreReportRequest)(nil), "pb.RestoreReportRequest")
+	proto.RegisterType((*RestoreReportResponse)(nil), "pb.RestoreReportResponse")
+	proto.RegisterType((*Job)(nil), "pb.Job")
+	proto.RegisterType((*ListJobsResponse)(nil), "pb.ListJobsResponse")
+	proto.RegisterType((*TriggerJobRequest)(nil), "pb.TriggerJobRequest")
+	proto.RegisterType((*TriggerJobResponse)(nil), "pb.TriggerJobResponse")
+	proto.RegisterType((*Audit)(nil), "pb.Audit")
+	proto.RegisterType((*GetAuditLogRequest)(nil), "pb.GetAuditLogRequest")
+	proto.RegisterType((*GetAuditLogResponse)(nil), "pb.GetAuditLogResponse")
 }
 
 func init() { proto.RegisterFile("galasejahtera-service.proto", fileDescriptor_fe7d991659ed015b) }
 
 var fileDescriptor_fe7d991659ed015b = []byte{
-	// 1983 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdd, 0x6f, 0x1b, 0xc7,
-	0x11, 0xc7, 0x89, 0x92, 0x25, 0x8e, 0x3e, 0x2c, 0xad, 0x28, 0xf9, 0x7c, 0x52, 0x1c, 0xe6, 0x52,
-	0x07, 0xaa, 0x52, 0x93, 0x31, 0x9d, 0xba, 0x80, 0x5f, 0x1a, 0xc7, 0x1f, 0xb2, 0x63, 0x43, 0x75,
-	0x4f, 0x71, 0x82, 0xa2, 0x1f, 0xc2, 0x92, 0x5c, 0x91, 0x67, 0x1f, 0xef, 0xae, 0xb7, 0x4b, 0xa9,
-	0x42, 0xd0, 0x97, 0x3e, 0xf5, 0xb9, 0xed, 0x5b, 0x81, 0xfe, 0x13, 0x6d, 0xd1, 0x3f, 0xa4, 0x40,
-	0xdf, 0x0b, 0xb4, 0x40, 0xff, 0x8c, 0x62, 0x67, 0xf7, 0x8e, 0xbb, 0xbc, 0x23, 0x23, 0xb4, 0x79,
-	0xc9, 0x13, 0x6f, 0x67, 0x67, 0x7f, 0x3b, 0xf3, 0x9b, 0xd9, 0xd9, 0x0f, 0xc2, 0xde, 0x80, 0x46,
-	0x94, 0xb3, 0x37, 0x74, 0x28, 0x58, 0x46, 0xef, 0x70, 0x96, 0x9d, 0x87, 0x3d, 0xd6, 0x4a, 0xb3,
-	0x44, 0x24, 0x64, 0x21, 0xed, 0x7a, 0xfb, 0x83, 0x24, 0x19, 0x44, 0xac, 0x4d, 0xd3, 0xb0, 0x4d,
-	0xe3, 0x38, 0x11, 0x54, 0x84, 0x49, 0xcc, 0x95, 0x86, 0xf7, 0x3d, 0xfc, 0xe9, 0xdd, 0x19, 0xb0,
-	0xf8, 0x0e, 0xbf, 0xa0, 0x83, 0x01, 0xcb, 0xda, 0x49, 0x8a, 0x1a, 0x15, 0xda, 0x7b, 0x1a, 0x0b,
-	0x5b, 0xdd, 0xf1, 0x59, 0x9b, 0x8d, 0x52, 0x71, 0xa9, 0x3a, 0xfd, 0x4f, 0x60, 0xed, 0x65, 0x32,
-	0x08, 0xe3, 0x80, 0xfd, 0x72, 0xcc, 0xb8, 0x20, 0x0d, 0x58, 0x62, 0x23, 0x1a, 0x46, 0xae, 0xd3,
-	0x74, 0x0e, 0xea, 0x81, 0x6a, 0x10, 0x0f, 0x56, 0x52, 0xca, 0xf9, 0x45, 0x92, 0xf5, 0xdd, 0x05,
-	0xec, 0x28, 0xda, 0xfe, 0x1f, 0x1d, 0x58, 0xd7, 0x10, 0x3c, 0x4d, 0x62, 0xce, 0x48, 0x13, 0x56,
-	0x69, 0xaf, 0xc7, 0x38, 0xff, 0x3c, 0x79, 0xcb, 0x62, 0x8d, 0x64, 0x8a, 0x88, 0x0f, 0x6b, 0x19,
-	0x3b, 0xcb, 0x18, 0x1f, 0x2a, 0x15, 0x85, 0x69, 0xc9, 0x24, 0x4a, 0x3f, 0xe4, 0x69, 0x44, 0x2f,
-	0x8f, 0xe9, 0x88, 0xb9, 0x35, 0x85, 0x62, 0x88, 0x08, 0x81, 0xc5, 0x2c, 0x89, 0x98, 0xbb, 0x88,
-	0x5d, 0xf8, 0x4d, 0x36, 0x60, 0x21, 0xec, 0xbb, 0x4b, 0x28, 0x59, 0x08, 0xfb, 0xfe, 0x97, 0x70,
-	0x3d, 0x50, 0xa8, 0xdf, 0xac, 0x79, 0xfe, 0x3f, 0x1c, 0x58, 0x7a, 0x94, 0x9c, 0x87, 0x7d, 0x3d,
-	0xa5, 0x93, 0x4f, 0x29, 0x29, 0x14, 0xa1, 0x88, 0x98, 0x1e, 0xa6, 0x1a, 0x64, 0x13, 0x6a, 0x3c,
-	0xec, 0xa3, 0x1b, 0xb5, 0x40, 0x7e, 0x92, 0x43, 0xd8, 0x0a, 0x47, 0x74, 0xc0, 0x4e, 0xcf, 0x18,
-	0x15, 0xa7, 0x3c, 0x8c, 0x07, 0x85, 0x2f, 0xd7, 0xb1, 0xe3, 0x29, 0xa3, 0xe2, 0x04, 0xc5, 0xc4,
-	0x85, 0x65, 0x3e, 0x1e, 0x8d, 0x68, 0x76, 0xa9, 0x7d, 0xcb, 0x9b, 0x64, 0x0f, 0xea, 0x7d, 0x2a,
-	0xd8, 0x69, 0x3a, 0xee, 0x76, 0xdc, 0x6b, 0x2a, 0x36, 0x52, 0xf0, 0x6a, 0xdc, 0xed, 0xc8, 0x61,
-	0xbd, 0x24, 0x16, 0x2c, 0x16, 0xee, 0xb2, 0x1a, 0xa6, 0x9b, 0xb2, 0x27, 0x66, 0x17, 0xfc, 0x75,
-	0x16, 0xb9, 0x2b, 0xaa, 0x47, 0x37, 0xfd, 0x8f, 0x61, 0xe5, 0x71, 0xc8, 0x45, 0x16, 0xf6, 0x84,
-	0x64, 0x38, 0x96, 0xe4, 0x2b, 0xe7, 0xf0, 0x1b, 0xdd, 0x4b, 0x04, 0x8d, 0xd0, 0xbd, 0x5a, 0xa0,
-	0x1a, 0xfe, 0x6f, 0x1d, 0xb8, 0x16, 0xb0, 0x34, 0xc9, 0x44, 0x89, 0x8f, 0x5d, 0xb8, 0x36, 0xe6,
-	0x2c, 0x7b, 0x9e, 0xa7, 0x8e, 0x6e, 0x91, 0x7d, 0xa8, 0xf7, 0x32, 0x46, 0x05, 0xeb, 0x3f, 0x14,
-	0x9a, 0x97, 0x89, 0x80, 0xdc, 0x02, 0x18, 0x52, 0x7e, 0x72, 0x39, 0x4a, 0x45, 0x32, 0x42, 0x5a,
-	0x56, 0x02, 0x43, 0x22, 0x1d, 0xc8, 0x18, 0x1f, 0x47, 0x82, 0xbb, 0x4b, 0xcd, 0xda, 0xc1, 0x4a,
-	0x90, 0x37, 0xfd, 0xff, 0x38, 0xb0, 0xf8, 0x9a, 0xb3, 0xac, 0x64, 0x48, 0x9e, 0x2f, 0x0b, 0x46,
-	0xbe, 0x14, 0xf9, 0xbe, 0x34, 0x2b, 0xdf, 0x97, 0xed, 0x7c, 0x97, 0xe9, 0x13, 0x51, 0x2e, 0x5e,
-	0xa7, 0x92, 0xe5, 0x3e, 0xb2, 0x57, 0x0b, 0x4c, 0x91, 0x0c, 0x75, 0x44, 0x85, 0x5b, 0x6f, 0x3a,
-	0x07, 0x4e, 0x20, 0x3f, 0xe5, 0xcc, 0x51, 0x12, 0x0f, 0x5c, 0x40, 0x11, 0x7e, 0x4b, 0x99, 0x08,
-	0x47, 0xcc, 0x5d, 0x43, 0x00, 0xfc, 0x96, 0xf3, 0x86, 0xfc, 0x61, 0x4f, 0x84, 0xe7, 0xcc, 0x5d,
-	0x47, 0x97, 0x8b, 0x76, 0x11, 0x8b, 0x8d, 0x49, 0x2c, 0xfc, 0x1f, 0xc2, 0x8d, 0x23, 0x26, 0x5e,
-	0x69, 0xd3, 0x02, 0xc6, 0x99, 0xc8, 0x17, 0x72, 0x45, 0x56, 0x2a, 0x47, 0x17, 0x0c, 0x47, 0xfd,
-	0x17, 0xb0, 0xa3, 0xac, 0x9e, 0x60, 0xa8, 0xe1, 0x93, 0xa0, 0x39, 0x56, 0xd0, 0xe6, 0x55, 0x82,
-	0x8f, 0xc1, 0x2d, 0x5b, 0xa3, 0x17, 0x9d, 0x0b, 0xcb, 0x23, 0xc6, 0x39, 0x1d, 0xe4, 0xc9, 0x94,
-	0x37, 0xfd, 0x3f, 0x3b, 0x70, 0xfd, 0x88, 0x09, 0x19, 0x31, 0x9e, 0xcf, 0x4e, 0x60, 0x31, 0x14,
-	0x6c, 0x94, 0xe7, 0x9d, 0xfc, 0x96, 0x0e, 0x24, 0x59, 0x9f, 0x65, 0xb9, 0x03, 0xd8, 0x90, 0x9a,
-	0x67, 0x59, 0x32, 0xd2, 0xf9, 0x83, 0xdf, 0xd2, 0x75, 0x91, 0x60, 0xca, 0xd4, 0x82, 0x05, 0x91,
-	0xc8, 0x54, 0x3a, 0x0b, 0x23, 0xc1, 0xb2, 0xe7, 0x12, 0x53, 0x05, 0xda, 0x90, 0xc8, 0x88, 0xaa,
-	0xd6, 0x17, 0x34, 0x1a, 0x33, 0xbd, 0x88, 0x4c, 0x91, 0x8c, 0x68, 0xd8, 0xe7, 0xee, 0x72, 0xb3,
-	0x76, 0x50, 0x0f, 0xe4, 0xa7, 0xdf, 0x84, 0x0d, 0x6d, 0xf4, 0x0c, 0xc2, 0xfd, 0x87, 0xb0, 0xf5,
-	0x08, 0xb3, 0x79, 0x8e, 0x12, 0xd9, 0x87, 0xc5, 0x3e, 0x15, 0x14, 0x7d, 0x5a, 0xed, 0xac, 0xb4,
-	0xd2, 0x6e, 0x0b, 0xd5, 0x51, 0x2a, 0x21, 0x54, 0x74, 0xfe, 0x77, 0x88, 0xc7, 0x40, 0x26, 0x10,
-	0x05, 0xbf, 0xda, 0x1f, 0xa7, 0xf0, 0xe7, 0x6b, 0x50, 0xde, 0x87, 0xad, 0xc7, 0x2c, 0x62, 0x73,
-	0x0d, 0xf1, 0x3f, 0x00, 0x32, 0x51, 0x9a, 0x3d, 0x95, 0xdf, 0x31, 0xf5, 0x8a, 0x04, 0xc9, 0x0d,
-	0x70, 0x2a, 0x0d, 0x78, 0x0a, 0x9b, 0x93, 0x1c, 0x29, 0x8d, 0xa8, 0x95, 0x47, 0xcc, 0x28, 0x53,
-	0xed, 0x22, 0xd7, 0xae, 0x38, 0x71, 0x07, 0x88, 0x19, 0xc5, 0xab, 0x8e, 0x31, 0xc3, 0x76, 0xa5,
-	0x31, 0xdf, 0x85, 0x6d, 0x2b, 0x4e, 0x7a, 0x10, 0x31, 0x7c, 0xac, 0x4f, 0x54, 0x2d, 0x9e, 0xe7,
-	0xa8, 0x7e, 0x1f, 0x76, 0x8e, 0x98, 0x38, 0x66, 0x34, 0xeb, 0x5e, 0x5a, 0x51, 0xd9, 0x87, 0x45,
-	0xb9, 0xa0, 0xcb, 0xc6, 0x48, 0xa9, 0x1f, 0xc0, 0xee, 0xf4, 0x30, 0x3d, 0xc9, 0x2d, 0x58, 0x92,
-	0x1a, 0xbc, 0x44, 0xba, 0x12, 0xcb, 0x65, 0x2e, 0x3f, 0x8e, 0xc7, 0x23, 0xcd, 0x7b, 0xde, 0xf4,
-	0x4f, 0x60, 0xf9, 0x88, 0xc5, 0x2c, 0xa3, 0x11, 0xf9, 0x00, 0x36, 0x30, 0x1a, 0x8f, 0x92, 0xf8,
-	0x2c, 0xcc, 0x46, 0x4c, 0x25, 0x51, 0x2d, 0x98, 0x92, 0xaa, 0x8d, 0x5a, 0xd6, 0xbe, 0x47, 0x94,
-	0x33, 0xae, 0x01, 0x4d, 0x91, 0x7f, 0x0f, 0xd3, 0xe2, 0x85, 0xfc, 0x2e, 0x4c, 0x7c, 0xd7, 0xe2,
-	0x79, 0x55, 0x5a, 0xa8, 0x27, 0xd6, 0xa4, 0xdc, 0x47, 0xef, 0x02, 0xd6, 0x63, 0xf1, 0xd4, 0xd0,
-	0x8a, 0x8c, 0x92, 0x0a, 0x7a, 0xdc, 0x77, 0x80, 0x1c, 0x31, 0x91, 0xef, 0x8d, 0xb3, 0x56, 0xc1,
-	0x0f, 0x60, 0xdb, 0xd2, 0x2a, 0x0e, 0x1d, 0xa6, 0x55, 0x6b, 0x12, 0xba, 0xd0, 0x51, 0xf0, 0x7f,
-	0x70, 0x60, 0x51, 0xce, 0x46, 0xde, 0x83, 0x35, 0xb9, 0x9b, 0x9c, 0x8e, 0xf5, 0x0e, 0xa3, 0x0f,
-	0x28, 0xe6, 0x0e, 0xf3, 0x0e, 0x40, 0xcc, 0x2e, 0x4e, 0xfb, 0x8c, 0x8a, 0x61, 0x4e, 0x4c, 0x3d,
-	0x66, 0x17, 0x8f, 0x51, 0x40, 0x6e, 0xc3, 0x86, 0xec, 0x0e, 0xe3, 0x33, 0xd6, 0xc3, 0x93, 0xa0,
-	0x2e, 0x8f, 0xeb, 0x31, 0xbb, 0x78, 0x5e, 0x08, 0xc9, 0xfb, 0x20, 0x05, 0xa7, 0x19, 0xeb, 0x25,
-	0xe7, 0x2c, 0x63, 0x7d, 0x5d, 0x32, 0xd7, 0x62, 0x76, 0x11, 0xe4, 0x32, 0xff, 0x2f, 0x0e, 0x72,
-	0x8c, 0x47, 0x9d, 0x6f, 0x51, 0x7d, 0x7e, 0x0f, 0x17, 0x3a, 0x5a, 0x3d, 0x2b, 0x52, 0xcf, 0x60,
-	0xcb, 0x70, 0x4c, 0xc7, 0xe9, 0x1d, 0x2b, 0x05, 0xea, 0x32, 0x4e, 0x0a, 0x64, 0x5e, 0x55, 0xb9,
-	0x3b, 0xa1, 0xa8, 0x02, 0xc8, 0xa9, 0x00, 0xf2, 0xff, 0xea, 0xe0, 0xec, 0xea, 0xc8, 0xf4, 0x2d,
-	0xe2, 0xd5, 0x47, 0x57, 0x95, 0xd9, 0xb3, 0x88, 0x7d, 0x02, 0xdb, 0xaa, 0x66, 0xce, 0x55, 0x23,
-	0xb7, 0xac, 0x2d, 0x07, 0x24, 0x43, 0x7a, 0x80, 0xa2, 0xe8, 0x49, 0x5e, 0x12, 0xff, 0x3f, 0x98,
-	0x67, 0xd0, 0x30, 0x61, 0xe6, 0xec, 0x81, 0x5f, 0x87, 0x74, 0x3b, 0x2f, 0xbc, 0xf3, 0xdd, 0x3f,
-	0x80, 0x86, 0xa9, 0x36, 0x67, 0x27, 0xbc, 0x6f, 0x6b, 0x1a, 0x55, 0xd6, 0xcc, 0x9d, 0xb2, 0x21,
-	0x9f, 0x61, 0x25, 0x2a, 0xe0, 0x4b, 0xa3, 0x6a, 0x55, 0xa3, 0x66, 0xe4, 0xee, 0x3d, 0x23, 0x0f,
-	0xaf, 0x6c, 0xc0, 0x7d, 0x68, 0xd8, 0x11, 0xbe, 0xfa, 0x38, 0x3b, 0xa4, 0x57, 0x1c, 0xf7, 0x61,
-	0x7e, 0x4c, 0x9d, 0xf6, 0xb9, 0x6a, 0xd3, 0xfb, 0x10, 0x76, 0xa6, 0xf8, 0x9f, 0xad, 0xdc, 0xf9,
-	0xd3, 0x16, 0x34, 0x8e, 0x68, 0x44, 0x4f, 0xf2, 0xcb, 0xf8, 0x89, 0xba, 0x8b, 0x93, 0x97, 0x50,
-	0x2f, 0xaa, 0x03, 0x69, 0xa8, 0x5d, 0xc4, 0xae, 0x82, 0xde, 0xce, 0x94, 0x54, 0x4d, 0xe3, 0x93,
-	0xdf, 0xfc, 0xfd, 0x5f, 0xbf, 0x5f, 0x58, 0x23, 0xd0, 0x3e, 0xbf, 0xdb, 0xee, 0x29, 0x80, 0x63,
-	0x58, 0xc9, 0x15, 0xc9, 0xb6, 0x39, 0x2c, 0xc7, 0x6a, 0xd8, 0x42, 0x0d, 0x75, 0x03, 0xa1, 0xb6,
-	0xc8, 0xf5, 0x09, 0x54, 0xfb, 0xab, 0xb0, 0xff, 0x6b, 0xf2, 0x25, 0xac, 0x1a, 0xbb, 0x0c, 0xd9,
-	0xd5, 0xa3, 0xa7, 0x36, 0x27, 0xef, 0x46, 0x49, 0x5e, 0x05, 0x9c, 0x46, 0xb4, 0xc7, 0x34, 0xf0,
-	0x5b, 0x3c, 0xd7, 0x1a, 0x5b, 0x3f, 0xb9, 0xa9, 0x31, 0xca, 0xa7, 0x08, 0xcf, 0xab, 0xea, 0xd2,
-	0x33, 0xf8, 0x38, 0xc3, 0xbe, 0x7f, 0x03, 0x4d, 0x8f, 0x42, 0x16, 0x8b, 0x36, 0x9e, 0x11, 0xda,
-	0x31, 0x6a, 0x3f, 0x70, 0x0e, 0xc9, 0x0b, 0x64, 0x05, 0xf7, 0x60, 0xb2, 0xdb, 0x52, 0xcf, 0x14,
-	0xad, 0xfc, 0x99, 0xa2, 0xf5, 0x64, 0x94, 0x8a, 0xcb, 0x82, 0x18, 0x6b, 0xa7, 0xf6, 0xb7, 0x10,
-	0x7d, 0x95, 0xd4, 0x25, 0xfa, 0x5b, 0x04, 0xf8, 0x39, 0x5a, 0x6e, 0x6c, 0xeb, 0x33, 0x21, 0x73,
-	0xb3, 0x2b, 0x8e, 0x00, 0x36, 0x31, 0x19, 0x2a, 0x28, 0xf8, 0x5f, 0xc0, 0x9a, 0x99, 0xf2, 0x04,
-	0xa9, 0xad, 0x28, 0x73, 0x9e, 0x5b, 0xee, 0xd0, 0xd8, 0x7b, 0x88, 0xbd, 0xe3, 0x6f, 0x2a, 0x6c,
-	0x4c, 0x4e, 0x64, 0x5d, 0x72, 0xf1, 0x0a, 0x60, 0xb2, 0xa6, 0xc9, 0x4e, 0x61, 0xa2, 0x59, 0x42,
-	0xbc, 0xdd, 0x69, 0xb1, 0x46, 0xde, 0x46, 0xe4, 0x75, 0xb2, 0x6a, 0x20, 0x93, 0x00, 0x33, 0x58,
-	0x9b, 0xdb, 0xb0, 0x46, 0x4e, 0x67, 0xf0, 0x94, 0xa1, 0x2e, 0xc2, 0x11, 0x52, 0x32, 0x54, 0xb2,
-	0x60, 0x2e, 0x44, 0xc5, 0x42, 0x45, 0x95, 0x56, 0x2c, 0x54, 0xad, 0xf5, 0x9c, 0x05, 0xaf, 0x92,
-	0x85, 0x9f, 0xc1, 0xba, 0xb5, 0xd0, 0x49, 0x09, 0xa7, 0xe0, 0xe2, 0x66, 0x45, 0x8f, 0x9e, 0x62,
-	0x17, 0xa7, 0xd8, 0xf4, 0x4c, 0x3a, 0x24, 0xfa, 0x4f, 0x61, 0xcd, 0xac, 0x0c, 0xca, 0xfa, 0x8a,
-	0x92, 0xae, 0xac, 0xaf, 0x2a, 0xcd, 0x39, 0x35, 0x87, 0x65, 0x6a, 0x7e, 0x02, 0xeb, 0x56, 0xd9,
-	0x21, 0x25, 0x10, 0xdb, 0xf4, 0xca, 0x1a, 0x95, 0x47, 0xf2, 0xd0, 0x8a, 0xe4, 0x17, 0x00, 0x93,
-	0x4b, 0x88, 0xca, 0x8d, 0xd2, 0xd5, 0x52, 0xe5, 0x46, 0xf9, 0xae, 0xe2, 0xdf, 0x44, 0xc4, 0x6d,
-	0x7f, 0x43, 0x22, 0xaa, 0x15, 0x98, 0xb3, 0xfd, 0x0c, 0xd7, 0x9f, 0x5a, 0xe6, 0x79, 0x55, 0xb2,
-	0x16, 0x78, 0xc3, 0x16, 0x56, 0x2d, 0x3e, 0x75, 0xee, 0xff, 0x4c, 0x9e, 0xee, 0x51, 0x8d, 0x10,
-	0x63, 0x4c, 0x8e, 0xb3, 0x6d, 0xc9, 0xec, 0x28, 0x91, 0x29, 0xc3, 0xa4, 0xb7, 0x93, 0xab, 0x90,
-	0xf2, 0xb6, 0x74, 0x0b, 0x56, 0xde, 0x96, 0x6f, 0x59, 0xb9, 0xb7, 0x5e, 0x85, 0xb7, 0x9f, 0xc3,
-	0xaa, 0x71, 0xc5, 0x22, 0x53, 0x08, 0xdc, 0xaa, 0x99, 0x15, 0x77, 0x31, 0xbf, 0x81, 0xd0, 0x1b,
-	0xde, 0xc4, 0x6d, 0x89, 0x7a, 0x02, 0x30, 0xb9, 0x8d, 0x29, 0x6b, 0x4b, 0x57, 0x65, 0x65, 0x6d,
-	0xf9, 0xd2, 0x9b, 0x53, 0x70, 0x38, 0x4d, 0xc1, 0x8f, 0x61, 0xd5, 0xb8, 0xe2, 0x91, 0xa9, 0xe1,
-	0xb6, 0xa9, 0x15, 0x77, 0xc1, 0x3c, 0x42, 0x87, 0x46, 0x84, 0x86, 0x78, 0x70, 0xb3, 0x1e, 0x67,
-	0xc8, 0x9e, 0x0e, 0x4b, 0xd5, 0x03, 0x92, 0xb7, 0x5f, 0xdd, 0x69, 0xf3, 0x4c, 0xb6, 0x70, 0x03,
-	0xd1, 0x2a, 0x19, 0xa2, 0xbe, 0x81, 0x0d, 0xfb, 0x4d, 0x89, 0x18, 0x4b, 0x75, 0xea, 0x9d, 0xc9,
-	0x9b, 0x51, 0xa3, 0xfd, 0xdb, 0x88, 0xff, 0xae, 0xe7, 0x95, 0xf0, 0xdb, 0x5f, 0xa9, 0xa7, 0x28,
-	0x8c, 0xe9, 0xa7, 0xb0, 0x84, 0x6f, 0xcf, 0x64, 0x53, 0x4e, 0x61, 0xbe, 0x64, 0x7b, 0x5b, 0x86,
-	0xc4, 0x8e, 0xa0, 0x8f, 0xb4, 0x44, 0xb2, 0x4b, 0x62, 0xbc, 0x84, 0x6b, 0x2f, 0x93, 0x41, 0x32,
-	0x16, 0x33, 0x37, 0x8c, 0x59, 0x46, 0xea, 0x9d, 0xde, 0x07, 0x8d, 0x27, 0x31, 0x8e, 0x61, 0x59,
-	0x3f, 0x38, 0xcf, 0x84, 0xdb, 0x56, 0xe7, 0x1b, 0xeb, 0x55, 0x3a, 0x4f, 0x05, 0x5f, 0x2f, 0x7c,
-	0xec, 0x7c, 0xe0, 0x1c, 0x7e, 0xfa, 0x6f, 0xe7, 0x77, 0x0f, 0xff, 0xe9, 0x90, 0xbf, 0x39, 0xb0,
-	0x63, 0x9d, 0x53, 0x9a, 0xf9, 0x41, 0xe5, 0x69, 0xa5, 0xb8, 0x39, 0xa4, 0x71, 0x3f, 0x62, 0xbc,
-	0x99, 0x29, 0x3a, 0x78, 0x53, 0x5e, 0x11, 0x9a, 0xb6, 0x2e, 0x4d, 0xd3, 0x28, 0xec, 0xe1, 0x9f,
-	0x05, 0x2d, 0xff, 0x47, 0xa4, 0x33, 0x14, 0x22, 0xe5, 0x0f, 0xda, 0xed, 0x41, 0x28, 0x86, 0xe3,
-	0x6e, 0xab, 0x97, 0x8c, 0xda, 0x03, 0x1a, 0xd1, 0x3b, 0xc5, 0x3f, 0x15, 0x6d, 0xfb, 0x7f, 0x8b,
-	0x2e, 0xf3, 0x6e, 0xf6, 0x86, 0x61, 0xfc, 0xab, 0x30, 0x89, 0x07, 0x17, 0x2c, 0xfc, 0xe4, 0x92,
-	0x0e, 0x93, 0x44, 0x8e, 0x6b, 0x8d, 0x2e, 0x3b, 0x4b, 0x77, 0x5b, 0x1f, 0xb5, 0x3e, 0x3a, 0x74,
-	0x16, 0x3a, 0x9b, 0xc6, 0x44, 0xed, 0x37, 0x3c, 0x89, 0x1f, 0x94, 0x24, 0xdd, 0x6b, 0x48, 0xd2,
-	0xbd, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x05, 0xf8, 0x88, 0x1b, 0x19, 0x00, 0x00,
+	// 3487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0x4d, 0x73, 0xdc, 0xc6,
+	0xb1, 0x85, 0x5d, 0x2e, 0xc9, 0xed, 0xe5, 0xe7, 0x90, 0x5c, 0x82, 0xa0, 0x24, 0xd3, 0x90, 0x2c,
+	0xeb, 0xf1, 0x3d, 0x71, 0xad, 0xd5, 0x7b, 0x7e, 0xcf, 0xf2, 0xe1, 0x99, 0xfa, 0xb0, 0x2c, 0x89,
+	0x96, 0x15, 0x50, 0x96, 0x3f, 0x92, 0x58, 0x19, 0x62, 0x87, 0x4b, 0x48, 0x58, 0x60, 0x83, 0x99,
+	0xe5, 0x47, 0x5c, 0x3e, 0x24, 0xb7, 0x54, 0xe5, 0x94, 0xe4, 0x96, 0x54, 0x52, 0x95, 0x3f, 0x91,
+	0x6b, 0x3e, 0x4e, 0x39, 0xe4, 0x94, 0xaa, 0xdc, 0x53, 0x95, 0xfc, 0x88, 0x54, 0xa5, 0x2a, 0xa9,
+	0xf9, 0x02, 0x30, 0x00, 0x96, 0x66, 0x59, 0x76, 0x4e, 0x3e, 0x2d, 0xba, 0xa7, 0xa7, 0xbb, 0xa7,
+	0xbb, 0xa7, 0xa7, 0x7b, 0x66, 0x61, 0xbd, 0x8f, 0x43, 0x4c, 0xc9, 0x33, 0x7c, 0xc0, 0x48, 0x82,
+	0xaf, 0x52, 0x92, 0x1c, 0x06, 0x3e, 0xd9, 0x1a, 0x26, 0x31, 0x8b, 0x51, 0x6d, 0xb8, 0xe7, 0x9c,
+	0xeb, 0xc7, 0x71, 0x3f, 0x24, 0x1d, 0x3c, 0x0c, 0x3a, 0x38, 0x8a, 0x62, 0x86, 0x59, 0x10, 0x47,
+	0x54, 0x52, 0x38, 0xff, 0x25, 0x7e, 0xfc, 0xab, 0x7d, 0x12, 0x5d, 0xa5, 0x47, 0xb8, 0xdf, 0x27,
+	0x49, 0x27, 0x1e, 0x0a, 0x8a, 0x0a, 0xea, 0x75, 0xc5, 0x4b, 0x40, 0x7b, 0xa3, 0xfd, 0x0e, 0x19,
+	0x0c, 0xd9, 0x89, 0x1a, 0xdc, 0x28, 0x0e, 0xee, 0x07, 0x24, 0xec, 0x3d, 0x1d, 0x60, 0xfa, 0x5c,
+	0x51, 0x9c, 0x2b, 0x52, 0x50, 0x96, 0x8c, 0x7c, 0x26, 0x47, 0xdd, 0xb7, 0x60, 0x66, 0x27, 0xee,
+	0x07, 0x91, 0x47, 0xbe, 0x3b, 0x22, 0x94, 0xa1, 0x65, 0x68, 0x90, 0x01, 0x0e, 0x42, 0xdb, 0xda,
+	0xb0, 0xae, 0x34, 0x3d, 0x09, 0x20, 0x07, 0xa6, 0x87, 0x98, 0xd2, 0xa3, 0x38, 0xe9, 0xd9, 0x35,
+	0x31, 0x90, 0xc2, 0xee, 0xcf, 0x2c, 0x98, 0x55, 0x2c, 0xe8, 0x30, 0x8e, 0x28, 0x41, 0x1b, 0xd0,
+	0xc2, 0xbe, 0x4f, 0x28, 0x7d, 0x1c, 0x3f, 0x27, 0x91, 0xe2, 0x94, 0x47, 0x21, 0x17, 0x66, 0x12,
+	0xb2, 0x9f, 0x10, 0x7a, 0x20, 0x49, 0x24, 0x4f, 0x03, 0xc7, 0xb9, 0xf4, 0x02, 0x3a, 0x0c, 0xf1,
+	0xc9, 0x43, 0x3c, 0x20, 0x76, 0x5d, 0x72, 0xc9, 0xa1, 0x10, 0x82, 0x89, 0x24, 0x0e, 0x89, 0x3d,
+	0x21, 0x86, 0xc4, 0x37, 0x9a, 0x83, 0x5a, 0xd0, 0xb3, 0x1b, 0x02, 0x53, 0x0b, 0x7a, 0xee, 0x07,
+	0x30, 0xef, 0x49, 0xae, 0x5f, 0xae, 0x7a, 0xee, 0x9f, 0x2d, 0x68, 0xdc, 0x8a, 0x0f, 0x83, 0x9e,
+	0x12, 0x69, 0x69, 0x91, 0xdc, 0x84, 0x2c, 0x60, 0x21, 0x51, 0xd3, 0x24, 0x80, 0x16, 0xa0, 0x4e,
+	0x83, 0x9e, 0x58, 0x46, 0xdd, 0xe3, 0x9f, 0x68, 0x13, 0x16, 0x83, 0x01, 0xee, 0x93, 0xa7, 0xfb,
+	0x04, 0xb3, 0xa7, 0x34, 0x88, 0xfa, 0xe9, 0x5a, 0xe6, 0xc5, 0xc0, 0xdb, 0x04, 0xb3, 0x5d, 0x81,
+	0x46, 0x36, 0x4c, 0xd1, 0xd1, 0x60, 0x80, 0x93, 0x13, 0xb5, 0x36, 0x0d, 0xa2, 0x75, 0x68, 0xf6,
+	0x30, 0x23, 0x4f, 0x87, 0xa3, 0xbd, 0xae, 0x3d, 0x29, 0x7d, 0xc3, 0x11, 0x8f, 0x46, 0x7b, 0x5d,
+	0x3e, 0xcd, 0x8f, 0x23, 0x46, 0x22, 0x66, 0x4f, 0xc9, 0x69, 0x0a, 0xe4, 0x23, 0x11, 0x39, 0xa2,
+	0xef, 0x27, 0xa1, 0x3d, 0x2d, 0x47, 0x14, 0xe8, 0xfe, 0xc8, 0x82, 0xe9, 0xdb, 0x01, 0x65, 0x49,
+	0xe0, 0x33, 0x6e, 0xe2, 0x88, 0x5b, 0x5f, 0xae, 0x4e, 0x7c, 0x8b, 0xf5, 0xc5, 0x0c, 0x87, 0x62,
+	0x7d, 0x75, 0x4f, 0x02, 0xa8, 0x0d, 0x93, 0xd8, 0x67, 0xc1, 0x21, 0x51, 0x4b, 0x54, 0x90, 0xa0,
+	0x4e, 0x48, 0xd4, 0x53, 0x2b, 0x93, 0x80, 0x70, 0x5d, 0x40, 0x9f, 0xab, 0xc5, 0x88, 0x6f, 0x4e,
+	0x49, 0x19, 0x66, 0x44, 0xad, 0x42, 0x02, 0xee, 0x2f, 0x2d, 0x68, 0xec, 0xf2, 0xaf, 0x7f, 0xab,
+	0x2e, 0x9b, 0xd0, 0xec, 0x29, 0x1b, 0x50, 0x7b, 0x72, 0xa3, 0x7e, 0xa5, 0xd5, 0x9d, 0xd9, 0x1a,
+	0xee, 0x6d, 0x69, 0xc3, 0x78, 0xd9, 0xb0, 0xfb, 0x1b, 0x0b, 0x26, 0x3d, 0x32, 0x8c, 0x13, 0x56,
+	0x0a, 0x85, 0x36, 0x4c, 0x8e, 0x28, 0x49, 0xee, 0xe9, 0x5d, 0xa3, 0x20, 0x74, 0x0e, 0x9a, 0x7e,
+	0x42, 0x30, 0x23, 0xbd, 0x6d, 0xa6, 0x74, 0xcc, 0x10, 0xe8, 0x02, 0xc0, 0x01, 0xa6, 0xbb, 0x27,
+	0x83, 0x21, 0x8b, 0x07, 0x42, 0xd7, 0x69, 0x2f, 0x87, 0xe1, 0xbe, 0x4b, 0x08, 0x1d, 0x85, 0x8c,
+	0xda, 0x8d, 0x8d, 0xfa, 0x95, 0x69, 0x4f, 0x83, 0x7c, 0xe4, 0x90, 0x24, 0x34, 0x88, 0x23, 0x61,
+	0xc4, 0xba, 0xa7, 0x41, 0x2e, 0xb1, 0x47, 0x42, 0x22, 0x25, 0x4e, 0x49, 0x89, 0x29, 0xc2, 0xfd,
+	0x45, 0x0d, 0x26, 0xde, 0xa7, 0x24, 0x29, 0x2d, 0x40, 0x6f, 0xb1, 0x5a, 0x6e, 0x8b, 0xa5, 0x29,
+	0xa2, 0x31, 0x2e, 0x45, 0x4c, 0x99, 0x29, 0x82, 0xef, 0xb8, 0x10, 0x53, 0xf6, 0xfe, 0x90, 0x07,
+	0x66, 0x4f, 0x04, 0x5c, 0xdd, 0xcb, 0xa3, 0xf8, 0xee, 0x08, 0x31, 0xb3, 0x9b, 0x1b, 0xd6, 0x15,
+	0xcb, 0xe3, 0x9f, 0x5c, 0x72, 0x18, 0x47, 0x7d, 0x1b, 0x04, 0x4a, 0x7c, 0x73, 0x1c, 0x0b, 0x06,
+	0xc4, 0x9e, 0x11, 0x0c, 0xc4, 0x37, 0x97, 0x1b, 0xd0, 0x6d, 0xe9, 0xed, 0x59, 0x61, 0xaa, 0x14,
+	0x4e, 0x23, 0x66, 0x2e, 0x17, 0x31, 0x0e, 0x4c, 0x73, 0xd7, 0xe1, 0xc8, 0x27, 0xf6, 0xbc, 0xe0,
+	0x9d, 0xc2, 0x79, 0xf3, 0x2d, 0x18, 0xe6, 0x73, 0xff, 0x1f, 0x56, 0xef, 0x12, 0xf6, 0x48, 0x2d,
+	0xc8, 0x23, 0x94, 0x30, 0x9d, 0x31, 0x2b, 0xb6, 0xbf, 0x34, 0x4f, 0x2d, 0x67, 0x1e, 0xf7, 0x01,
+	0xac, 0xc8, 0xb5, 0x66, 0x3c, 0xe4, 0xf4, 0x2c, 0x44, 0x2c, 0x23, 0x44, 0x4e, 0x4b, 0xb9, 0xff,
+	0x0d, 0x76, 0x59, 0x1b, 0x95, 0xdd, 0x6c, 0x98, 0x1a, 0x10, 0x4a, 0x71, 0x5f, 0x6f, 0x14, 0x0d,
+	0xba, 0xbf, 0xad, 0xc1, 0xfc, 0x5d, 0xc2, 0xb8, 0x9f, 0xa9, 0x96, 0x8e, 0x60, 0x22, 0x60, 0x64,
+	0xa0, 0xf7, 0x14, 0xff, 0xe6, 0x0b, 0x88, 0x93, 0x1e, 0x49, 0xf4, 0x02, 0x04, 0xc0, 0x29, 0xf7,
+	0x93, 0x78, 0xa0, 0xa2, 0x55, 0x7c, 0xf3, 0xa5, 0xb3, 0x58, 0x04, 0x68, 0xdd, 0xab, 0xb1, 0x98,
+	0x07, 0xee, 0x7e, 0x10, 0x32, 0x92, 0xdc, 0xe3, 0x3c, 0x65, 0x78, 0xe4, 0x30, 0x3c, 0x0e, 0x24,
+	0xf4, 0x04, 0x87, 0x23, 0xbd, 0xcf, 0xf3, 0x28, 0x1e, 0x07, 0x41, 0x8f, 0xda, 0x53, 0x1b, 0xf5,
+	0x2b, 0x4d, 0x8f, 0x7f, 0xf2, 0xf5, 0x48, 0x02, 0x6a, 0x4f, 0x0b, 0xac, 0x06, 0x45, 0xbe, 0x88,
+	0x13, 0x46, 0xed, 0xa6, 0xc0, 0x4b, 0x40, 0xda, 0xad, 0x4f, 0x76, 0x83, 0xef, 0x11, 0x11, 0x3b,
+	0x75, 0x2f, 0x85, 0xf9, 0x26, 0xe0, 0xdf, 0x32, 0xa9, 0xb7, 0x84, 0xf4, 0x0c, 0xc1, 0xb3, 0x7e,
+	0x10, 0xf9, 0xe1, 0xa8, 0x47, 0x1e, 0x8b, 0x94, 0x32, 0x23, 0xa2, 0xc9, 0xc0, 0xb9, 0x1b, 0x30,
+	0xa7, 0x4c, 0x38, 0xc6, 0xfd, 0xee, 0x36, 0x2c, 0xde, 0x12, 0x3b, 0xf9, 0x14, 0x22, 0x74, 0x0e,
+	0x26, 0x7a, 0x98, 0x61, 0x61, 0xe1, 0x56, 0x77, 0x9a, 0x67, 0x16, 0x41, 0x2e, 0xb0, 0xee, 0x67,
+	0xb0, 0x28, 0x63, 0xe5, 0x0b, 0xb3, 0x40, 0x37, 0x00, 0x46, 0x82, 0xc5, 0xbb, 0x98, 0x3e, 0x17,
+	0x3e, 0x6b, 0x75, 0x9d, 0x2d, 0x59, 0x09, 0x6c, 0xe9, 0x4a, 0x60, 0xeb, 0x6d, 0x5e, 0x2b, 0x70,
+	0x0a, 0x2f, 0x47, 0xed, 0xfe, 0xd3, 0x02, 0x94, 0xc9, 0x4f, 0x43, 0x45, 0xb9, 0xc6, 0xca, 0x5c,
+	0xf3, 0x95, 0xa9, 0x80, 0xde, 0x82, 0x69, 0xb5, 0xf3, 0xa8, 0x3d, 0x21, 0xb2, 0xef, 0x25, 0xc1,
+	0xbd, 0xa4, 0xd5, 0xd6, 0x13, 0x45, 0x76, 0x27, 0x62, 0xc9, 0x89, 0x97, 0xce, 0x72, 0xde, 0x84,
+	0x59, 0x63, 0x88, 0xab, 0xff, 0x9c, 0x9c, 0x28, 0x03, 0xf2, 0x4f, 0x1e, 0x3f, 0x87, 0x22, 0x0e,
+	0xd5, 0xd9, 0x21, 0x80, 0x1b, 0xb5, 0xff, 0xb3, 0xdc, 0x8b, 0xb0, 0x78, 0x5b, 0xe4, 0xc6, 0xd3,
+	0x1c, 0x7d, 0x19, 0x50, 0x46, 0x34, 0xde, 0x4a, 0x6e, 0x37, 0x4f, 0x97, 0x6e, 0x53, 0x6d, 0x3b,
+	0xab, 0x32, 0x02, 0x42, 0x58, 0xc8, 0x76, 0x6a, 0x69, 0x46, 0xbd, 0xc2, 0xda, 0xd5, 0x07, 0xe1,
+	0x25, 0x98, 0x8d, 0xc8, 0x31, 0x7b, 0x94, 0x06, 0xbd, 0xac, 0xa2, 0x4c, 0xa4, 0xdb, 0x49, 0xf3,
+	0xc2, 0x19, 0xd5, 0xeb, 0x02, 0xca, 0xc7, 0xf8, 0x59, 0xe7, 0xe4, 0x83, 0xfa, 0x4c, 0x73, 0xde,
+	0x80, 0x79, 0xe5, 0xc4, 0x5b, 0x71, 0xb4, 0x1f, 0xf2, 0x82, 0xe4, 0x32, 0xcc, 0xf9, 0xa3, 0x24,
+	0x21, 0x11, 0x53, 0x23, 0x62, 0x6a, 0xdd, 0x2b, 0x60, 0xdd, 0x07, 0xd0, 0xba, 0x89, 0x99, 0xcf,
+	0xab, 0xbe, 0x51, 0xc8, 0xaa, 0xce, 0x35, 0x3f, 0xee, 0x49, 0xd7, 0x37, 0x3c, 0xf1, 0x9d, 0xcf,
+	0x9c, 0x75, 0x33, 0x73, 0x3e, 0x86, 0x25, 0x23, 0xf4, 0x94, 0xf2, 0x28, 0xe7, 0x91, 0xa6, 0xf2,
+	0xc3, 0x7f, 0x64, 0x67, 0x73, 0x4d, 0x38, 0x6a, 0x9e, 0xaf, 0x29, 0xa7, 0x4a, 0x7a, 0x58, 0x73,
+	0xae, 0x46, 0x00, 0x7d, 0x39, 0x5c, 0x2f, 0x01, 0xf2, 0x08, 0x65, 0x71, 0x72, 0x6a, 0xf0, 0x5e,
+	0x87, 0x25, 0x83, 0xea, 0x4c, 0xee, 0x58, 0x87, 0xa5, 0x3b, 0xc7, 0xbc, 0xce, 0x79, 0xf7, 0xe4,
+	0x36, 0x66, 0x58, 0xf1, 0xbe, 0x3f, 0x31, 0x6d, 0x2d, 0xd4, 0xdc, 0x37, 0x61, 0x6a, 0x97, 0x50,
+	0x51, 0x6b, 0xf0, 0x63, 0xfa, 0x64, 0x98, 0x16, 0x6a, 0xfc, 0x9b, 0xa7, 0x5e, 0x72, 0x3c, 0x0c,
+	0x12, 0x42, 0xb7, 0x99, 0x8a, 0xd1, 0x0c, 0xe1, 0xc6, 0x30, 0xbf, 0x13, 0xfb, 0xa2, 0xeb, 0x79,
+	0x27, 0xe0, 0x6a, 0x9d, 0xe8, 0x8a, 0xc0, 0x2a, 0x57, 0x04, 0xb5, 0x5c, 0x45, 0x60, 0xc3, 0x54,
+	0x9f, 0xc4, 0x07, 0x98, 0x1e, 0x68, 0x9f, 0x29, 0xd0, 0x2c, 0xb1, 0x26, 0x0a, 0x25, 0x16, 0xaf,
+	0xde, 0x97, 0xcd, 0xb5, 0x28, 0x0b, 0x5c, 0x00, 0x20, 0x02, 0x2f, 0xe6, 0xc9, 0xd8, 0xca, 0x61,
+	0xb8, 0x85, 0xf8, 0x01, 0x5d, 0xce, 0x79, 0x1c, 0x8b, 0x2e, 0x71, 0x3f, 0x0d, 0xc5, 0xa1, 0x54,
+	0x17, 0x7e, 0x02, 0x4e, 0x20, 0x8b, 0x43, 0x4f, 0x0f, 0xa1, 0x57, 0x61, 0x9a, 0x12, 0x9a, 0xcf,
+	0x6e, 0x2d, 0x4e, 0xa6, 0xcc, 0xe7, 0xa5, 0x83, 0xe8, 0x1a, 0x34, 0x43, 0x65, 0x16, 0x59, 0xea,
+	0xb5, 0xba, 0x4b, 0x9c, 0xb2, 0x60, 0x2b, 0x2f, 0xa3, 0x72, 0xcf, 0xc3, 0xca, 0x9d, 0x04, 0x53,
+	0xf2, 0xee, 0xc9, 0xb6, 0xef, 0xc7, 0xa3, 0x88, 0x99, 0x5e, 0x22, 0xb0, 0x72, 0x97, 0xb0, 0x87,
+	0x04, 0x27, 0x7b, 0x27, 0x46, 0xde, 0xd2, 0xeb, 0xb2, 0x2a, 0xd7, 0xd5, 0x86, 0xc9, 0x04, 0xf7,
+	0x82, 0x11, 0x55, 0xc6, 0x57, 0x10, 0xc7, 0x0f, 0xf0, 0xf1, 0x76, 0x3f, 0x2d, 0xb4, 0x25, 0xe4,
+	0x7e, 0x02, 0xed, 0xa2, 0x98, 0xac, 0x3c, 0xe1, 0x1c, 0x1f, 0x8e, 0x06, 0x2a, 0x0a, 0x34, 0x88,
+	0x2e, 0x41, 0xc3, 0x27, 0x61, 0xa8, 0x2d, 0x37, 0xc7, 0x55, 0x90, 0x1c, 0x6e, 0x91, 0x30, 0xf4,
+	0xe4, 0xa0, 0x5a, 0xc6, 0x1f, 0x2d, 0x58, 0xbc, 0x4b, 0xd8, 0x3b, 0x04, 0xb3, 0x01, 0x1e, 0xe6,
+	0x4a, 0xa9, 0x41, 0x10, 0xed, 0xa4, 0x51, 0xa3, 0x20, 0xb1, 0xb1, 0x83, 0x68, 0x27, 0x8b, 0x1d,
+	0x0d, 0x2a, 0xfd, 0xf9, 0x8c, 0xba, 0x9a, 0x81, 0x8f, 0xf5, 0x0c, 0x7c, 0x2c, 0x66, 0x4c, 0xa8,
+	0x19, 0x12, 0xe4, 0x61, 0x45, 0x19, 0x4e, 0xd8, 0x63, 0x5e, 0x87, 0x36, 0x64, 0x58, 0xa5, 0x08,
+	0x3e, 0x8f, 0x44, 0x3d, 0x31, 0xa6, 0xea, 0x6f, 0x05, 0xf2, 0x79, 0xc3, 0x84, 0xf8, 0x81, 0x48,
+	0x59, 0x53, 0x22, 0xeb, 0x64, 0x08, 0xd7, 0x87, 0x96, 0x5a, 0x0b, 0x5f, 0x6b, 0x3e, 0xaa, 0x2d,
+	0x33, 0xaa, 0xd5, 0xae, 0xa8, 0x95, 0x77, 0x45, 0x3d, 0xb7, 0x2b, 0x96, 0xa1, 0x21, 0x7c, 0xaf,
+	0xe2, 0x5e, 0x02, 0xee, 0x1b, 0x80, 0xf2, 0x36, 0x53, 0x0e, 0xb9, 0x68, 0x1c, 0x2b, 0x22, 0xaf,
+	0xe4, 0x54, 0x51, 0x3b, 0xff, 0xe7, 0x16, 0x40, 0xe6, 0x8b, 0xaf, 0x46, 0x3f, 0xd1, 0xa2, 0x04,
+	0x09, 0xf1, 0x79, 0x20, 0xab, 0xe2, 0x31, 0x43, 0x18, 0x75, 0xfb, 0xa4, 0x59, 0xb7, 0xbb, 0xbb,
+	0x30, 0x75, 0x97, 0x44, 0x24, 0xc1, 0x21, 0x3f, 0x1f, 0xc4, 0xd1, 0xc7, 0x0f, 0x8c, 0x20, 0x19,
+	0x90, 0x9e, 0x3e, 0x1f, 0x4c, 0xac, 0xbc, 0x04, 0xe0, 0x4d, 0xc2, 0x2d, 0x4c, 0x09, 0x55, 0xb1,
+	0x98, 0x47, 0xb9, 0xd7, 0xc5, 0x19, 0xfc, 0x80, 0x7f, 0xa7, 0xc6, 0x7a, 0xc9, 0xc8, 0x8f, 0x62,
+	0xd7, 0x2a, 0xc1, 0xca, 0x50, 0xaf, 0x8b, 0xc0, 0xf7, 0x88, 0x4f, 0xa2, 0xc2, 0xd4, 0x8a, 0xe3,
+	0x9b, 0x13, 0xa8, 0x79, 0x97, 0x84, 0x6f, 0xd2, 0xee, 0x72, 0x4c, 0xd6, 0xee, 0xc3, 0x92, 0x41,
+	0x95, 0x5e, 0x68, 0xe4, 0xb5, 0x32, 0xfb, 0x54, 0x31, 0x82, 0xb6, 0xa0, 0x45, 0x47, 0xfd, 0x3e,
+	0xa1, 0x32, 0x95, 0xd4, 0x2a, 0x1a, 0xda, 0x3c, 0x81, 0x7b, 0x19, 0x96, 0xef, 0x12, 0x9e, 0x1a,
+	0x3f, 0x47, 0xa1, 0xf7, 0x60, 0xa5, 0x40, 0xa7, 0x54, 0x4a, 0x7b, 0x79, 0x2b, 0xd7, 0xcb, 0xa7,
+	0x8a, 0xd6, 0xc6, 0x29, 0xea, 0x7e, 0x08, 0x2b, 0x3b, 0x01, 0x4d, 0x97, 0x98, 0x99, 0xef, 0xbc,
+	0x61, 0xbe, 0xa6, 0xc8, 0x97, 0x9c, 0xa7, 0x5a, 0x60, 0xa1, 0xc3, 0xac, 0x95, 0x3a, 0x4c, 0xd7,
+	0x87, 0xb5, 0x9c, 0xed, 0x74, 0xe6, 0x1c, 0x5b, 0x5c, 0xe7, 0x76, 0x79, 0xed, 0x94, 0x5d, 0x5e,
+	0x37, 0x76, 0xb9, 0x7b, 0x02, 0xf3, 0x05, 0x09, 0x45, 0xcd, 0xac, 0x72, 0xef, 0x5b, 0x5d, 0xba,
+	0x2d, 0x43, 0xa3, 0x47, 0x42, 0x86, 0x95, 0x08, 0x09, 0x70, 0xd1, 0xf8, 0x90, 0x24, 0xbc, 0x46,
+	0x51, 0x89, 0x49, 0x81, 0xee, 0x47, 0xe0, 0x54, 0xad, 0x2f, 0x2b, 0x2a, 0x4a, 0x77, 0x27, 0xaf,
+	0xa6, 0xde, 0x48, 0x0f, 0x96, 0xe2, 0x74, 0xe9, 0x94, 0x9f, 0x5a, 0x30, 0xc1, 0x63, 0x15, 0xbd,
+	0x0c, 0x33, 0x5c, 0xf1, 0xa7, 0xa3, 0xdc, 0x62, 0x9a, 0xe6, 0x62, 0xce, 0x03, 0x44, 0xe4, 0xe8,
+	0x69, 0x8f, 0x60, 0x76, 0xa0, 0xb7, 0x55, 0x33, 0x22, 0x47, 0xb7, 0x05, 0x02, 0xbd, 0x02, 0x73,
+	0x7c, 0x38, 0x88, 0xf6, 0xe5, 0xb6, 0xa6, 0x6a, 0x79, 0xb3, 0x11, 0x39, 0xba, 0x97, 0x22, 0xd1,
+	0x45, 0x5e, 0xb7, 0x1e, 0x3d, 0x4d, 0x88, 0x1f, 0x1f, 0x92, 0x84, 0xf4, 0x54, 0xa2, 0x98, 0x89,
+	0xc8, 0x91, 0xa7, 0x71, 0xee, 0xef, 0x6a, 0x62, 0x87, 0x8a, 0x4b, 0xb8, 0xaf, 0x1b, 0xda, 0x2f,
+	0xd8, 0xd0, 0xbe, 0x0c, 0xf3, 0xda, 0x86, 0xe3, 0x36, 0x79, 0x04, 0x8b, 0x9a, 0xe4, 0xd4, 0xfd,
+	0x28, 0x99, 0xbc, 0x78, 0x3b, 0x72, 0x2d, 0x73, 0x6b, 0x85, 0x38, 0xab, 0x42, 0x9c, 0xfb, 0xfb,
+	0x9a, 0xd0, 0x51, 0x16, 0x5a, 0x5f, 0xc7, 0xc2, 0x17, 0x8c, 0x05, 0x17, 0x16, 0x52, 0x23, 0x8e,
+	0x0b, 0x86, 0x3b, 0xb0, 0x24, 0x5b, 0xbf, 0x53, 0xc9, 0xd0, 0x05, 0x23, 0xd3, 0xe7, 0xab, 0x60,
+	0xe9, 0xb0, 0xef, 0x5b, 0xba, 0xa5, 0x7a, 0x21, 0x3e, 0x2f, 0x74, 0xcf, 0xf1, 0x07, 0x0b, 0x96,
+	0xf3, 0x3a, 0x9c, 0x72, 0xd3, 0xf1, 0x79, 0x6a, 0xdc, 0xcc, 0xdd, 0x57, 0xc8, 0xf2, 0xf5, 0x72,
+	0x76, 0x5f, 0x61, 0x72, 0xff, 0x6a, 0x6e, 0x2c, 0x5e, 0xd1, 0xbd, 0xe4, 0xe9, 0xde, 0xbb, 0x02,
+	0xcb, 0x79, 0xb2, 0x53, 0x6e, 0x2d, 0x5e, 0x37, 0x29, 0x73, 0xfd, 0x51, 0x7e, 0x23, 0x96, 0x1d,
+	0x3b, 0x14, 0x85, 0x4c, 0xca, 0xbe, 0x34, 0xab, 0x5e, 0x69, 0xbf, 0x17, 0x49, 0x17, 0xd7, 0x73,
+	0x5b, 0xff, 0xcc, 0x6a, 0xbe, 0x0e, 0xcb, 0x66, 0x18, 0x9f, 0x7d, 0x9e, 0x19, 0xb6, 0x67, 0x9c,
+	0xf7, 0x44, 0x5f, 0xff, 0x16, 0x2d, 0xf3, 0x82, 0xdd, 0xfe, 0x13, 0x58, 0x29, 0x38, 0xf4, 0xcb,
+	0xe1, 0x7b, 0x19, 0x96, 0xd5, 0xfd, 0xc0, 0xe9, 0x01, 0xf5, 0xbf, 0xb0, 0x52, 0xa0, 0x3b, 0xa3,
+	0x41, 0x7e, 0x58, 0x83, 0xfa, 0xfd, 0x78, 0xaf, 0xb2, 0x30, 0x41, 0x30, 0x41, 0x87, 0xc4, 0xd7,
+	0x8f, 0x0e, 0xfc, 0x9b, 0x67, 0x4a, 0x7e, 0xdd, 0x1f, 0x8f, 0xf4, 0x7b, 0x89, 0x06, 0xf9, 0x08,
+	0x2f, 0x40, 0xbc, 0x51, 0xa4, 0x92, 0xb5, 0x06, 0x79, 0xce, 0xe3, 0x9f, 0xb7, 0x47, 0x09, 0x4e,
+	0x7b, 0x8a, 0xba, 0x67, 0xe0, 0x78, 0xd6, 0xe4, 0xf0, 0x9d, 0x24, 0x89, 0x13, 0x95, 0xb3, 0x33,
+	0x84, 0x7c, 0x25, 0x3b, 0x16, 0xbc, 0xe5, 0x9b, 0x89, 0x06, 0xf9, 0x48, 0x32, 0x8a, 0xa2, 0x20,
+	0xea, 0x8b, 0xe7, 0x8c, 0x69, 0x4f, 0x83, 0xe2, 0x84, 0x39, 0x8a, 0x48, 0x62, 0x37, 0xd5, 0x09,
+	0xc3, 0x01, 0x9e, 0xb9, 0x93, 0x51, 0x74, 0x4b, 0x74, 0x3d, 0x2a, 0x73, 0x6b, 0xd8, 0xed, 0xc0,
+	0x02, 0x2f, 0x7a, 0xef, 0xc7, 0x7b, 0x99, 0xff, 0xd6, 0x8d, 0x1d, 0x33, 0xc5, 0xed, 0x77, 0x3f,
+	0xde, 0x53, 0xc6, 0x7b, 0x15, 0x16, 0x1f, 0x27, 0x01, 0x7f, 0x36, 0xe6, 0xb8, 0xec, 0xb4, 0x2b,
+	0x5a, 0xd2, 0xbd, 0x06, 0x28, 0x4f, 0x58, 0xe2, 0x6d, 0x95, 0x79, 0xff, 0xdd, 0x82, 0xc6, 0xf6,
+	0xa8, 0x17, 0x54, 0x3e, 0x6c, 0x60, 0x9f, 0xc5, 0xe9, 0xd1, 0x29, 0x00, 0xfd, 0xd6, 0x16, 0xeb,
+	0xdd, 0xa9, 0x20, 0x8e, 0x67, 0x38, 0xe9, 0x13, 0xa6, 0x1e, 0xdb, 0x14, 0x84, 0x3a, 0x30, 0xb9,
+	0x47, 0xf6, 0xe3, 0x44, 0x76, 0xcf, 0xad, 0xee, 0x6a, 0x29, 0x5b, 0xef, 0x8a, 0xf7, 0x69, 0x4f,
+	0x91, 0xa1, 0xab, 0xd0, 0xc0, 0xfb, 0x8c, 0x48, 0xef, 0x9c, 0x42, 0x2f, 0xa9, 0xb8, 0x43, 0x13,
+	0x69, 0x91, 0x7b, 0xfa, 0x21, 0x2a, 0x43, 0x98, 0xb7, 0x42, 0xd3, 0xc5, 0x5b, 0xa1, 0x5f, 0x59,
+	0x22, 0x7b, 0x89, 0xe5, 0xef, 0xc4, 0x7d, 0x6d, 0xd9, 0xdc, 0x29, 0x6d, 0x8d, 0x39, 0xa5, 0x6b,
+	0xe3, 0x4e, 0xe9, 0xfa, 0x69, 0xa7, 0xf4, 0xc4, 0xe7, 0x9d, 0xd2, 0x8d, 0x8a, 0x53, 0x7a, 0x08,
+	0x4b, 0x86, 0x8e, 0xe3, 0x0b, 0x32, 0x41, 0xf3, 0xe2, 0x19, 0xb6, 0xfb, 0x8f, 0x35, 0x58, 0xbe,
+	0x8b, 0x43, 0xbc, 0xab, 0xff, 0xf0, 0xb0, 0x2b, 0xff, 0xef, 0x80, 0x76, 0xa0, 0x99, 0x56, 0x86,
+	0x68, 0x59, 0x76, 0xc3, 0x66, 0x3d, 0xee, 0xac, 0x14, 0xb0, 0x52, 0x5b, 0x17, 0xfd, 0xe0, 0x4f,
+	0x7f, 0xfd, 0x49, 0x6d, 0x06, 0x41, 0xe7, 0xf0, 0x5a, 0xc7, 0x97, 0x0c, 0x1e, 0xc2, 0xb4, 0x26,
+	0x44, 0x4b, 0xf9, 0x69, 0x9a, 0xd7, 0xb2, 0x89, 0x54, 0xac, 0x56, 0x05, 0xab, 0x45, 0x34, 0x9f,
+	0xb1, 0xea, 0x7c, 0x1a, 0xf4, 0x3e, 0x43, 0x4f, 0x60, 0xd6, 0xe8, 0x25, 0x51, 0xbb, 0x14, 0x3a,
+	0x77, 0xf8, 0x3f, 0x29, 0x9c, 0x35, 0x71, 0xa7, 0x56, 0xd5, 0x76, 0x9a, 0x7a, 0x0e, 0x43, 0xec,
+	0x13, 0x8a, 0x3e, 0x80, 0x56, 0xae, 0xd3, 0x42, 0x6d, 0xa5, 0x55, 0xa1, 0x57, 0x76, 0x56, 0x4b,
+	0xf8, 0x2a, 0x85, 0x25, 0x4f, 0xa9, 0x30, 0x33, 0x2e, 0x01, 0x74, 0x03, 0x79, 0xbe, 0xc0, 0xc7,
+	0x6c, 0x5d, 0x9d, 0x0b, 0xe3, 0x86, 0x95, 0xb4, 0x97, 0x84, 0xb4, 0x35, 0xb4, 0x5a, 0x90, 0xd6,
+	0x39, 0x50, 0xfc, 0xf7, 0x60, 0xd6, 0xe8, 0xe1, 0x91, 0xad, 0x38, 0x96, 0xda, 0x7f, 0x67, 0xad,
+	0x62, 0x44, 0x89, 0x39, 0x27, 0xc4, 0xb4, 0xd1, 0xb2, 0xf0, 0x42, 0x18, 0x90, 0x88, 0x69, 0x69,
+	0x03, 0x82, 0x9e, 0x8b, 0x67, 0xb3, 0xdc, 0x7d, 0x20, 0xd2, 0xac, 0xca, 0x57, 0x91, 0x8e, 0x53,
+	0x35, 0xa4, 0xc4, 0xb8, 0x42, 0xcc, 0x39, 0x77, 0x35, 0x27, 0x66, 0xc4, 0x29, 0x3a, 0x91, 0xa0,
+	0xbe, 0x61, 0x6d, 0xa2, 0x47, 0x00, 0xd9, 0x3d, 0x17, 0xd2, 0x01, 0x68, 0xde, 0x15, 0x3a, 0xed,
+	0x22, 0x5a, 0x09, 0x58, 0x12, 0x02, 0x66, 0x51, 0x8b, 0x0b, 0x38, 0x50, 0x3c, 0x1e, 0x88, 0xc8,
+	0x14, 0xf7, 0x39, 0x63, 0x83, 0x48, 0x07, 0xa7, 0x71, 0xeb, 0xe3, 0x2e, 0x0a, 0x76, 0x2d, 0xd4,
+	0xe4, 0xec, 0x9e, 0x0b, 0x06, 0xdf, 0x86, 0x39, 0xf3, 0x8a, 0x68, 0x2c, 0x4b, 0x6d, 0x88, 0x8a,
+	0xeb, 0x24, 0x33, 0x88, 0x12, 0x41, 0x20, 0xd9, 0x7f, 0x02, 0x33, 0xf9, 0xca, 0x06, 0x89, 0x30,
+	0xac, 0x28, 0xd9, 0x1d, 0xbb, 0x3c, 0xa0, 0x78, 0xaf, 0x0b, 0xde, 0x2b, 0xee, 0x82, 0xe4, 0xcd,
+	0xc7, 0x64, 0xcc, 0x64, 0xd6, 0x95, 0x33, 0x68, 0x6a, 0x5d, 0xb3, 0x9e, 0x74, 0xda, 0x45, 0x74,
+	0x95, 0x75, 0x15, 0x67, 0xe4, 0x41, 0x33, 0x25, 0x4d, 0xb3, 0x88, 0xa9, 0xeb, 0x4a, 0x01, 0xab,
+	0xd8, 0xd9, 0x82, 0x1d, 0x42, 0x25, 0x45, 0xd1, 0x31, 0xcc, 0xe4, 0xeb, 0x2d, 0x69, 0x85, 0x8a,
+	0x86, 0xc3, 0xb1, 0xcb, 0x03, 0x8a, 0xf9, 0xff, 0x08, 0xe6, 0x1d, 0xa7, 0xca, 0x0a, 0x1f, 0xdb,
+	0xdd, 0x32, 0x5a, 0x26, 0xda, 0x6f, 0xc1, 0x6c, 0x9e, 0x1d, 0x45, 0xf6, 0xb8, 0x4e, 0xc0, 0x59,
+	0xab, 0x18, 0x51, 0xc2, 0xdb, 0x42, 0xf8, 0x82, 0x93, 0x37, 0x14, 0xb7, 0xfe, 0x37, 0x61, 0x26,
+	0x5f, 0xef, 0xc9, 0x75, 0x55, 0x54, 0xfe, 0x8e, 0x5d, 0x1e, 0x30, 0x8d, 0xb6, 0x59, 0x36, 0xda,
+	0x47, 0x30, 0x9b, 0x9f, 0xa1, 0x54, 0xaf, 0x6a, 0x18, 0x9c, 0xb5, 0x8a, 0x11, 0xd3, 0xc7, 0x9b,
+	0x86, 0x8f, 0x03, 0x98, 0x35, 0xea, 0x44, 0xc9, 0xba, 0xaa, 0xc4, 0x74, 0xd6, 0x2a, 0x46, 0x14,
+	0xeb, 0x8b, 0x82, 0xf5, 0x79, 0xd7, 0x2e, 0xaa, 0xde, 0x49, 0x24, 0x3d, 0x37, 0xd1, 0x13, 0x80,
+	0xec, 0x71, 0x52, 0x06, 0x68, 0xe9, 0x41, 0xde, 0x69, 0x17, 0xd1, 0x4a, 0xc2, 0x9a, 0x90, 0xb0,
+	0xe4, 0xce, 0x71, 0x09, 0x32, 0xb1, 0xe8, 0xc0, 0x7f, 0x47, 0x24, 0x01, 0x99, 0xbd, 0xf4, 0xf1,
+	0x64, 0xe4, 0xad, 0x65, 0x13, 0x59, 0x95, 0x01, 0x04, 0x47, 0x74, 0x1f, 0xa6, 0x14, 0x19, 0x42,
+	0xb9, 0x39, 0x9a, 0xcf, 0x92, 0x81, 0x33, 0x03, 0x02, 0x15, 0x14, 0x43, 0x11, 0x40, 0xf6, 0x34,
+	0x29, 0x57, 0x5b, 0xfa, 0xef, 0x80, 0xd3, 0x2e, 0xa2, 0x15, 0xd3, 0x6b, 0x82, 0xe9, 0x7f, 0x3a,
+	0xe5, 0xd5, 0x7e, 0xdc, 0xee, 0x16, 0x91, 0x32, 0xbc, 0x1f, 0x43, 0x2b, 0x63, 0x44, 0x51, 0xbb,
+	0xfa, 0x59, 0xde, 0x59, 0x2d, 0xe1, 0x95, 0xc8, 0x65, 0x21, 0x72, 0xce, 0xc9, 0xcc, 0xc1, 0x6d,
+	0xbb, 0x0b, 0x90, 0x3d, 0x85, 0xca, 0x55, 0x94, 0x1e, 0xe0, 0x9d, 0x76, 0x11, 0x6d, 0x9a, 0x66,
+	0xb3, 0x68, 0x9a, 0x6f, 0x40, 0x2b, 0xa3, 0x56, 0xaa, 0x96, 0x5f, 0xec, 0x9d, 0xd5, 0x12, 0xde,
+	0xf4, 0xdc, 0x66, 0xce, 0x73, 0x18, 0x5a, 0xb9, 0x67, 0x53, 0xc9, 0xb2, 0xfc, 0xda, 0xea, 0xac,
+	0x96, 0xf0, 0x8a, 0xe5, 0xcb, 0x82, 0xe5, 0xba, 0xdb, 0x36, 0x55, 0xcd, 0x87, 0xaf, 0x0f, 0x33,
+	0xf9, 0x87, 0x49, 0xb9, 0xc3, 0x2b, 0x9e, 0x5d, 0x1d, 0xbb, 0x3c, 0xa0, 0xa4, 0x6c, 0x08, 0x29,
+	0x0e, 0xb2, 0x4b, 0x87, 0xe4, 0x80, 0x74, 0x84, 0x17, 0xbf, 0x03, 0x73, 0xe6, 0x2b, 0xa1, 0x3c,
+	0x8f, 0x2b, 0x5f, 0x0e, 0x9d, 0x31, 0xc7, 0x93, 0x3e, 0x26, 0x36, 0x97, 0x2a, 0xc4, 0xa0, 0x03,
+	0x58, 0x28, 0xfe, 0x45, 0x09, 0xad, 0xab, 0xc0, 0xae, 0xfa, 0x1b, 0x95, 0x73, 0xae, 0x7a, 0xd0,
+	0xdc, 0x97, 0x68, 0x91, 0xcb, 0xd2, 0xff, 0x83, 0x4a, 0x04, 0xd7, 0x67, 0x30, 0x67, 0xfe, 0xb3,
+	0x0a, 0xe5, 0xf2, 0x6a, 0xe1, 0xdf, 0x56, 0x63, 0xd7, 0xf2, 0x8a, 0xe0, 0xff, 0x92, 0xe3, 0x94,
+	0xf8, 0x77, 0x3e, 0x95, 0x7f, 0xc8, 0x12, 0x39, 0xe0, 0x26, 0x34, 0xc4, 0x5f, 0x5d, 0xd1, 0x82,
+	0x7c, 0x86, 0xcd, 0xfe, 0x38, 0xeb, 0x2c, 0xe6, 0x30, 0x66, 0xac, 0xbb, 0x22, 0x80, 0x42, 0x3e,
+	0xc4, 0x79, 0xec, 0xc0, 0xe4, 0x4e, 0xdc, 0xe7, 0x9d, 0xeb, 0xb8, 0x73, 0x7f, 0x9c, 0x92, 0xaa,
+	0x18, 0x75, 0x41, 0xf1, 0xe3, 0x3c, 0x1e, 0xc2, 0x94, 0xfa, 0x7f, 0xeb, 0x58, 0x76, 0x4b, 0x32,
+	0x1a, 0x8d, 0x3f, 0xc1, 0xea, 0x4d, 0xe3, 0xaa, 0x2c, 0x2d, 0x06, 0xe5, 0xf1, 0x3e, 0xad, 0x7b,
+	0xd1, 0xd3, 0x4b, 0x9d, 0x62, 0xc7, 0x6a, 0x66, 0x28, 0xdc, 0x1b, 0x04, 0x51, 0xe7, 0x19, 0xe7,
+	0x42, 0x00, 0xb2, 0x1e, 0x54, 0xee, 0xed, 0x52, 0xf3, 0xea, 0xb4, 0x8b, 0x68, 0xc5, 0xf4, 0x8a,
+	0x60, 0xea, 0xba, 0xe7, 0x4d, 0xa6, 0x9d, 0x4f, 0x79, 0x7b, 0xfb, 0x59, 0x87, 0xc9, 0x19, 0x5c,
+	0xf1, 0x0f, 0x45, 0x55, 0xae, 0xdb, 0xa2, 0xb4, 0x2a, 0x2f, 0xf4, 0x72, 0xce, 0x6a, 0x09, 0x5f,
+	0x55, 0x4b, 0x48, 0x49, 0x98, 0xd3, 0xd0, 0x9b, 0x7f, 0xb3, 0x7e, 0xbc, 0xfd, 0x17, 0x0b, 0xfd,
+	0xda, 0x82, 0x15, 0xa3, 0x0b, 0xda, 0xd0, 0x6d, 0xd0, 0xdb, 0x95, 0xe8, 0x8d, 0x03, 0x1c, 0xf5,
+	0x42, 0x42, 0x37, 0x54, 0xf7, 0x49, 0x37, 0xf8, 0xb5, 0xf2, 0x86, 0x49, 0x8b, 0x87, 0xc3, 0x30,
+	0x90, 0x6f, 0xf7, 0x5b, 0xee, 0x7b, 0xa8, 0x7b, 0xc0, 0xd8, 0x90, 0xde, 0xe8, 0x74, 0xfa, 0x01,
+	0x3b, 0x18, 0xed, 0x6d, 0xf9, 0xf1, 0xa0, 0xc3, 0xff, 0x6a, 0x7e, 0x35, 0xfd, 0xaf, 0x79, 0xc7,
+	0xfc, 0xe7, 0xf9, 0x1e, 0x71, 0xd6, 0xfc, 0x83, 0x20, 0x3a, 0x0e, 0xe2, 0xa8, 0x7f, 0x44, 0x82,
+	0xb7, 0x4e, 0xf0, 0x41, 0x1c, 0xf3, 0x79, 0x5b, 0x83, 0x93, 0x6e, 0xe3, 0xda, 0xd6, 0x6b, 0x5b,
+	0xaf, 0x6d, 0x5a, 0xb5, 0xee, 0x42, 0x4e, 0x50, 0xe7, 0x19, 0x8d, 0xa3, 0x1b, 0x25, 0xcc, 0xde,
+	0xa4, 0x70, 0xf3, 0xf5, 0x7f, 0x0d, 0x00, 0x04, 0xc2, 0xac, 0xfb, 0xdd, 0x2e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2785,10 +4958,18 @@ type GalaSejahteraServiceClient interface {
 	GetCovids(ctx context.Context, in *GetCovidsRequest, opts ...grpc.CallOption) (*GetCovidsResponse, error)
 	// Get Covid
 	GetCovid(ctx context.Context, in *GetCovidRequest, opts ...grpc.CallOption) (*GetCovidResponse, error)
+	// List Districts
+	ListDistricts(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListDistrictsResponse, error)
 	// Get District
 	GetDistrict(ctx context.Context, in *GetDistrictRequest, opts ...grpc.CallOption) (*GetDistrictResponse, error)
+	// Get District History
+	GetDistrictHistory(ctx context.Context, in *GetDistrictHistoryRequest, opts ...grpc.CallOption) (*GetDistrictHistoryResponse, error)
+	// Get My District
+	GetMyDistrict(ctx context.Context, in *GetMyDistrictRequest, opts ...grpc.CallOption) (*GetMyDistrictResponse, error)
 	// Get Nearby Users
 	GetNearbyUsers(ctx context.Context, in *GetNearbyUsersRequest, opts ...grpc.CallOption) (*GetNearbyUsersResponse, error)
+	// Get Heatmap
+	GetHeatmap(ctx context.Context, in *GetHeatmapRequest, opts ...grpc.CallOption) (*GetHeatmapResponse, error)
 	// Get Covid Kases
 	GetKases(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetKasesResponse, error)
 	// Get Recent Covid Kases
@@ -2807,6 +4988,8 @@ type GalaSejahteraServiceClient interface {
 	DeleteReport(ctx context.Context, in *DeleteReportRequest, opts ...grpc.CallOption) (*DeleteReportResponse, error)
 	// Delete Reports
 	DeleteReports(ctx context.Context, in *DeleteReportsRequest, opts ...grpc.CallOption) (*DeleteReportsResponse, error)
+	// Restore Report
+	RestoreReport(ctx context.Context, in *RestoreReportRequest, opts ...grpc.CallOption) (*RestoreReportResponse, error)
 	// Create User
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	// Get Users
@@ -2821,6 +5004,12 @@ type GalaSejahteraServiceClient interface {
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
 	// Delete Users
 	DeleteUsers(ctx context.Context, in *DeleteUsersRequest, opts ...grpc.CallOption) (*DeleteUsersResponse, error)
+	// Restore User
+	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error)
+	// Export My Data
+	ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (*ExportMyDataResponse, error)
+	// Erase My Account
+	EraseMyAccount(ctx context.Context, in *EraseMyAccountRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Get Password Reset
 	GetPasswordReset(ctx context.Context, in *GetPasswordResetRequest, opts ...grpc.CallOption) (*GetPasswordResetResponse, error)
 	// Update Password
@@ -2831,6 +5020,12 @@ type GalaSejahteraServiceClient interface {
 	Logout(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Refresh
 	Refresh(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RefreshResponse, error)
+	// List Jobs
+	ListJobs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	// Trigger Job
+	TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*TriggerJobResponse, error)
+	// Get Audit Log
+	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
 }
 
 type galaSejahteraServiceClient struct {
@@ -2859,6 +5054,15 @@ func (c *galaSejahteraServiceClient) GetCovid(ctx context.Context, in *GetCovidR
 	return out, nil
 }
 
+func (c *galaSejahteraServiceClient) ListDistricts(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListDistrictsResponse, error) {
+	out := new(ListDistrictsResponse)
+	err := c.cc.Invoke(ctx, "/pb.GalaSejahteraService/ListDistricts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *galaSejahteraServiceClient) GetDistrict(ctx context.Context, in *GetDistrictRequest, opts ...grpc.CallOption) (*GetDistrictResponse, error) {
 	out := new(GetDistrictResponse)
 	err := c.cc.Invoke(ctx, "/pb.GalaSejahteraService/GetDistrict", in, out, opts...)
@@ -2868,6 +5072,24 @@ func (c *galaSejahteraServiceClient) GetDistrict(ctx context.Context, in *GetDis
 	return out, nil
 }
 
+func (c *galaSejahteraServiceClient) GetDistrictHistory(ctx context.Context, in *GetDistrictHistoryRequest, opts ...grpc.CallOption) (*GetDistrictHistoryResponse, error) {
+	out := new(GetDistrictHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.GalaSejahteraService/GetDistrictHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaSejahteraServiceClient) GetMyDistrict(ctx context.Context, in *GetMyDistrictRequest, opts ...grpc.CallOption) (*GetMyDistrictResponse, error) {
+	out := new(GetMyDistrictResponse)
+	err := c.cc.Invoke(ctx, "/pb.GalaSejahteraService/GetMyDistrict", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *galaSejahteraServiceClient) GetNearbyUsers(ctx context.Context, in *GetNearbyUsersRequest, opts ...grpc.CallOption) (*GetNearbyUsersResponse, error) {
 	out := new(GetNearbyUsersResponse)
 	err := c.cc.Invoke(ctx, "/pb.GalaSejahteraService/GetNearbyUsers", in, out, opts...)
@@ -2877,6 +5099,15 @@ func (c *galaSejahteraServiceClient) GetNearbyUsers(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *galaSejahteraServiceClient) GetHeatmap(ctx context.Context, in *GetHeatmapRequest, opts ...grpc.CallOption) (*GetHeatmapResponse, error) {
+	out := new(GetHeatmapResponse)
+	err := c.cc.Invoke(ctx, "/pb.GalaSejahteraService/GetHeatmap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *galaSejahteraServiceClient) GetKases(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetKasesResponse, error) {
 	out := new(GetKasesResponse)
 	err := c.cc.Invoke(ctx, "/pb.GalaSejahteraService/GetKases", in, out, opts...)
@@ -2958,6 +5189,15 @@ func (c *galaSejahteraServiceClient) DeleteReports(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *galaSejahteraServiceClient) RestoreReport(ctx context.Context, in *RestoreReportRequest, opts ...grpc.CallOption) (*RestoreReportResponse, error) {
+	out := new(RestoreReportResponse)
+	err := c.cc.Invoke(ctx, "/pb.GalaSejahteraService/RestoreReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *galaSejahteraServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
 	out := new(CreateUserResponse)
 	err := c.cc.Invoke(ctx, "/pb.GalaSejahteraService/CreateUser", in, out, opts...)
@@ -3021,6 +5261,33 @@ func (c *galaSejahteraServiceClient) DeleteUsers(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *galaSejahteraServiceClient) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error) {
+	out := new(RestoreUserResponse)
+	err := c.cc.Invoke(ctx, "/pb.GalaSejahteraService/RestoreUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaSejahteraServiceClient) ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (*ExportMyDataResponse, error) {
+	out := new(ExportMyDataResponse)
+	err := c.cc.Invoke(ctx, "/pb.GalaSejahteraService/ExportMyData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaSejahteraServiceClient) EraseMyAccount(ctx context.Context, in *EraseMyAccountRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.GalaSejahteraService/EraseMyAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *galaSejahteraServiceClient) GetPasswordReset(ctx context.Context, in *GetPasswordResetRequest, opts ...grpc.CallOption) (*GetPasswordResetResponse, error) {
 	out := new(GetPasswordResetResponse)
 	err := c.cc.Invoke(ctx, "/pb.GalaSejahteraService/GetPasswordReset", in, out, opts...)
@@ -3066,16 +5333,51 @@ func (c *galaSejahteraServiceClient) Refresh(ctx context.Context, in *empty.Empt
 	return out, nil
 }
 
+func (c *galaSejahteraServiceClient) ListJobs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, "/pb.GalaSejahteraService/ListJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaSejahteraServiceClient) TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*TriggerJobResponse, error) {
+	out := new(TriggerJobResponse)
+	err := c.cc.Invoke(ctx, "/pb.GalaSejahteraService/TriggerJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaSejahteraServiceClient) GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error) {
+	out := new(GetAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/pb.GalaSejahteraService/GetAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GalaSejahteraServiceServer is the server API for GalaSejahteraService service.
 type GalaSejahteraServiceServer interface {
 	// Get Covids
 	GetCovids(context.Context, *GetCovidsRequest) (*GetCovidsResponse, error)
 	// Get Covid
 	GetCovid(context.Context, *GetCovidRequest) (*GetCovidResponse, error)
+	// List Districts
+	ListDistricts(context.Context, *empty.Empty) (*ListDistrictsResponse, error)
 	// Get District
 	GetDistrict(context.Context, *GetDistrictRequest) (*GetDistrictResponse, error)
+	// Get District History
+	GetDistrictHistory(context.Context, *GetDistrictHistoryRequest) (*GetDistrictHistoryResponse, error)
+	// Get My District
+	GetMyDistrict(context.Context, *GetMyDistrictRequest) (*GetMyDistrictResponse, error)
 	// Get Nearby Users
 	GetNearbyUsers(context.Context, *GetNearbyUsersRequest) (*GetNearbyUsersResponse, error)
+	// Get Heatmap
+	GetHeatmap(context.Context, *GetHeatmapRequest) (*GetHeatmapResponse, error)
 	// Get Covid Kases
 	GetKases(context.Context, *empty.Empty) (*GetKasesResponse, error)
 	// Get Recent Covid Kases
@@ -3094,6 +5396,8 @@ type GalaSejahteraServiceServer interface {
 	DeleteReport(context.Context, *DeleteReportRequest) (*DeleteReportResponse, error)
 	// Delete Reports
 	DeleteReports(context.Context, *DeleteReportsRequest) (*DeleteReportsResponse, error)
+	// Restore Report
+	RestoreReport(context.Context, *RestoreReportRequest) (*RestoreReportResponse, error)
 	// Create User
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	// Get Users
@@ -3108,6 +5412,12 @@ type GalaSejahteraServiceServer interface {
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
 	// Delete Users
 	DeleteUsers(context.Context, *DeleteUsersRequest) (*DeleteUsersResponse, error)
+	// Restore User
+	RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error)
+	// Export My Data
+	ExportMyData(context.Context, *ExportMyDataRequest) (*ExportMyDataResponse, error)
+	// Erase My Account
+	EraseMyAccount(context.Context, *EraseMyAccountRequest) (*empty.Empty, error)
 	// Get Password Reset
 	GetPasswordReset(context.Context, *GetPasswordResetRequest) (*GetPasswordResetResponse, error)
 	// Update Password
@@ -3118,6 +5428,12 @@ type GalaSejahteraServiceServer interface {
 	Logout(context.Context, *empty.Empty) (*empty.Empty, error)
 	// Refresh
 	Refresh(context.Context, *empty.Empty) (*RefreshResponse, error)
+	// List Jobs
+	ListJobs(context.Context, *empty.Empty) (*ListJobsResponse, error)
+	// Trigger Job
+	TriggerJob(context.Context, *TriggerJobRequest) (*TriggerJobResponse, error)
+	// Get Audit Log
+	GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error)
 }
 
 // UnimplementedGalaSejahteraServiceServer can be embedded to have forward compatible implementations.
@@ -3130,12 +5446,24 @@ func (*UnimplementedGalaSejahteraServiceServer) GetCovids(ctx context.Context, r
 func (*UnimplementedGalaSejahteraServiceServer) GetCovid(ctx context.Context, req *GetCovidRequest) (*GetCovidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCovid not implemented")
 }
+func (*UnimplementedGalaSejahteraServiceServer) ListDistricts(ctx context.Context, req *empty.Empty) (*ListDistrictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDistricts not implemented")
+}
 func (*UnimplementedGalaSejahteraServiceServer) GetDistrict(ctx context.Context, req *GetDistrictRequest) (*GetDistrictResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDistrict not implemented")
 }
+func (*UnimplementedGalaSejahteraServiceServer) GetDistrictHistory(ctx context.Context, req *GetDistrictHistoryRequest) (*GetDistrictHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDistrictHistory not implemented")
+}
+func (*UnimplementedGalaSejahteraServiceServer) GetMyDistrict(ctx context.Context, req *GetMyDistrictRequest) (*GetMyDistrictResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyDistrict not implemented")
+}
 func (*UnimplementedGalaSejahteraServiceServer) GetNearbyUsers(ctx context.Context, req *GetNearbyUsersRequest) (*GetNearbyUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNearbyUsers not implemented")
 }
+func (*UnimplementedGalaSejahteraServiceServer) GetHeatmap(ctx context.Context, req *GetHeatmapRequest) (*GetHeatmapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeatmap not implemented")
+}
 func (*UnimplementedGalaSejahteraServiceServer) GetKases(ctx context.Context, req *empty.Empty) (*GetKasesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKases not implemented")
 }
@@ -3163,6 +5491,9 @@ func (*UnimplementedGalaSejahteraServiceServer) DeleteReport(ctx context.Context
 func (*UnimplementedGalaSejahteraServiceServer) DeleteReports(ctx context.Context, req *DeleteReportsRequest) (*DeleteReportsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteReports not implemented")
 }
+func (*UnimplementedGalaSejahteraServiceServer) RestoreReport(ctx context.Context, req *RestoreReportRequest) (*RestoreReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreReport not implemented")
+}
 func (*UnimplementedGalaSejahteraServiceServer) CreateUser(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
 }
@@ -3184,6 +5515,15 @@ func (*UnimplementedGalaSejahteraServiceServer) DeleteUser(ctx context.Context,
 func (*UnimplementedGalaSejahteraServiceServer) DeleteUsers(ctx context.Context, req *DeleteUsersRequest) (*DeleteUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUsers not implemented")
 }
+func (*UnimplementedGalaSejahteraServiceServer) RestoreUser(ctx context.Context, req *RestoreUserRequest) (*RestoreUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUser not implemented")
+}
+func (*UnimplementedGalaSejahteraServiceServer) ExportMyData(ctx context.Context, req *ExportMyDataRequest) (*ExportMyDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMyData not implemented")
+}
+func (*UnimplementedGalaSejahteraServiceServer) EraseMyAccount(ctx context.Context, req *EraseMyAccountRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseMyAccount not implemented")
+}
 func (*UnimplementedGalaSejahteraServiceServer) GetPasswordReset(ctx context.Context, req *GetPasswordResetRequest) (*GetPasswordResetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPasswordReset not implemented")
 }
@@ -3199,6 +5539,15 @@ func (*UnimplementedGalaSejahteraServiceServer) Logout(ctx context.Context, req
 func (*UnimplementedGalaSejahteraServiceServer) Refresh(ctx context.Context, req *empty.Empty) (*RefreshResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
 }
+func (*UnimplementedGalaSejahteraServiceServer) ListJobs(ctx context.Context, req *empty.Empty) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (*UnimplementedGalaSejahteraServiceServer) TriggerJob(ctx context.Context, req *TriggerJobRequest) (*TriggerJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerJob not implemented")
+}
+func (*UnimplementedGalaSejahteraServiceServer) GetAuditLog(ctx context.Context, req *GetAuditLogRequest) (*GetAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
 
 func RegisterGalaSejahteraServiceServer(s *grpc.Server, srv GalaSejahteraServiceServer) {
 	s.RegisterService(&_GalaSejahteraService_serviceDesc, srv)
@@ -3240,6 +5589,24 @@ func _GalaSejahteraService_GetCovid_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GalaSejahteraService_ListDistricts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaSejahteraServiceServer).ListDistricts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GalaSejahteraService/ListDistricts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaSejahteraServiceServer).ListDistricts(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GalaSejahteraService_GetDistrict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDistrictRequest)
 	if err := dec(in); err != nil {
@@ -3258,6 +5625,42 @@ func _GalaSejahteraService_GetDistrict_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GalaSejahteraService_GetDistrictHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDistrictHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaSejahteraServiceServer).GetDistrictHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GalaSejahteraService/GetDistrictHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaSejahteraServiceServer).GetDistrictHistory(ctx, req.(*GetDistrictHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GalaSejahteraService_GetMyDistrict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyDistrictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaSejahteraServiceServer).GetMyDistrict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GalaSejahteraService/GetMyDistrict",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaSejahteraServiceServer).GetMyDistrict(ctx, req.(*GetMyDistrictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GalaSejahteraService_GetNearbyUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetNearbyUsersRequest)
 	if err := dec(in); err != nil {
@@ -3276,6 +5679,24 @@ func _GalaSejahteraService_GetNearbyUsers_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GalaSejahteraService_GetHeatmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHeatmapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaSejahteraServiceServer).GetHeatmap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GalaSejahteraService/GetHeatmap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaSejahteraServiceServer).GetHeatmap(ctx, req.(*GetHeatmapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GalaSejahteraService_GetKases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -3438,6 +5859,24 @@ func _GalaSejahteraService_DeleteReports_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GalaSejahteraService_RestoreReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaSejahteraServiceServer).RestoreReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GalaSejahteraService/RestoreReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaSejahteraServiceServer).RestoreReport(ctx, req.(*RestoreReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GalaSejahteraService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateUserRequest)
 	if err := dec(in); err != nil {
@@ -3564,6 +6003,60 @@ func _GalaSejahteraService_DeleteUsers_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GalaSejahteraService_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaSejahteraServiceServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GalaSejahteraService/RestoreUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaSejahteraServiceServer).RestoreUser(ctx, req.(*RestoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GalaSejahteraService_ExportMyData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMyDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaSejahteraServiceServer).ExportMyData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GalaSejahteraService/ExportMyData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaSejahteraServiceServer).ExportMyData(ctx, req.(*ExportMyDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GalaSejahteraService_EraseMyAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseMyAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaSejahteraServiceServer).EraseMyAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GalaSejahteraService/EraseMyAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaSejahteraServiceServer).EraseMyAccount(ctx, req.(*EraseMyAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GalaSejahteraService_GetPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPasswordResetRequest)
 	if err := dec(in); err != nil {
@@ -3654,6 +6147,60 @@ func _GalaSejahteraService_Refresh_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GalaSejahteraService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaSejahteraServiceServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GalaSejahteraService/ListJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaSejahteraServiceServer).ListJobs(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GalaSejahteraService_TriggerJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaSejahteraServiceServer).TriggerJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GalaSejahteraService/TriggerJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaSejahteraServiceServer).TriggerJob(ctx, req.(*TriggerJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GalaSejahteraService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaSejahteraServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.GalaSejahteraService/GetAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaSejahteraServiceServer).GetAuditLog(ctx, req.(*GetAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GalaSejahteraService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.GalaSejahteraService",
 	HandlerType: (*GalaSejahteraServiceServer)(nil),
@@ -3666,14 +6213,30 @@ var _GalaSejahteraService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCovid",
 			Handler:    _GalaSejahteraService_GetCovid_Handler,
 		},
+		{
+			MethodName: "ListDistricts",
+			Handler:    _GalaSejahteraService_ListDistricts_Handler,
+		},
 		{
 			MethodName: "GetDistrict",
 			Handler:    _GalaSejahteraService_GetDistrict_Handler,
 		},
+		{
+			MethodName: "GetDistrictHistory",
+			Handler:    _GalaSejahteraService_GetDistrictHistory_Handler,
+		},
+		{
+			MethodName: "GetMyDistrict",
+			Handler:    _GalaSejahteraService_GetMyDistrict_Handler,
+		},
 		{
 			MethodName: "GetNearbyUsers",
 			Handler:    _GalaSejahteraService_GetNearbyUsers_Handler,
 		},
+		{
+			MethodName: "GetHeatmap",
+			Handler:    _GalaSejahteraService_GetHeatmap_Handler,
+		},
 		{
 			MethodName: "GetKases",
 			Handler:    _GalaSejahteraService_GetKases_Handler,
@@ -3710,6 +6273,10 @@ var _GalaSejahteraService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteReports",
 			Handler:    _GalaSejahteraService_DeleteReports_Handler,
 		},
+		{
+			MethodName: "RestoreReport",
+			Handler:    _GalaSejahteraService_RestoreReport_Handler,
+		},
 		{
 			MethodName: "CreateUser",
 			Handler:    _GalaSejahteraService_CreateUser_Handler,
@@ -3738,6 +6305,18 @@ var _GalaSejahteraService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUsers",
 			Handler:    _GalaSejahteraService_DeleteUsers_Handler,
 		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _GalaSejahteraService_RestoreUser_Handler,
+		},
+		{
+			MethodName: "ExportMyData",
+			Handler:    _GalaSejahteraService_ExportMyData_Handler,
+		},
+		{
+			MethodName: "EraseMyAccount",
+			Handler:    _GalaSejahteraService_EraseMyAccount_Handler,
+		},
 		{
 			MethodName: "GetPasswordReset",
 			Handler:    _GalaSejahteraService_GetPasswordReset_Handler,
@@ -3758,6 +6337,18 @@ var _GalaSejahteraService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Refresh",
 			Handler:    _GalaSejahteraService_Refresh_Handler,
 		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _GalaSejahteraService_ListJobs_Handler,
+		},
+		{
+			MethodName: "TriggerJob",
+			Handler:    _GalaSejahteraService_TriggerJob_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _GalaSejahteraService_GetAuditLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "galasejahtera-service.proto",
@@ -3,16 +3,28 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dao"
 	"galasejahtera/pkg/handlers"
+	"galasejahtera/pkg/health"
 	"galasejahtera/pkg/logger"
+	"galasejahtera/pkg/metrics"
+	"galasejahtera/pkg/migration"
 	model2 "galasejahtera/pkg/model"
 	"galasejahtera/pkg/protocol/grpc"
 	"galasejahtera/pkg/protocol/rest"
+	"galasejahtera/pkg/scheduler"
+	"galasejahtera/pkg/tracing"
 	"galasejahtera/pkg/utility"
 	"github.com/joho/godotenv"
+	"github.com/twinj/uuid"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
 // Config is configuration for Server
@@ -23,56 +35,199 @@ type Config struct {
 	LogTimeFormat string
 }
 
+// shutdownDelay is default duration readiness fails before servers stop, so that load balancers stop routing traffic
+const shutdownDelay = 5 * time.Second
+
+// defaultConfig is configuration for Server
+var defaultConfig = &Config{GRPCPort: "10001", HTTPPort: "10002", LogLevel: -1, LogTimeFormat: "02 Jan 2006 15:04:05 MST"}
+
 // RunServer runs gRPC server and HTTP gateway
 func RunServer() error {
 	ctx := context.Background()
 
 	// get configuration
-	cfg := &Config{GRPCPort: "10001", HTTPPort: "10002", LogLevel: -1, LogTimeFormat: "02 Jan 2006 15:04:05 MST"}
+	cfg := defaultConfig
 
-	// initialize logger
-	if err := logger.Init(cfg.LogLevel, cfg.LogTimeFormat); err != nil {
-		return fmt.Errorf("failed to initialize logger: %v", err)
+	mongoClient, err := connect(ctx, cfg)
+	if err != nil {
+		return err
 	}
+	defer mongoClient.Disconnect(ctx)
 
-	// Load .env configuration
-	err := godotenv.Load()
-	if err != nil {
-		logger.Log.Warn(".env file not found, using environment variables")
+	// updates are recorded in audit log in the same transaction, refuse to serve without transactions
+	if err := dao.VerifyTransactions(ctx, mongoClient); err != nil {
+		return fmt.Errorf("failed to verify MongoDB: %v", err)
 	}
 
-	// Connect to MongoDB
-	clientOptions := options.Client().ApplyURI(os.Getenv("MONGODB_URL"))
-	mongoClient, err := mongo.Connect(ctx, clientOptions)
+	// set up tracing exporter, flushing pending spans on exit
+	shutdownTracing, err := tracing.Init(ctx)
 	if err != nil {
-		return fmt.Errorf("error getting connect mongo client: %v", err)
+		return err
 	}
-	defer mongoClient.Disconnect(ctx)
+	defer shutdownTracing(ctx)
 
-	utility.CrawlDaily()
+	// apply pending migrations unless they are run separately by migrate command
+	db := mongoClient.Database(constants.GalaSejahtera)
+	if os.Getenv("MIGRATE_ON_START") != "false" {
+		if _, err := migration.Run(ctx, db, migration.Migrations); err != nil {
+			return fmt.Errorf("failed to run migrations: %v", err)
+		}
+	}
+	if err := migration.Verify(ctx, db); err != nil {
+		return fmt.Errorf("failed to verify indexes: %v", err)
+	}
+
+	utility.CrawlDaily(ctx)
 
 	// initialize model
 	model := model2.InitModel(mongoClient)
 
-	// initialize handlers
-	handler := handlers.NewHandlers(model)
+	// active users gauge is counted on each scrape
+	if err := metrics.RegisterActiveUsers(func(ctx context.Context) (int64, error) {
+		active, err := model.CountActiveUsers(ctx)
+		if err != nil {
+			logger.Log.Warn("failed to count active users", zap.String("reason", err.Error()))
+		}
+		return active, err
+	}); err != nil {
+		return fmt.Errorf("failed to register metrics: %v", err)
+	}
+
+	// import district boundaries for reverse geocoding
+	if path := os.Getenv("DISTRICTS_GEOJSON_PATH"); path != "" {
+		count, err := model.ImportDistrictBoundaries(ctx, path)
+		if err != nil {
+			return fmt.Errorf("failed to import district boundaries: %v", err)
+		}
+		logger.Log.Info("imported district boundaries", zap.Int64("count", count))
+	}
 
 	// initialize scheduler
+	sched, err := initScheduler(model)
+	if err != nil {
+		return fmt.Errorf("failed to initialize scheduler: %v", err)
+	}
+	sched.Start()
+	defer sched.Stop()
+
+	// initialize handlers
+	handler := handlers.NewHandlers(model, sched)
+
+	// graceful shutdown, readiness fails for SHUTDOWN_DELAY before servers stop
+	checker := health.NewChecker(mongoClient, sched)
+	serveCtx, stop := context.WithCancel(ctx)
+	defer stop()
 	go func() {
-		it := utility.Scheduler{Enabled: true, Job: model.DisableInactiveUsers}
-		it.Start()
-	}()
-	go func() {
-		it := utility.DailyScheduler{Enabled: true, Job: model.UpdateDailies}
-		it.Start()
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+		<-c
+		delay := utility.GetEnvDuration("SHUTDOWN_DELAY", shutdownDelay)
+		logger.Log.Warn("shutting down...", zap.Duration("delay", delay))
+		checker.Drain()
+		time.Sleep(delay)
+		stop()
 	}()
 
 	// run HTTP gateway
 	go func() {
-		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort)
+		if err := rest.RunServer(serveCtx, cfg.GRPCPort, cfg.HTTPPort, checker); err != nil {
+			logger.Log.Error("HTTP/REST gateway stopped", zap.String("reason", err.Error()))
+		}
 	}()
 
-	fmt.Printf("%+v", utility.CrawlCasesByDate("2021-01-01", "2021-01-20")[0])
+	fmt.Printf("%+v", utility.CrawlCasesByDate(ctx, "2021-01-01", "2021-01-20")[0])
+
+	return grpc.RunServer(serveCtx, handler, cfg.GRPCPort, checker)
+}
+
+// RunMigrate applies pending migrations and verifies indexes
+func RunMigrate() error {
+	ctx := context.Background()
 
-	return grpc.RunServer(ctx, handler, cfg.GRPCPort)
+	mongoClient, err := connect(ctx, defaultConfig)
+	if err != nil {
+		return err
+	}
+	defer mongoClient.Disconnect(ctx)
+
+	db := mongoClient.Database(constants.GalaSejahtera)
+	count, err := migration.Run(ctx, db, migration.Migrations)
+	if err != nil {
+		return fmt.Errorf("failed to run migrations: %v", err)
+	}
+	if err := migration.Verify(ctx, db); err != nil {
+		return fmt.Errorf("failed to verify indexes: %v", err)
+	}
+	logger.Log.Info("migrations are up to date", zap.Int("applied", count))
+	return nil
+}
+
+// connect initializes logger, loads .env configuration and connects to MongoDB
+func connect(ctx context.Context, cfg *Config) (*mongo.Client, error) {
+	// initialize logger
+	if err := logger.Init(cfg.LogLevel, cfg.LogTimeFormat); err != nil {
+		return nil, fmt.Errorf("failed to initialize logger: %v", err)
+	}
+
+	// Load .env configuration
+	err := godotenv.Load()
+	if err != nil {
+		logger.Log.Warn(".env file not found, using environment variables")
+	}
+
+	// Connect to MongoDB
+	clientOptions := options.Client().ApplyURI(os.Getenv("MONGODB_URL")).SetPoolMonitor(metrics.PoolMonitor()).
+		SetMonitor(tracing.CommandMonitor())
+	mongoClient, err := mongo.Connect(ctx, clientOptions)
+	if err != nil {
+		return nil, fmt.Errorf("error getting connect mongo client: %v", err)
+	}
+	return mongoClient, nil
+}
+
+// initScheduler registers background jobs, each job is configurable by SCHEDULER_<JOB>_* environment variables
+func initScheduler(model model2.IModel) (*scheduler.Scheduler, error) {
+	// owner identifies this replica in job leases
+	hostname, _ := os.Hostname()
+	sched := scheduler.NewScheduler(model, hostname+"/"+uuid.NewV4().String())
+
+	err := sched.Register("disable-inactive-users", scheduler.LoadJobConfig("disable-inactive-users", scheduler.JobConfig{
+		Spec:       "@every 10m",
+		Timeout:    5 * time.Minute,
+		Jitter:     30 * time.Second,
+		RunOnStart: true,
+		Enabled:    true,
+	}), func(ctx context.Context) error {
+		_, err := model.DisableInactiveUsers(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = sched.Register("update-dailies", scheduler.LoadJobConfig("update-dailies", scheduler.JobConfig{
+		Spec:       "@every 6h",
+		Timeout:    10 * time.Minute,
+		Jitter:     time.Minute,
+		RunOnStart: true,
+		Enabled:    true,
+	}), model.UpdateDailies)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sched.Register("purge-deleted", scheduler.LoadJobConfig("purge-deleted", scheduler.JobConfig{
+		Spec:       "@daily",
+		Timeout:    30 * time.Minute,
+		Jitter:     10 * time.Minute,
+		RunOnStart: false,
+		Enabled:    true,
+	}), func(ctx context.Context) error {
+		_, _, err := model.PurgeDeleted(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sched, nil
 }
@@ -0,0 +1,26 @@
+package constants
+
+// Audit actions
+const (
+	// AuditUpdateUser is update of user profile, role or active status
+	AuditUpdateUser = "user.update"
+	// AuditDeleteUser is soft delete of user
+	AuditDeleteUser = "user.delete"
+	// AuditRestoreUser is restore of soft deleted user
+	AuditRestoreUser = "user.restore"
+	// AuditResetPassword is password reset of user, password is never recorded
+	AuditResetPassword = "user.password"
+	// AuditEraseAccount is user erasing own account along with all its data
+	AuditEraseAccount = "user.erase"
+	// AuditDeleteReport is soft delete of report
+	AuditDeleteReport = "report.delete"
+	// AuditRestoreReport is restore of soft deleted report
+	AuditRestoreReport = "report.restore"
+)
+
+// AuditRedacted replaces values of personal fields in audit log, which only records that they changed
+const AuditRedacted = "[redacted]"
+
+// AuditPersonalFields are user fields holding personal data, their values are never recorded so that audit log keeps
+// no personal data of erased or purged users
+var AuditPersonalFields = []string{Email, Lat, Long, Name}
@@ -14,6 +14,11 @@ const (
 	Reports    = "reports"
 	Covids     = "covids"
 	Dailies    = "dailies"
+	Districts  = "districts"
+	Jobs       = "jobs"
+	Locations  = "locations"
+	Migrations = "migrations"
+	Audits     = "audits"
 )
 
 // Fields
@@ -31,19 +36,25 @@ const (
 	Reset       = "reset"
 
 	// Common
-	ID = "id"
+	ID        = "id"
+	DeletedAt = "deletedAt"
 
 	// Users
-	DisplayName = "displayName"
-	Email       = "email"
-	IsActive    = "isActive"
-	Role        = "role"
-	Disabled    = "disabled"
-	Password    = "password"
-	IC          = "ic"
-	PhoneNumber = "phoneNumber"
-	Alert       = "alert"
-	Infected    = "infected"
+	DisplayName     = "displayName"
+	Email           = "email"
+	NormalizedEmail = "normalizedEmail"
+	IsActive        = "isActive"
+	Role            = "role"
+	Disabled        = "disabled"
+	Password        = "password"
+	IC              = "ic"
+	PhoneNumber     = "phoneNumber"
+	Alert           = "alert"
+	Infected        = "infected"
+	UserUpdated     = "lastUpdated"
+	Distance        = "distance"
+	Lat             = "lat"
+	Long            = "long"
 
 	// Zones
 	Name     = "name"
@@ -59,16 +70,78 @@ const (
 
 	// Daily
 	LastUpdated = "last_updated"
+	States      = "states"
+
+	// District
+	State    = "state"
+	Geometry = "geometry"
+
+	// Location history
+	Geohash = "geohash"
+	Count   = "count"
+
+	// Migration
+	Version = "version"
+
+	// Job
+	Owner        = "owner"
+	LeaseUntil   = "leaseUntil"
+	LastRun      = "lastRun"
+	LastDuration = "lastDuration"
+	LastError    = "lastError"
+	RunCount     = "runCount"
+
+	// Audit
+	Actor     = "actor"
+	Action    = "action"
+	Target    = "target"
+	RequestID = "requestId"
+	Before    = "before"
+	After     = "after"
 
 	// Report
-	CreatedAt = "createdAt"
+	CreatedAt    = "createdAt"
+	HasSymptom   = "hasSymptom"
+	LatestReport = "latestReport"
 
 	// Covid
 	Title = "title"
+	SID   = "sid"
 )
 
 // Keywords
 const (
 	ASC  = "ASC"
 	DESC = "DESC"
+	// Search is legacy filter item matching searchable fields
+	Search = "q"
+)
+
+// Filter operators
+const (
+	EQ     = "eq"
+	NE     = "ne"
+	IN     = "in"
+	GT     = "gt"
+	GTE    = "gte"
+	LT     = "lt"
+	LTE    = "lte"
+	RANGE  = "range"
+	PREFIX = "prefix"
+)
+
+// Query limits
+const (
+	// MaxQueryFilters is maximum number of filters in a query
+	MaxQueryFilters = 10
+	// MaxQuerySorts is maximum number of sort fields in a query
+	MaxQuerySorts = 3
+	// MaxQueryValues is maximum number of values of in operator
+	MaxQueryValues = 100
+	// PageSize is default page size
+	PageSize = 20
+	// MaxPageSize is maximum page size
+	MaxPageSize = 100
+	// MaxBatchSize is maximum number of IDs in a batch operation
+	MaxBatchSize = 100
 )
@@ -6,11 +6,17 @@ import (
 )
 
 var (
-	InvalidArgumentError = status.Error(codes.InvalidArgument, "Invalid input.")
-	InvalidEmailError    = status.Error(codes.InvalidArgument, "Invalid email.")
-	InvalidRoleError     = status.Error(codes.InvalidArgument, "Invalid role.")
-	InvalidDateError     = status.Error(codes.InvalidArgument, "Invalid date.")
-	InvalidPasswordError = status.Error(codes.InvalidArgument, "Invalid password, please ensure that password is more than 6 characters.")
+	InvalidArgumentError  = status.Error(codes.InvalidArgument, "Invalid input.")
+	InvalidEmailError     = status.Error(codes.InvalidArgument, "Invalid email.")
+	InvalidRoleError      = status.Error(codes.InvalidArgument, "Invalid role.")
+	InvalidDateError      = status.Error(codes.InvalidArgument, "Invalid date.")
+	InvalidPasswordError  = status.Error(codes.InvalidArgument, "Invalid password, please ensure that password is more than 6 characters.")
+	InvalidFilterError    = status.Error(codes.InvalidArgument, "Invalid filter, please check filter field, operator and value.")
+	InvalidSortError      = status.Error(codes.InvalidArgument, "Invalid sort, please check sort field and order.")
+	InvalidPageTokenError = status.Error(codes.InvalidArgument, "Invalid page token, please restart from the first page.")
+	InvalidFieldMaskError = status.Error(codes.InvalidArgument, "Invalid field mask, please check fields to update.")
+	InvalidETagError      = status.Error(codes.InvalidArgument, "Invalid If-Match, please use version of the resource.")
+	BatchEmailError       = status.Error(codes.InvalidArgument, "Email can only be updated for a single user.")
 
 	UserAlreadyExistError        = status.Error(codes.AlreadyExists, "User already exist!")
 	ZoneAlreadyExistError        = status.Error(codes.AlreadyExists, "Zone already exist!")
@@ -24,13 +30,25 @@ var (
 	CovidNotFoundError    = status.Error(codes.NotFound, "Article not found!")
 	ActivityNotFoundError = status.Error(codes.NotFound, "Activity not found!")
 	MetadataNotFoundError = status.Error(codes.NotFound, "Metadata not found!")
+	PlaceNotFoundError    = status.Error(codes.NotFound, "Place not found!")
+	DistrictNotFoundError = status.Error(codes.NotFound, "District not found for your location!")
+	JobNotFoundError      = status.Error(codes.NotFound, "Job not found!")
+	JobLeaseHeldError     = status.Error(codes.FailedPrecondition, "Job is running on another replica!")
 
 	UserOperationError         = status.Error(codes.Internal, "Authentication Service failed. Might be due to invalid input.")
 	UnauthorizedAccessError    = status.Error(codes.Unauthenticated, "User is not authorized to perform this action!")
+	PermissionDeniedError      = status.Error(codes.PermissionDenied, "User is not allowed to update this field!")
 	InvalidPasswordVerifyError = status.Error(codes.InvalidArgument, "Invalid password.")
 	CreateTokenFailedError     = status.Error(codes.Internal, "Failed to create token.")
 	VerifyTokenFailedError     = status.Error(codes.Internal, "Failed to verify token.")
 
 	OperationUnsupportedError = status.Error(codes.Unimplemented, "Operation unsupported.")
 	InternalError             = status.Error(codes.Internal, "Server unavailable, please try again.")
+	RateLimitedError          = status.Error(codes.ResourceExhausted, "Too many requests, please try again later.")
+	NotFoundError             = status.Error(codes.NotFound, "Resource not found!")
+	ConflictError             = status.Error(codes.AlreadyExists, "Resource already exist!")
+	UnavailableError          = status.Error(codes.Unavailable, "Database unavailable, please try again later.")
+	TimeoutError              = status.Error(codes.DeadlineExceeded, "Request timed out, please try again.")
+	CanceledError             = status.Error(codes.Canceled, "Request canceled.")
+	VersionConflictError      = status.Error(codes.Aborted, "Resource has been modified, please reload and try again.")
 )
@@ -1,13 +1,47 @@
 package constants
 
+import "time"
+
 const (
 	AccessTokenTTLMinutes     = 15
 	RefreshTokenTTLDays       = 365 // one year only expire the user
 	PasswordResetTokenTTLDays = 1
+	// InactiveUserThreshold is default duration without location update before user is set to inactive
+	InactiveUserThreshold = 10 * time.Minute
+	// NearbyRadius is default nearby users search radius in meter
+	NearbyRadius = 100.0
+	// MaxNearbyRadius is maximum nearby users search radius in meter
+	MaxNearbyRadius = 500.0
+	// NearbyMaxAge is maximum age of nearby users location
+	NearbyMaxAge = 10 * time.Minute
+	// NearbyGeohashPrecision is geohash length nearby users are grouped by, precision 7 is about 150 meter
+	NearbyGeohashPrecision = 7
+	// MaxNearbyGeohashPrecision is maximum nearby geohash precision, finer cells would disclose user locations
+	MaxNearbyGeohashPrecision = 7
+	// NearbyRateLimit is number of nearby users requests allowed per user per minute
+	NearbyRateLimit = 6
+	// LocationRetention is default retention of location history
+	LocationRetention = 21 * 24 * time.Hour
+	// LocationGeohashPrecision is geohash length stored in location history, heatmap cells are its prefixes
+	LocationGeohashPrecision = 9
+	// HeatmapPrecision is default heatmap geohash precision, about 1.2 x 0.6 km
+	HeatmapPrecision = 6
+	// MaxHeatmapPrecision is maximum heatmap geohash precision, about 150 meter
+	MaxHeatmapPrecision = 7
+	// HeatmapMinCount hides heatmap cells with fewer users, so that single users cannot be located
+	HeatmapMinCount = 3
+	// MaxHeatmapCells limits number of heatmap cells returned
+	MaxHeatmapCells = 1000
+	// DeletedRetention is default retention of soft deleted users and reports before they are purged
+	DeletedRetention = 30 * 24 * time.Hour
+	// PurgeBatchSize is number of deleted users purged in one transaction
+	PurgeBatchSize = 100
 )
 
 const (
-	User = "user"
+	User  = "user"
+	Admin = "admin"
 )
 
-var AllCanAccess = []string{User}
+var AllCanAccess = []string{User, Admin}
+var AdminCanAccess = []string{Admin}
@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 const (
 	Zone = iota + 1
 	SubZone
@@ -13,3 +15,32 @@ const (
 	LowRisk
 	MinimumRisk
 )
+
+// District risk colour mapping by active cases, ref: MOH zone classification
+const (
+	GreenZone  = "green"
+	YellowZone = "yellow"
+	RedZone    = "red"
+	// UnknownZone is risk of place without snapshot 14 days before to count its active cases
+	UnknownZone = "unknown"
+
+	RedZoneThreshold = 40
+	ActiveCaseDays   = 14
+)
+
+// District trend of active cases
+const (
+	TrendUp   = "up"
+	TrendDown = "down"
+	TrendFlat = "flat"
+	// TrendUnknown is trend of place whose active cases, or those of previous snapshot, are unknown
+	TrendUnknown = "unknown"
+)
+
+// District history time range
+const (
+	// DistrictHistoryRange is default time range of district history
+	DistrictHistoryRange = 30 * 24 * time.Hour
+	// MaxDistrictHistoryRange caps time range of district history, longer ranges keep their latest days
+	MaxDistrictHistoryRange = 90 * 24 * time.Hour
+)
@@ -0,0 +1,83 @@
+package dao
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// AuditDAO ...
+type AuditDAO struct {
+	client *mongo.Client
+}
+
+// InitAuditDAO ...
+func InitAuditDAO(client *mongo.Client) IAuditDAO {
+	return &AuditDAO{client: client}
+}
+
+// Create appends audit record
+func (v *AuditDAO) Create(ctx context.Context, audit *dto.Audit) (*dto.Audit, error) {
+	ctx, span := startSpan(ctx, "AuditDAO.Create")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Audits)
+	if _, err := collection.InsertOne(ctx, audit); err != nil {
+		return nil, wrapError(err)
+	}
+	return audit, nil
+}
+
+// BatchCreate appends audit records in a single operation
+func (v *AuditDAO) BatchCreate(ctx context.Context, audits []*dto.Audit) error {
+	ctx, span := startSpan(ctx, "AuditDAO.BatchCreate")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Audits)
+	docs := make([]interface{}, len(audits))
+	for i, audit := range audits {
+		docs[i] = audit
+	}
+	if _, err := collection.InsertMany(ctx, docs); err != nil {
+		return wrapError(err)
+	}
+	return nil
+}
+
+// Query queries audit records by filters, sorts and range or page, fields are whitelisted by auditFields, returns
+// total, audit records and next page token
+func (v *AuditDAO) Query(ctx context.Context, query *dto.QueryData) (int64, []*dto.Audit, string, error) {
+	ctx, span := startSpan(ctx, "AuditDAO.Query")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Audits)
+	cursor, count, page, err := find(ctx, collection, query, auditFields)
+	if err != nil {
+		return 0, nil, "", err
+	}
+
+	var audits []*dto.Audit
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		if !page.add(cursor.Current) {
+			break
+		}
+		audit := &dto.Audit{}
+		if err = cursor.Decode(&audit); err != nil {
+			return 0, nil, "", wrapError(err)
+		}
+		audits = append(audits, audit)
+	}
+
+	if err = cursor.Err(); err != nil {
+		return 0, nil, "", wrapError(err)
+	}
+	next, err := page.nextToken()
+	if err != nil {
+		return 0, nil, "", err
+	}
+
+	return count, audits, next, nil
+}
@@ -7,7 +7,6 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // AuthDAO ...
@@ -20,50 +19,57 @@ func InitAuthDAO(client *mongo.Client) IAuthDAO {
 	return &AuthDAO{client: client}
 }
 
-// InitIndex initializes index
-func (v *AuthDAO) InitIndex(ctx context.Context) error {
-	mod := mongo.IndexModel{
-		Keys: bson.M{
-			constants.TTL: 1, // index in ascending order
-		}, Options: options.Index().SetExpireAfterSeconds(1),
-	}
-
-	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.AuthTokens)
-	_, err := collection.Indexes().CreateOne(ctx, mod)
-	return err
-}
-
 // Create creates new auth token
 func (v *AuthDAO) Create(ctx context.Context, auth *dto.AuthObject) (*dto.AuthObject, error) {
+	ctx, span := startSpan(ctx, "AuthDAO.Create")
+	defer span.End()
+
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.AuthTokens)
 	if _, err := collection.InsertOne(ctx, auth); err != nil {
-		return nil, err
+		return nil, wrapError(err)
 	}
 	return auth, nil
 }
 
 // Get gets auth token
 func (v *AuthDAO) Get(ctx context.Context, token string) (*dto.AuthObject, error) {
+	ctx, span := startSpan(ctx, "AuthDAO.Get")
+	defer span.End()
+
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.AuthTokens)
 	auth := &dto.AuthObject{}
 	if err := collection.FindOne(ctx, bson.D{{constants.Token, token}}).Decode(&auth); err != nil {
-		return nil, err
+		return nil, wrapError(err)
 	}
 	return auth, nil
 }
 
 // Delete deletes user by token
 func (v *AuthDAO) Delete(ctx context.Context, token string) error {
+	ctx, span := startSpan(ctx, "AuthDAO.Delete")
+	defer span.End()
+
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.AuthTokens)
 	_, err := collection.DeleteOne(ctx, bson.D{{constants.Token, token}})
 	if err != nil {
-		return err
+		return wrapError(err)
 	}
 	return nil
 }
 
 // DeleteByID deletes user by ID
 func (v *AuthDAO) DeleteByID(ctx context.Context, id string) error {
+	ctx, span := startSpan(ctx, "AuthDAO.DeleteByID")
+	defer span.End()
+
+	return v.DeleteByIDs(ctx, []string{id})
+}
+
+// DeleteByIDs deletes tokens of users by IDs
+func (v *AuthDAO) DeleteByIDs(ctx context.Context, ids []string) error {
+	ctx, span := startSpan(ctx, "AuthDAO.DeleteByIDs")
+	defer span.End()
+
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.AuthTokens)
 
 	query := bson.D{{
@@ -72,8 +78,8 @@ func (v *AuthDAO) DeleteByID(ctx context.Context, id string) error {
 			bson.D{{
 				constants.UserId,
 				bson.D{{
-					"$eq",
-					id,
+					"$in",
+					ids,
 				}},
 			}},
 			bson.D{{
@@ -88,7 +94,46 @@ func (v *AuthDAO) DeleteByID(ctx context.Context, id string) error {
 
 	_, err := collection.DeleteMany(ctx, query)
 	if err != nil {
-		return err
+		return wrapError(err)
 	}
 	return nil
 }
+
+// GetByUserID gets all tokens of user, including password reset tokens
+func (v *AuthDAO) GetByUserID(ctx context.Context, userID string) ([]*dto.AuthObject, error) {
+	ctx, span := startSpan(ctx, "AuthDAO.GetByUserID")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.AuthTokens)
+	cursor, err := collection.Find(ctx, bson.D{{constants.UserId, userID}})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	var auths []*dto.AuthObject
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		auth := &dto.AuthObject{}
+		if err = cursor.Decode(&auth); err != nil {
+			return nil, wrapError(err)
+		}
+		auths = append(auths, auth)
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, wrapError(err)
+	}
+	return auths, nil
+}
+
+// PurgeByUserIDs deletes all tokens of users by IDs, including password reset tokens, returns number of tokens deleted
+func (v *AuthDAO) PurgeByUserIDs(ctx context.Context, userIDs []string) (int64, error) {
+	ctx, span := startSpan(ctx, "AuthDAO.PurgeByUserIDs")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.AuthTokens)
+	result, err := collection.DeleteMany(ctx, byUserIDs(userIDs))
+	if err != nil {
+		return 0, wrapError(err)
+	}
+	return result.DeletedCount, nil
+}
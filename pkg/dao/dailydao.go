@@ -2,13 +2,11 @@ package dao
 
 import (
 	"context"
-	"fmt"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
-	"strconv"
 )
 
 // DailyDAO ...
@@ -23,140 +21,91 @@ func InitDailyDAO(client *mongo.Client) IDailyDAO {
 
 // Create creates new daily
 func (v *DailyDAO) Create(ctx context.Context, daily *dto.Daily) (*dto.Daily, error) {
+	ctx, span := startSpan(ctx, "DailyDAO.Create")
+	defer span.End()
+
 	// create daily
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Dailies)
 	if _, err := collection.InsertOne(ctx, daily); err != nil {
-		return nil, err
+		return nil, wrapError(err)
 	}
 	return daily, nil
 }
 
+// UpdateStates sets states of daily by last updated time, e.g. after classification
+func (v *DailyDAO) UpdateStates(ctx context.Context, daily *dto.Daily) error {
+	ctx, span := startSpan(ctx, "DailyDAO.UpdateStates")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Dailies)
+	result, err := collection.UpdateOne(ctx, bson.D{{constants.LastUpdated, daily.LastUpdated}}, bson.D{
+		{"$set", bson.D{{constants.States, daily.States}}},
+	})
+	if err != nil {
+		return wrapError(err)
+	}
+	if result.MatchedCount == 0 {
+		return wrapError(mongo.ErrNoDocuments)
+	}
+	return nil
+}
+
 // Get gets daily by ID
 func (v *DailyDAO) Get(ctx context.Context, id string) (*dto.Daily, error) {
+	ctx, span := startSpan(ctx, "DailyDAO.Get")
+	defer span.End()
+
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Dailies)
 	daily := &dto.Daily{}
 	if err := collection.FindOne(ctx, bson.D{{constants.ID, id}}).Decode(&daily); err != nil {
-		return nil, err
+		return nil, wrapError(err)
 	}
 	return daily, nil
 }
 
-// BatchGet gets dailies by slice of IDs
+// BatchGet gets dailies by slice of IDs in a single query, dailies not found are omitted
 func (v *DailyDAO) BatchGet(ctx context.Context, ids []string) ([]*dto.Daily, error) {
-	var dailies []*dto.Daily
-	for _, id := range ids {
-		daily, err := v.Get(ctx, id)
-		if err != nil {
-			return nil, err
-		}
-		dailies = append(dailies, daily)
-	}
-	return dailies, nil
-}
+	ctx, span := startSpan(ctx, "DailyDAO.BatchGet")
+	defer span.End()
 
-// Query queries dailies by sort, range, filter
-func (v *DailyDAO) Query(ctx context.Context, sort *dto.SortData, itemsRange *dto.RangeData, filter *dto.FilterData) (int64, []*dto.Daily, error) {
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Dailies)
-
-	var cursor *mongo.Cursor
-	var err error
-	var count int64
-	var dailies []*dto.Daily
-
-	findOptions := options.Find()
-	// set range
-	if itemsRange != nil {
-		findOptions.SetSkip(int64(itemsRange.From))
-		findOptions.SetLimit(int64(itemsRange.To + 1 - itemsRange.From))
+	cursor, err := collection.Find(ctx, bson.D{{constants.ID, bson.D{{"$in", ids}}}})
+	if err != nil {
+		return nil, wrapError(err)
 	}
 
-	// set sorter
-	if sort != nil {
-		order := 1
-		if sort.Order == constants.DESC {
-			order = -1
+	var dailies []*dto.Daily
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		daily := &dto.Daily{}
+		if err = cursor.Decode(&daily); err != nil {
+			return nil, wrapError(err)
 		}
-		findOptions.SetSort(bson.D{{sort.Item, order}})
+		dailies = append(dailies, daily)
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, wrapError(err)
 	}
+	return dailies, nil
+}
 
-	// set filter
-	if filter != nil {
-		// special case: if filter item is primary key we can directly call Get
-		if filter.Item == "id" {
-			d, err := v.Get(ctx, filter.Value)
-			if err != nil {
-				return 0, nil, err
-			}
-			return 1, []*dto.Daily{d}, nil
-		}
+// Query queries dailies by filters, sorts and range, fields are whitelisted by dailyFields
+func (v *DailyDAO) Query(ctx context.Context, query *dto.QueryData) (int64, []*dto.Daily, error) {
+	ctx, span := startSpan(ctx, "DailyDAO.Query")
+	defer span.End()
 
-		// else: do query filter
-		if filter.Item == "q" {
-			query := bson.M{
-				constants.UserId: bson.M{
-					"$regex":   fmt.Sprintf("%s.*", filter.Value),
-					"$options": "i",
-				},
-			}
-			cursor, err = collection.Find(ctx, query, findOptions)
-			if err != nil {
-				return 0, nil, err
-			}
-			count, err = collection.CountDocuments(ctx, query)
-			if err != nil {
-				return 0, nil, err
-			}
-		} else if filter.Item == constants.LastUpdated {
-			n, err := strconv.ParseInt(filter.Value, 10, 64)
-			if err != nil {
-				return 0, nil, err
-			}
-			cursor, err = collection.Find(
-				ctx, bson.D{
-					{filter.Item, n},
-				}, findOptions,
-			)
-			if err != nil {
-				return 0, nil, err
-			}
-			count, err = collection.CountDocuments(ctx, bson.D{
-				{filter.Item, n},
-			})
-			if err != nil {
-				return 0, nil, err
-			}
-		} else {
-			cursor, err = collection.Find(
-				ctx, bson.D{
-					{filter.Item, filter.Value},
-				}, findOptions,
-			)
-			if err != nil {
-				return 0, nil, err
-			}
-			count, err = collection.CountDocuments(ctx, bson.D{
-				{filter.Item, filter.Value},
-			})
-			if err != nil {
-				return 0, nil, err
-			}
-		}
-	} else {
-		cursor, err = collection.Find(ctx, bson.D{{}}, findOptions)
-		if err != nil {
-			return 0, nil, err
-		}
-		count, err = collection.CountDocuments(ctx, bson.D{{}})
-		if err != nil {
-			return 0, nil, err
-		}
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Dailies)
+	cursor, count, _, err := find(ctx, collection, query, dailyFields)
+	if err != nil {
+		return 0, nil, err
 	}
 
+	var dailies []*dto.Daily
 	defer cursor.Close(ctx)
 	for cursor.Next(ctx) {
 		daily := &dto.Daily{}
 		if err = cursor.Decode(&daily); err != nil {
-			return 0, nil, err
+			return 0, nil, wrapError(err)
 		}
 		dailies = append(dailies, daily)
 	}
@@ -166,28 +115,34 @@ func (v *DailyDAO) Query(ctx context.Context, sort *dto.SortData, itemsRange *dt
 
 // Delete deletes daily by ID
 func (v *DailyDAO) Delete(ctx context.Context, id string) error {
+	ctx, span := startSpan(ctx, "DailyDAO.Delete")
+	defer span.End()
+
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Dailies)
 	if _, err := collection.DeleteOne(ctx, bson.D{{constants.ID, id}}); err != nil {
-		return err
+		return wrapError(err)
 	}
 	return nil
 }
 
-// BatchDelete deletes dailies by IDs
-func (v *DailyDAO) BatchDelete(ctx context.Context, ids []string) ([]string, error) {
-	var deletedIDs []string
-	for _, id := range ids {
-		err := v.Delete(ctx, id)
-		if err != nil {
-			return nil, err
-		}
-		deletedIDs = append(deletedIDs, id)
+// BatchDelete deletes dailies by IDs in a single operation, returns number of dailies deleted
+func (v *DailyDAO) BatchDelete(ctx context.Context, ids []string) (int64, error) {
+	ctx, span := startSpan(ctx, "DailyDAO.BatchDelete")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Dailies)
+	result, err := collection.DeleteMany(ctx, bson.D{{constants.ID, bson.D{{"$in", ids}}}})
+	if err != nil {
+		return 0, wrapError(err)
 	}
-	return deletedIDs, nil
+	return result.DeletedCount, nil
 }
 
 // Query queries dailies by time range
 func (v *DailyDAO) QueryByTimeRange(ctx context.Context, startTime int64, endTime int64) (int64, []*dto.Daily, error) {
+	ctx, span := startSpan(ctx, "DailyDAO.QueryByTimeRange")
+	defer span.End()
+
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Dailies)
 
 	var cursor *mongo.Cursor
@@ -205,17 +160,17 @@ func (v *DailyDAO) QueryByTimeRange(ctx context.Context, startTime int64, endTim
 	}
 	cursor, err = collection.Find(ctx, query, findOptions)
 	if err != nil {
-		return 0, nil, err
+		return 0, nil, wrapError(err)
 	}
 	count, err = collection.CountDocuments(ctx, query)
 	if err != nil {
-		return 0, nil, err
+		return 0, nil, wrapError(err)
 	}
 	defer cursor.Close(ctx)
 	for cursor.Next(ctx) {
 		daily := &dto.Daily{}
 		if err = cursor.Decode(&daily); err != nil {
-			return 0, nil, err
+			return 0, nil, wrapError(err)
 		}
 		dailies = append(dailies, daily)
 	}
@@ -10,25 +10,38 @@ import (
 type IUserDAO interface {
 	// Create creates new user
 	Create(ctx context.Context, user *dto.User) (*dto.User, error)
-	// Update updates user
-	Update(ctx context.Context, user *dto.User) (*dto.User, error)
+	// Patch sets only given fields of user if it is still at its version, lat and long set location
+	Patch(ctx context.Context, user *dto.User, fields []string) (*dto.User, error)
 	// Get gets user by ID
 	Get(ctx context.Context, id string) (*dto.User, error)
-	// BatchGet gets users by slice of IDs
+	// GetByEmail gets user by case-insensitive email
+	GetByEmail(ctx context.Context, email string) (*dto.User, error)
+	// BatchGet gets users by slice of IDs in a single query, users not found are omitted
 	BatchGet(ctx context.Context, ids []string) ([]*dto.User, error)
-	// Query queries users by sort, range, filter
-	Query(ctx context.Context, sort *dto.SortData, itemsRange *dto.RangeData, filter *dto.FilterData) (int64, []*dto.User, error)
-	// Delete deletes user by ID
-	Delete(ctx context.Context, id string) error
-	// BatchDelete deletes users by IDs
-	BatchDelete(ctx context.Context, ids []string) ([]string, error)
-	// GetNearbyUsers gets users within 50 meter
-	GetNearbyUsers(ctx context.Context, user *dto.User) (int64, []*dto.User, error)
+	// Query queries users by filters, sorts and range or page, returns total, users and next page token
+	Query(ctx context.Context, query *dto.QueryData) (int64, []*dto.User, string, error)
+	// Delete soft deletes user by ID at deletedAt
+	Delete(ctx context.Context, id string, deletedAt int64) error
+	// BatchDelete soft deletes users by IDs at deletedAt in a single operation, returns number of users deleted
+	BatchDelete(ctx context.Context, ids []string, deletedAt int64) (int64, error)
+	// Restore restores soft deleted user by ID, returns user as it was deleted, email taken since is rejected
+	Restore(ctx context.Context, id string) (*dto.User, error)
+	// GetDeleted gets IDs of at most limit users soft deleted before deletedBefore
+	GetDeleted(ctx context.Context, deletedBefore int64, limit int64) ([]string, error)
+	// Purge hard deletes soft deleted users by IDs, returns number of users purged
+	Purge(ctx context.Context, ids []string) (int64, error)
+	// GetNearbySymptomaticUsers gets users within radius in meter and updated since lastUpdated whose latest report has symptom, ordered by distance
+	GetNearbySymptomaticUsers(ctx context.Context, user *dto.User, radius float64, lastUpdated int64) (int64, []*dto.User, error)
+	// UpdateMany sets fields of users by IDs to those of user, returns number of users matched
+	UpdateMany(ctx context.Context, ids []string, user *dto.User, fields []string) (int64, error)
+	// DisableInactive sets active users last updated before given time to inactive, returns number of users updated
+	DisableInactive(ctx context.Context, lastUpdated int64) (int64, error)
+	// CountActive counts active users
+	CountActive(ctx context.Context) (int64, error)
 }
 
 // IAuthDAO ...
 type IAuthDAO interface {
-	InitIndex(ctx context.Context) error
 	// Create creates new auth token
 	Create(ctx context.Context, auth *dto.AuthObject) (*dto.AuthObject, error)
 	// Get gets auth token
@@ -37,6 +50,12 @@ type IAuthDAO interface {
 	Delete(ctx context.Context, token string) error
 	// DeleteByID deletes user by ID
 	DeleteByID(ctx context.Context, id string) error
+	// DeleteByIDs deletes tokens of users by IDs
+	DeleteByIDs(ctx context.Context, ids []string) error
+	// GetByUserID gets all tokens of user, including password reset tokens
+	GetByUserID(ctx context.Context, userID string) ([]*dto.AuthObject, error)
+	// PurgeByUserIDs deletes all tokens of users by IDs, including password reset tokens, returns number of tokens deleted
+	PurgeByUserIDs(ctx context.Context, userIDs []string) (int64, error)
 }
 
 // IReportDAO ...
@@ -45,16 +64,32 @@ type IReportDAO interface {
 	Create(ctx context.Context, report *dto.Report) (*dto.Report, error)
 	// Get gets report
 	Get(ctx context.Context, id string) (*dto.Report, error)
-	// BatchGet gets reports by slice of IDs
+	// Patch sets only given fields of report if it is still at its version, returns VersionError otherwise
+	Patch(ctx context.Context, report *dto.Report, fields []string) (*dto.Report, error)
+	// BatchGet gets reports by slice of IDs in a single query, reports not found are omitted
 	BatchGet(ctx context.Context, ids []string) ([]*dto.Report, error)
-	// Query queries reports by sort, range, filter
-	Query(ctx context.Context, sort *dto.SortData, itemsRange *dto.RangeData, filter *dto.FilterData) (int64, []*dto.Report, error)
-	// Delete deletes report by ID
-	Delete(ctx context.Context, id string) error
-	// BatchDelete deletes reports by IDs
-	BatchDelete(ctx context.Context, ids []string) ([]string, error)
-	// Update updates report
+	// GetByUserID gets all reports of user including soft deleted ones, oldest first
+	GetByUserID(ctx context.Context, userID string) ([]*dto.Report, error)
+	// Query queries reports by filters, sorts and range or page, returns total, reports and next page token
+	Query(ctx context.Context, query *dto.QueryData) (int64, []*dto.Report, string, error)
+	// Delete soft deletes report by ID at deletedAt
+	Delete(ctx context.Context, id string, deletedAt int64) error
+	// BatchDelete soft deletes reports by IDs at deletedAt in a single operation, returns number of reports deleted
+	BatchDelete(ctx context.Context, ids []string, deletedAt int64) (int64, error)
+	// DeleteByUserIDs soft deletes reports of users by IDs at deletedAt, returns number of reports deleted
+	DeleteByUserIDs(ctx context.Context, userIDs []string, deletedAt int64) (int64, error)
+	// Restore restores soft deleted report by ID, returns report as it was deleted
+	Restore(ctx context.Context, id string) (*dto.Report, error)
+	// RestoreByUserID restores reports of user soft deleted at deletedAt, returns number of reports restored
+	RestoreByUserID(ctx context.Context, userID string, deletedAt int64) (int64, error)
+	// Purge hard deletes reports soft deleted before deletedBefore, returns number of reports purged
+	Purge(ctx context.Context, deletedBefore int64) (int64, error)
+	// PurgeByUserIDs hard deletes all reports of users by IDs, returns number of reports purged
+	PurgeByUserIDs(ctx context.Context, userIDs []string) (int64, error)
+	// Update updates report if it is still at its version, returns VersionError otherwise
 	Update(ctx context.Context, report *dto.Report) (*dto.Report, error)
+	// UpdateMany sets symptom of reports by IDs to that of report, returns number of reports matched
+	UpdateMany(ctx context.Context, ids []string, report *dto.Report) (int64, error)
 }
 
 // ICovidDAO ...
@@ -63,14 +98,14 @@ type ICovidDAO interface {
 	Create(ctx context.Context, covid *dto.Covid) (*dto.Covid, error)
 	// Get gets covid
 	Get(ctx context.Context, id string) (*dto.Covid, error)
-	// BatchGet gets covids by slice of IDs
+	// BatchGet gets covids by slice of IDs in a single query, covids not found are omitted
 	BatchGet(ctx context.Context, ids []string) ([]*dto.Covid, error)
-	// Query queries covids by sort, range, filter
-	Query(ctx context.Context, sort *dto.SortData, itemsRange *dto.RangeData, filter *dto.FilterData) (int64, []*dto.Covid, error)
+	// Query queries covids by filters, sorts and range or page, returns total, covids and next page token
+	Query(ctx context.Context, query *dto.QueryData) (int64, []*dto.Covid, string, error)
 	// Delete deletes covid by ID
 	Delete(ctx context.Context, id string) error
-	// BatchDelete deletes covids by IDs
-	BatchDelete(ctx context.Context, ids []string) ([]string, error)
+	// BatchDelete deletes covids by IDs in a single operation, returns number of covids deleted
+	BatchDelete(ctx context.Context, ids []string) (int64, error)
 	// Update updates covid
 	Update(ctx context.Context, covid *dto.Covid) (*dto.Covid, error)
 }
@@ -81,13 +116,63 @@ type IDailyDAO interface {
 	Create(ctx context.Context, daily *dto.Daily) (*dto.Daily, error)
 	// Get gets daily
 	Get(ctx context.Context, id string) (*dto.Daily, error)
-	// BatchGet gets dailies by slice of IDs
+	// UpdateStates sets states of daily by last updated time, e.g. after classification
+	UpdateStates(ctx context.Context, daily *dto.Daily) error
+	// BatchGet gets dailies by slice of IDs in a single query, dailies not found are omitted
 	BatchGet(ctx context.Context, ids []string) ([]*dto.Daily, error)
-	// Query queries dailies by sort, range, filter
-	Query(ctx context.Context, sort *dto.SortData, itemsRange *dto.RangeData, filter *dto.FilterData) (int64, []*dto.Daily, error)
+	// Query queries dailies by filters, sorts and range
+	Query(ctx context.Context, query *dto.QueryData) (int64, []*dto.Daily, error)
 	// Delete deletes daily by ID
 	Delete(ctx context.Context, id string) error
-	// BatchDelete deletes dailies by IDs
-	BatchDelete(ctx context.Context, ids []string) ([]string, error)
+	// BatchDelete deletes dailies by IDs in a single operation, returns number of dailies deleted
+	BatchDelete(ctx context.Context, ids []string) (int64, error)
 	QueryByTimeRange(ctx context.Context, startTime int64, endTime int64) (int64, []*dto.Daily, error)
 }
+
+// IDistrictDAO ...
+type IDistrictDAO interface {
+	// Upsert creates or replaces district boundary
+	Upsert(ctx context.Context, district *dto.DistrictBoundary) (*dto.DistrictBoundary, error)
+	// GetByLocation gets district boundary containing location
+	GetByLocation(ctx context.Context, location *dto.Location) (*dto.DistrictBoundary, error)
+}
+
+// IJobDAO ...
+type IJobDAO interface {
+	// Acquire acquires job lease for owner until leaseUntil, returns false if lease is held by another owner or job
+	// already ran since slot
+	Acquire(ctx context.Context, name string, owner string, now int64, leaseUntil int64, slot int64) (bool, error)
+	// Release releases job lease and records run status
+	Release(ctx context.Context, job *dto.Job) error
+	// Query queries all jobs
+	Query(ctx context.Context) ([]*dto.Job, error)
+}
+
+// ILocationDAO ...
+type ILocationDAO interface {
+	// Create appends location to location history
+	Create(ctx context.Context, location *dto.LocationHistory) (*dto.LocationHistory, error)
+	// Heatmap counts distinct users per geohash cell within bounding box and time range
+	Heatmap(ctx context.Context, box *dto.BoundingBox, startTime int64, endTime int64, precision int, minCount int64, limit int64) ([]*dto.HeatmapCell, error)
+	// GetByUserID gets location history of user, oldest first
+	GetByUserID(ctx context.Context, userID string) ([]*dto.LocationHistory, error)
+	// DeleteByUserIDs deletes location history of users by IDs, returns number of locations deleted
+	DeleteByUserIDs(ctx context.Context, userIDs []string) (int64, error)
+}
+
+// IAuditDAO ...
+type IAuditDAO interface {
+	// Create appends audit record
+	Create(ctx context.Context, audit *dto.Audit) (*dto.Audit, error)
+	// BatchCreate appends audit records in a single operation
+	BatchCreate(ctx context.Context, audits []*dto.Audit) error
+	// Query queries audit records by filters, sorts and range or page, returns total, audit records and next page token
+	Query(ctx context.Context, query *dto.QueryData) (int64, []*dto.Audit, string, error)
+}
+
+// ITransactionDAO ...
+type ITransactionDAO interface {
+	// WithTransaction runs fn in a transaction, operations of DAOs using the context passed to fn are committed
+	// if fn returns nil and aborted otherwise
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
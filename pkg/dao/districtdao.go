@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// DistrictDAO ...
+type DistrictDAO struct {
+	client *mongo.Client
+}
+
+// InitDistrictDAO ...
+func InitDistrictDAO(client *mongo.Client) IDistrictDAO {
+	return &DistrictDAO{client: client}
+}
+
+// Upsert creates or replaces district boundary
+func (v *DistrictDAO) Upsert(ctx context.Context, district *dto.DistrictBoundary) (*dto.DistrictBoundary, error) {
+	ctx, span := startSpan(ctx, "DistrictDAO.Upsert")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Districts)
+	_, err := collection.ReplaceOne(ctx, bson.D{{constants.ID, district.ID}}, district, options.Replace().SetUpsert(true))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return district, nil
+}
+
+// GetByLocation gets district boundary containing location
+func (v *DistrictDAO) GetByLocation(ctx context.Context, location *dto.Location) (*dto.DistrictBoundary, error) {
+	ctx, span := startSpan(ctx, "DistrictDAO.GetByLocation")
+	defer span.End()
+
+	if location == nil || len(location.Coordinates) != 2 {
+		return nil, constants.InvalidArgumentError
+	}
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Districts)
+	query := bson.M{
+		constants.Geometry: bson.M{
+			"$geoIntersects": bson.M{
+				"$geometry": bson.M{
+					constants.Type:        "Point",
+					constants.Coordinates: location.Coordinates,
+				},
+			},
+		},
+	}
+
+	district := &dto.DistrictBoundary{}
+	if err := collection.FindOne(ctx, query).Decode(&district); err != nil {
+		return nil, wrapError(err)
+	}
+	return district, nil
+}
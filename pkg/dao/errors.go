@@ -0,0 +1,152 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/topology"
+)
+
+// duplicateKeyCode is MongoDB error code of unique index violation
+const duplicateKeyCode = 11000
+
+// Sentinel errors returned by DAOs, check with errors.Is
+var (
+	// ErrNotFound is returned when no document matches
+	ErrNotFound = errors.New("not found")
+	// ErrVersionConflict is returned when document has been modified since expected version, see VersionError
+	ErrVersionConflict = errors.New("version conflict")
+	// ErrConflict is returned when write violates a unique index
+	ErrConflict = errors.New("conflict")
+	// ErrUnavailable is returned when database cannot be reached
+	ErrUnavailable = errors.New("database unavailable")
+	// ErrTimeout is returned when operation exceeds its deadline
+	ErrTimeout = errors.New("database timeout")
+)
+
+// Error is a DAO error of Kind, one of the sentinel errors, caused by Err
+type Error struct {
+	Kind error
+	Err  error
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	return e.Kind.Error() + ": " + e.Err.Error()
+}
+
+// Unwrap returns cause of error
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether error is of kind target
+func (e *Error) Is(target error) bool {
+	return e.Kind == target
+}
+
+// VersionError is returned by conditional update when document has been modified since expected version
+type VersionError struct {
+	// Current is current version of document
+	Current int64
+}
+
+// Error implements the error interface
+func (e *VersionError) Error() string {
+	return fmt.Sprintf("%s: current version is %d", ErrVersionConflict.Error(), e.Current)
+}
+
+// Is reports whether target is ErrVersionConflict
+func (e *VersionError) Is(target error) bool {
+	return target == ErrVersionConflict
+}
+
+// wrapError classifies MongoDB driver error into DAO error, other errors are returned as is
+func wrapError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var daoErr *Error
+	if errors.As(err, &daoErr) {
+		return err
+	}
+
+	var kind error
+	switch {
+	case err == mongo.ErrNoDocuments:
+		kind = ErrNotFound
+	case isDuplicateKeyError(err):
+		kind = ErrConflict
+	case isTimeoutError(err):
+		kind = ErrTimeout
+	case isUnavailableError(err):
+		kind = ErrUnavailable
+	default:
+		return err
+	}
+	return &Error{Kind: kind, Err: err}
+}
+
+// isTimeoutError checks if err is caused by expired deadline or time limit of the operation
+func isTimeoutError(err error) bool {
+	if mongo.IsTimeout(err) {
+		return true
+	}
+	var e mongo.CommandError
+	return errors.As(err, &e) && e.IsMaxTimeMSExpiredError()
+}
+
+// isUnavailableError checks if err is caused by unreachable database
+func isUnavailableError(err error) bool {
+	if errors.Is(err, mongo.ErrClientDisconnected) || errors.Is(err, topology.ErrTopologyClosed) {
+		return true
+	}
+	var selection topology.ServerSelectionError
+	var connection topology.ConnectionError
+	return mongo.IsNetworkError(err) || errors.As(err, &selection) || errors.As(err, &connection)
+}
+
+// duplicateKeyIndex matches name of violated index in duplicate key error message, e.g. "index: id_1 dup key", the
+// first match is the index since duplicated value only follows it
+var duplicateKeyIndex = regexp.MustCompile(`index: (\S+) dup key`)
+
+// duplicateKeyMessages returns messages of duplicate key errors of err
+func duplicateKeyMessages(err error) []string {
+	var messages []string
+	switch e := err.(type) {
+	case mongo.WriteException:
+		for _, we := range e.WriteErrors {
+			if we.Code == duplicateKeyCode {
+				messages = append(messages, we.Message)
+			}
+		}
+	case mongo.BulkWriteException:
+		for _, we := range e.WriteErrors {
+			if we.Code == duplicateKeyCode {
+				messages = append(messages, we.Message)
+			}
+		}
+	case mongo.CommandError:
+		if e.Code == duplicateKeyCode {
+			messages = append(messages, e.Message)
+		}
+	}
+	return messages
+}
+
+// isDuplicateKeyError checks if err is caused by unique index violation
+func isDuplicateKeyError(err error) bool {
+	return len(duplicateKeyMessages(err)) > 0
+}
+
+// isDuplicateKeyOn checks if err is caused by violation of unique index by name
+func isDuplicateKeyOn(err error, index string) bool {
+	for _, message := range duplicateKeyMessages(err) {
+		if match := duplicateKeyIndex.FindStringSubmatch(message); match != nil && match[1] == index {
+			return true
+		}
+	}
+	return false
+}
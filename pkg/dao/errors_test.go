@@ -0,0 +1,77 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/topology"
+)
+
+// TestIsDuplicateKeyOn ...
+func TestIsDuplicateKeyOn(t *testing.T) {
+	duplicateEmail := mongo.WriteException{WriteErrors: []mongo.WriteError{{
+		Code:    11000,
+		Message: `E11000 duplicate key error collection: galasejahtera.users index: normalizedEmail_1 dup key: { normalizedEmail: "a@b.com" }`,
+	}}}
+	duplicateID := mongo.WriteException{WriteErrors: []mongo.WriteError{{
+		Code:    11000,
+		Message: `E11000 duplicate key error collection: galasejahtera.users index: id_1 dup key: { id: "index: normalizedEmail_1 dup key" }`,
+	}}}
+
+	tests := []struct {
+		name           string
+		err            error
+		index          string
+		expectedResult bool
+	}{
+		{name: "duplicate email, should return true", err: duplicateEmail, index: "normalizedEmail_1", expectedResult: true},
+		{name: "duplicate email on other index, should return false", err: duplicateEmail, index: "id_1", expectedResult: false},
+		{name: "duplicate id with index name in value, should return false", err: duplicateID, index: "normalizedEmail_1", expectedResult: false},
+		{name: "duplicate email of command, should return true", err: mongo.CommandError{Code: 11000, Message: duplicateEmail.WriteErrors[0].Message}, index: "normalizedEmail_1", expectedResult: true},
+		{name: "other write error, should return false", err: mongo.WriteException{WriteErrors: []mongo.WriteError{{Code: 121}}}, index: "normalizedEmail_1", expectedResult: false},
+		{name: "other error, should return false", err: errors.New("index: normalizedEmail_1 dup key"), index: "normalizedEmail_1", expectedResult: false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expectedResult, isDuplicateKeyOn(test.err, test.index), test.name)
+	}
+}
+
+// TestWrapError ...
+func TestWrapError(t *testing.T) {
+	other := errors.New("other")
+	tests := []struct {
+		name         string
+		err          error
+		expectedKind error
+	}{
+		{name: "no documents, should return not found", err: mongo.ErrNoDocuments, expectedKind: ErrNotFound},
+		{name: "duplicate key, should return conflict", err: mongo.WriteException{WriteErrors: []mongo.WriteError{{Code: 11000}}}, expectedKind: ErrConflict},
+		{name: "deadline exceeded, should return timeout", err: context.DeadlineExceeded, expectedKind: ErrTimeout},
+		{name: "max time expired, should return timeout", err: mongo.CommandError{Code: 50}, expectedKind: ErrTimeout},
+		{name: "network error, should return unavailable", err: mongo.CommandError{Labels: []string{"NetworkError"}}, expectedKind: ErrUnavailable},
+		{name: "connection error, should return unavailable", err: topology.ConnectionError{Wrapped: errors.New("connection refused")}, expectedKind: ErrUnavailable},
+		{name: "network timeout label, should return timeout", err: mongo.CommandError{Labels: []string{"NetworkTimeoutError"}}, expectedKind: ErrTimeout},
+		{name: "connection deadline exceeded, should return timeout", err: topology.ConnectionError{Wrapped: context.DeadlineExceeded}, expectedKind: ErrTimeout},
+		{name: "server selection error, should return unavailable", err: topology.ServerSelectionError{Wrapped: topology.ErrServerSelectionTimeout}, expectedKind: ErrUnavailable},
+		{name: "client disconnected, should return unavailable", err: mongo.ErrClientDisconnected, expectedKind: ErrUnavailable},
+		{name: "server selection message only, should return as is", err: errors.New("server selection error: context deadline exceeded"), expectedKind: nil},
+		{name: "other error, should return as is", err: other, expectedKind: nil},
+	}
+
+	for _, test := range tests {
+		err := wrapError(test.err)
+		if test.expectedKind == nil {
+			assert.Equal(t, test.err, err, test.name)
+			continue
+		}
+		assert.True(t, errors.Is(err, test.expectedKind), test.name)
+		assert.Equal(t, test.err, errors.Unwrap(err), test.name)
+		// wrapping twice keeps kind
+		assert.Equal(t, err, wrapError(err), test.name)
+	}
+	assert.Nil(t, wrapError(nil))
+}
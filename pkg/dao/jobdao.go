@@ -0,0 +1,98 @@
+package dao
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// JobDAO ...
+type JobDAO struct {
+	client *mongo.Client
+}
+
+// InitJobDAO ...
+func InitJobDAO(client *mongo.Client) IJobDAO {
+	return &JobDAO{client: client}
+}
+
+// Acquire acquires job lease for owner until leaseUntil, returns false if lease is held by another owner or job
+// already ran since slot, so that each scheduled run happens on one replica only
+func (v *JobDAO) Acquire(ctx context.Context, name string, owner string, now int64, leaseUntil int64, slot int64) (bool, error) {
+	ctx, span := startSpan(ctx, "JobDAO.Acquire")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Jobs)
+
+	// lease can be taken if it is expired or already owned, and no replica ran the job since slot
+	query := bson.D{
+		{constants.Name, name},
+		{"$or", bson.A{
+			bson.D{{constants.LeaseUntil, bson.D{{"$lte", now}}}},
+			bson.D{{constants.Owner, owner}},
+		}},
+		{"$nor", bson.A{bson.D{{constants.LastRun, bson.D{{"$gte", slot}}}}}},
+	}
+	update := bson.D{{"$set", bson.D{
+		{constants.Owner, owner},
+		{constants.LeaseUntil, leaseUntil},
+	}}}
+
+	// upsert fails with duplicate key if the job exists but lease is held by another owner
+	_, err := collection.UpdateOne(ctx, query, update, options.Update().SetUpsert(true))
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, wrapError(err)
+	}
+	return true, nil
+}
+
+// Release releases job lease and records run status
+func (v *JobDAO) Release(ctx context.Context, job *dto.Job) error {
+	ctx, span := startSpan(ctx, "JobDAO.Release")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Jobs)
+	_, err := collection.UpdateOne(ctx, bson.D{
+		{constants.Name, job.Name},
+		{constants.Owner, job.Owner},
+	}, bson.D{
+		{"$set", bson.D{
+			{constants.LeaseUntil, job.LeaseUntil},
+			{constants.LastRun, job.LastRun},
+			{constants.LastDuration, job.LastDuration},
+			{constants.LastError, job.LastError},
+		}},
+		{"$inc", bson.D{{constants.RunCount, 1}}},
+	})
+	return wrapError(err)
+}
+
+// Query queries all jobs
+func (v *JobDAO) Query(ctx context.Context) ([]*dto.Job, error) {
+	ctx, span := startSpan(ctx, "JobDAO.Query")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Jobs)
+	cursor, err := collection.Find(ctx, bson.D{{}})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	var jobs []*dto.Job
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		job := &dto.Job{}
+		if err = cursor.Decode(&job); err != nil {
+			return nil, wrapError(err)
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
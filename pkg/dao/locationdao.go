@@ -0,0 +1,129 @@
+package dao
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// LocationDAO ...
+type LocationDAO struct {
+	client *mongo.Client
+}
+
+// InitLocationDAO ...
+func InitLocationDAO(client *mongo.Client) ILocationDAO {
+	return &LocationDAO{client: client}
+}
+
+// Create appends location to location history
+func (v *LocationDAO) Create(ctx context.Context, location *dto.LocationHistory) (*dto.LocationHistory, error) {
+	ctx, span := startSpan(ctx, "LocationDAO.Create")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Locations)
+	if _, err := collection.InsertOne(ctx, location); err != nil {
+		return nil, wrapError(err)
+	}
+	return location, nil
+}
+
+// GetByUserID gets location history of user, oldest first
+func (v *LocationDAO) GetByUserID(ctx context.Context, userID string) ([]*dto.LocationHistory, error) {
+	ctx, span := startSpan(ctx, "LocationDAO.GetByUserID")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Locations)
+	cursor, err := collection.Find(ctx, bson.D{{constants.UserId, userID}}, options.Find().
+		SetSort(bson.D{{constants.CreatedAt, 1}}))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	var locations []*dto.LocationHistory
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		location := &dto.LocationHistory{}
+		if err = cursor.Decode(&location); err != nil {
+			return nil, wrapError(err)
+		}
+		locations = append(locations, location)
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, wrapError(err)
+	}
+	return locations, nil
+}
+
+// DeleteByUserIDs deletes location history of users by IDs, returns number of locations deleted
+func (v *LocationDAO) DeleteByUserIDs(ctx context.Context, userIDs []string) (int64, error) {
+	ctx, span := startSpan(ctx, "LocationDAO.DeleteByUserIDs")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Locations)
+	result, err := collection.DeleteMany(ctx, byUserIDs(userIDs))
+	if err != nil {
+		return 0, wrapError(err)
+	}
+	return result.DeletedCount, nil
+}
+
+// Heatmap counts distinct users per geohash cell of given precision within bounding box and time range,
+// cells with fewer than minCount users are left out
+func (v *LocationDAO) Heatmap(ctx context.Context, box *dto.BoundingBox, startTime int64, endTime int64, precision int, minCount int64, limit int64) ([]*dto.HeatmapCell, error) {
+	ctx, span := startSpan(ctx, "LocationDAO.Heatmap")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Locations)
+
+	polygon := bson.A{bson.A{
+		bson.A{box.MinLong, box.MinLat},
+		bson.A{box.MaxLong, box.MinLat},
+		bson.A{box.MaxLong, box.MaxLat},
+		bson.A{box.MinLong, box.MaxLat},
+		bson.A{box.MinLong, box.MinLat},
+	}}
+	pipeline := mongo.Pipeline{
+		{{"$match", bson.D{
+			{constants.Location, bson.D{{"$geoWithin", bson.D{{"$geometry", bson.D{
+				{constants.Type, "Polygon"},
+				{constants.Coordinates, polygon},
+			}}}}}},
+			{constants.CreatedAt, bson.D{{"$gte", startTime}, {"$lte", endTime}}},
+		}}},
+		// heatmap cell is prefix of stored geohash
+		{{"$group", bson.D{
+			{"_id", bson.D{
+				{constants.Geohash, bson.D{{"$substrCP", bson.A{"$" + constants.Geohash, 0, precision}}}},
+				{constants.UserId, "$" + constants.UserId},
+			}},
+		}}},
+		{{"$group", bson.D{
+			{"_id", "$_id." + constants.Geohash},
+			{constants.Count, bson.D{{"$sum", 1}}},
+		}}},
+		{{"$match", bson.D{{constants.Count, bson.D{{"$gte", minCount}}}}}},
+		{{"$sort", bson.D{{constants.Count, -1}, {"_id", 1}}}},
+		{{"$limit", limit}},
+	}
+
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	var cells []*dto.HeatmapCell
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		cell := &dto.HeatmapCell{}
+		if err = cursor.Decode(&cell); err != nil {
+			return nil, wrapError(err)
+		}
+		cells = append(cells, cell)
+	}
+	return cells, nil
+}
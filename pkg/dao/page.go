@@ -0,0 +1,152 @@
+package dao
+
+import (
+	"encoding/base64"
+	"fmt"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"hash/fnv"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// pageToken is content of opaque next page token
+type pageToken struct {
+	// Values is sort key of last item of previous page, in order of sort fields
+	Values []bson.RawValue `bson:"v"`
+	// Query is hash of filters and sorts the token is issued for
+	Query uint32 `bson:"q"`
+}
+
+// pager collects items of a page and issues next page token
+type pager struct {
+	size  int
+	sort  bson.D
+	query uint32
+	count int
+	last  bson.Raw
+	more  bool
+}
+
+// add adds raw document to page, returns false if page is full and document belongs to next page
+func (p *pager) add(doc bson.Raw) bool {
+	if p.size == 0 {
+		return true
+	}
+	if p.count == p.size {
+		p.more = true
+		return false
+	}
+	p.count++
+	// cursor reuses its buffer, keep a copy
+	p.last = append(p.last[:0], doc...)
+	return true
+}
+
+// nextToken returns next page token, empty if there is no next page
+func (p *pager) nextToken() (string, error) {
+	if !p.more {
+		return "", nil
+	}
+	token := &pageToken{Query: p.query}
+	for _, e := range p.sort {
+		v, err := p.last.LookupErr(e.Key)
+		if err != nil {
+			// missing field sorts as null
+			v = bson.RawValue{Type: bsontype.Null}
+		}
+		token.Values = append(token.Values, v)
+	}
+	b, err := bson.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// paginate sets up keyset pagination of query, sort is completed with id as tie breaker and filter is restricted
+// to items after page token
+func paginate(query *dto.QueryData, filter bson.D, sort bson.D) (*pager, bson.D, bson.D, error) {
+	if query == nil || query.Page == nil {
+		return &pager{}, filter, sort, nil
+	}
+
+	size := query.Page.Size
+	if size <= 0 {
+		size = constants.PageSize
+	}
+	if size > constants.MaxPageSize {
+		size = constants.MaxPageSize
+	}
+	hasID := false
+	for _, e := range sort {
+		hasID = hasID || e.Key == constants.ID
+	}
+	if !hasID {
+		sort = append(sort, bson.E{Key: constants.ID, Value: 1})
+	}
+	p := &pager{size: size, sort: sort, query: queryHash(query)}
+	if query.Page.Token == "" {
+		return p, filter, sort, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(query.Page.Token)
+	if err != nil {
+		return nil, nil, nil, constants.InvalidPageTokenError
+	}
+	token := &pageToken{}
+	if err := bson.Unmarshal(b, token); err != nil || token.Query != p.query || len(token.Values) != len(sort) {
+		return nil, nil, nil, constants.InvalidPageTokenError
+	}
+
+	after := keysetFilter(sort, token.Values)
+	if len(filter) == 0 {
+		return p, after, sort, nil
+	}
+	return p, bson.D{{"$and", bson.A{filter, after}}}, sort, nil
+}
+
+// keysetFilter matches items after sort key values, i.e. greater in first differing sort field by its order. Null,
+// also of missing field, sorts before other values but only matches null in comparisons, so it is compared explicitly
+func keysetFilter(sort bson.D, values []bson.RawValue) bson.D {
+	var or bson.A
+	for i, e := range sort {
+		prefix := bson.D{}
+		for j := 0; j < i; j++ {
+			prefix = append(prefix, bson.E{Key: sort[j].Key, Value: values[j]})
+		}
+		after := func(condition interface{}) bson.D {
+			return append(prefix[:len(prefix):len(prefix)], bson.E{Key: e.Key, Value: condition})
+		}
+
+		null := values[i].Type == bsontype.Null
+		switch {
+		case e.Value != -1 && null:
+			or = append(or, after(bson.D{{"$ne", nil}}))
+		case e.Value != -1:
+			or = append(or, after(bson.D{{"$gt", values[i]}}))
+		case !null:
+			// nothing sorts before null in descending order
+			or = append(or, after(bson.D{{"$lt", values[i]}}), after(nil))
+		}
+	}
+	if len(or) == 0 {
+		// last item sorts last in every field, nothing follows it
+		return bson.D{{constants.ID, bson.D{{"$in", bson.A{}}}}}
+	}
+	return bson.D{{"$or", or}}
+}
+
+// queryHash hashes filters, search and sorts of query, so that page token cannot be reused with other query
+func queryHash(query *dto.QueryData) uint32 {
+	h := fnv.New32a()
+	for _, f := range query.Filters {
+		fmt.Fprintf(h, "f%q%q%q", f.Item, f.Operator, f.Value)
+	}
+	for _, s := range query.Sorts {
+		fmt.Fprintf(h, "s%q%q", s.Item, s.Order)
+	}
+	fmt.Fprintf(h, "q%q", query.Search)
+	return h.Sum32()
+}
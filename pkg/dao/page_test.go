@@ -0,0 +1,119 @@
+package dao
+
+import (
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// TestPaginate ...
+func TestPaginate(t *testing.T) {
+	sorts := []*dto.SortData{{Item: constants.CreatedAt, Order: constants.DESC}}
+	filters := []*dto.FilterData{{Item: constants.HasSymptom, Operator: constants.EQ, Value: "true"}}
+	filter := bson.D{{constants.HasSymptom, true}}
+	sort := bson.D{{constants.CreatedAt, -1}}
+
+	// issue token from the last report of a full page
+	first := &dto.QueryData{Filters: filters, Sorts: sorts, Page: &dto.PageData{Size: 2}}
+	p, _, pageSort, err := paginate(first, filter, sort)
+	assert.Nil(t, err)
+	assert.Equal(t, bson.D{{constants.CreatedAt, -1}, {constants.ID, 1}}, pageSort)
+	for i, id := range []string{"a", "b", "c"} {
+		doc, _ := bson.Marshal(&dto.Report{ID: id, CreatedAt: int64(100 - i)})
+		assert.Equal(t, i < 2, p.add(doc))
+	}
+	token, err := p.nextToken()
+	assert.Nil(t, err)
+	assert.NotEmpty(t, token)
+
+	tests := []struct {
+		name           string
+		query          *dto.QueryData
+		expectedResult bson.D
+		expectedError  error
+	}{
+		{
+			name:  "next page, should return reports after last report",
+			query: &dto.QueryData{Filters: filters, Sorts: sorts, Page: &dto.PageData{Size: 2, Token: token}},
+			expectedResult: bson.D{{"$and", bson.A{filter, bson.D{{"$or", bson.A{
+				bson.D{{constants.CreatedAt, bson.D{{"$lt", int64(99)}}}},
+				bson.D{{constants.CreatedAt, nil}},
+				bson.D{{constants.CreatedAt, int64(99)}, {constants.ID, bson.D{{"$gt", "b"}}}},
+			}}}}}},
+		},
+		{
+			name:          "token of other query, should return error",
+			query:         &dto.QueryData{Sorts: sorts, Page: &dto.PageData{Size: 2, Token: token}},
+			expectedError: constants.InvalidPageTokenError,
+		},
+		{
+			name:          "malformed token, should return error",
+			query:         &dto.QueryData{Filters: filters, Sorts: sorts, Page: &dto.PageData{Size: 2, Token: "!!"}},
+			expectedError: constants.InvalidPageTokenError,
+		},
+	}
+
+	for _, test := range tests {
+		_, pageFilter, _, err := paginate(test.query, filter, sort)
+		assert.Equal(t, test.expectedError, err, test.name)
+		if test.expectedError != nil {
+			continue
+		}
+		// compare through extended json since token values are raw
+		expected, _ := bson.MarshalExtJSON(test.expectedResult, false, false)
+		actual, _ := bson.MarshalExtJSON(pageFilter, false, false)
+		assert.JSONEq(t, string(expected), string(actual), test.name)
+	}
+}
+
+// TestKeysetFilter ...
+func TestKeysetFilter(t *testing.T) {
+	null := bson.RawValue{Type: bsontype.Null}
+	id := bson.RawValue{Type: bsontype.String, Value: bsoncore.AppendString(nil, "b")}
+
+	tests := []struct {
+		name           string
+		sort           bson.D
+		values         []bson.RawValue
+		expectedResult bson.D
+	}{
+		{
+			name:   "ascending null value, should match non null values",
+			sort:   bson.D{{constants.Name, 1}, {constants.ID, 1}},
+			values: []bson.RawValue{null, id},
+			expectedResult: bson.D{{"$or", bson.A{
+				bson.D{{constants.Name, bson.D{{"$ne", nil}}}},
+				bson.D{{constants.Name, nil}, {constants.ID, bson.D{{"$gt", "b"}}}},
+			}}},
+		},
+		{
+			name:   "descending null value, should only match later null values",
+			sort:   bson.D{{constants.Name, -1}, {constants.ID, 1}},
+			values: []bson.RawValue{null, id},
+			expectedResult: bson.D{{"$or", bson.A{
+				bson.D{{constants.Name, nil}, {constants.ID, bson.D{{"$gt", "b"}}}},
+			}}},
+		},
+	}
+
+	for _, test := range tests {
+		expected, _ := bson.MarshalExtJSON(test.expectedResult, false, false)
+		actual, _ := bson.MarshalExtJSON(keysetFilter(test.sort, test.values), false, false)
+		assert.JSONEq(t, string(expected), string(actual), test.name)
+	}
+}
+
+// TestPagerLastPage ...
+func TestPagerLastPage(t *testing.T) {
+	p := &pager{size: 2, sort: bson.D{{constants.ID, 1}}}
+	doc, _ := bson.Marshal(&dto.Report{ID: "a"})
+	assert.True(t, p.add(doc))
+	token, err := p.nextToken()
+	assert.Nil(t, err)
+	assert.Empty(t, token)
+}
@@ -0,0 +1,291 @@
+package dao
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// fieldKind is value type of queryable field
+type fieldKind int
+
+const (
+	stringField fieldKind = iota
+	intField
+	boolField
+)
+
+// queryFields whitelists fields of an entity that can be filtered and sorted
+type queryFields struct {
+	// fields maps field to its value type
+	fields map[string]fieldKind
+	// search lists fields matched by search
+	search []string
+	// softDelete excludes soft deleted documents
+	softDelete bool
+}
+
+var userFields = queryFields{
+	fields: map[string]fieldKind{
+		constants.ID:          stringField,
+		constants.Role:        stringField,
+		constants.Email:       stringField,
+		constants.Name:        stringField,
+		constants.IsActive:    boolField,
+		constants.UserUpdated: intField,
+	},
+	search:     []string{constants.Name, constants.Email, constants.PhoneNumber, constants.IC},
+	softDelete: true,
+}
+
+var reportFields = queryFields{
+	fields: map[string]fieldKind{
+		constants.ID:         stringField,
+		constants.UserId:     stringField,
+		constants.CreatedAt:  intField,
+		constants.HasSymptom: boolField,
+	},
+	search:     []string{constants.UserId},
+	softDelete: true,
+}
+
+var covidFields = queryFields{
+	fields: map[string]fieldKind{
+		constants.ID:    stringField,
+		constants.Title: stringField,
+		constants.SID:   intField,
+	},
+	search: []string{constants.Title},
+}
+
+var auditFields = queryFields{
+	fields: map[string]fieldKind{
+		constants.ID:        stringField,
+		constants.Actor:     stringField,
+		constants.Action:    stringField,
+		constants.Target:    stringField,
+		constants.RequestID: stringField,
+		constants.CreatedAt: intField,
+	},
+}
+
+var dailyFields = queryFields{
+	fields: map[string]fieldKind{
+		constants.LastUpdated: intField,
+	},
+}
+
+// find finds documents of collection matching query, returns cursor, total count ignoring range and pagination
+// unless skipped, and pager that documents of cursor are added to
+func find(ctx context.Context, collection *mongo.Collection, query *dto.QueryData, fields queryFields) (*mongo.Cursor, int64, *pager, error) {
+	filter, err := fields.filter(query)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	sort, err := fields.sort(query)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	if fields.softDelete {
+		filter = append(filter, notDeleted)
+	}
+
+	// count before restricting filter to current page
+	var count int64
+	if query == nil || !query.NoTotal {
+		count, err = collection.CountDocuments(ctx, filter)
+		if err != nil {
+			return nil, 0, nil, wrapError(err)
+		}
+	}
+
+	p, filter, sort, err := paginate(query, filter, sort)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	findOptions := options.Find()
+	if len(sort) > 0 {
+		findOptions.SetSort(sort)
+	}
+	if p.size > 0 {
+		// fetch one more to know if there is a next page
+		findOptions.SetLimit(int64(p.size + 1))
+	} else if query != nil && query.Range != nil {
+		findOptions.SetSkip(int64(query.Range.From))
+		findOptions.SetLimit(int64(query.Range.To + 1 - query.Range.From))
+	}
+
+	cursor, err := collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, 0, nil, wrapError(err)
+	}
+	return cursor, count, p, nil
+}
+
+// filter builds MongoDB filter of query, fields not in whitelist are rejected
+func (f queryFields) filter(query *dto.QueryData) (bson.D, error) {
+	if query == nil {
+		return bson.D{}, nil
+	}
+	if len(query.Filters) > constants.MaxQueryFilters {
+		return nil, constants.InvalidFilterError
+	}
+
+	var conditions bson.A
+	if query.Search != "" {
+		if len(f.search) == 0 {
+			return nil, constants.InvalidFilterError
+		}
+		var or bson.A
+		for _, field := range f.search {
+			or = append(or, bson.D{{field, bson.D{
+				{"$regex", regexp.QuoteMeta(query.Search)},
+				{"$options", "i"},
+			}}})
+		}
+		conditions = append(conditions, bson.D{{"$or", or}})
+	}
+	for _, filter := range query.Filters {
+		condition, err := f.condition(filter)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	switch len(conditions) {
+	case 0:
+		return bson.D{}, nil
+	case 1:
+		return conditions[0].(bson.D), nil
+	}
+	// $and keeps multiple conditions on the same field
+	return bson.D{{"$and", conditions}}, nil
+}
+
+// condition builds MongoDB condition of filter
+func (f queryFields) condition(filter *dto.FilterData) (bson.D, error) {
+	kind, ok := f.fields[filter.Item]
+	if !ok {
+		return nil, constants.InvalidFilterError
+	}
+
+	operator := filter.Operator
+	if operator == "" {
+		operator = constants.EQ
+	}
+	switch operator {
+	case constants.EQ:
+		v, err := parseValue(kind, filter.Value)
+		if err != nil {
+			return nil, err
+		}
+		return bson.D{{filter.Item, v}}, nil
+	case constants.NE:
+		v, err := parseValue(kind, filter.Value)
+		if err != nil {
+			return nil, err
+		}
+		return bson.D{{filter.Item, bson.D{{"$ne", v}}}}, nil
+	case constants.IN:
+		values := strings.Split(filter.Value, ",")
+		if len(values) > constants.MaxQueryValues {
+			return nil, constants.InvalidFilterError
+		}
+		var in bson.A
+		for _, value := range values {
+			v, err := parseValue(kind, value)
+			if err != nil {
+				return nil, err
+			}
+			in = append(in, v)
+		}
+		return bson.D{{filter.Item, bson.D{{"$in", in}}}}, nil
+	case constants.GT, constants.GTE, constants.LT, constants.LTE:
+		if kind == boolField {
+			return nil, constants.InvalidFilterError
+		}
+		v, err := parseValue(kind, filter.Value)
+		if err != nil {
+			return nil, err
+		}
+		return bson.D{{filter.Item, bson.D{{"$" + operator, v}}}}, nil
+	case constants.RANGE:
+		// range is inclusive "from,to", either bound may be empty
+		bounds := strings.Split(filter.Value, ",")
+		if kind == boolField || len(bounds) != 2 || (bounds[0] == "" && bounds[1] == "") {
+			return nil, constants.InvalidFilterError
+		}
+		var r bson.D
+		for i, op := range []string{"$gte", "$lte"} {
+			if bounds[i] == "" {
+				continue
+			}
+			v, err := parseValue(kind, bounds[i])
+			if err != nil {
+				return nil, err
+			}
+			r = append(r, bson.E{Key: op, Value: v})
+		}
+		return bson.D{{filter.Item, r}}, nil
+	case constants.PREFIX:
+		if kind != stringField || filter.Value == "" {
+			return nil, constants.InvalidFilterError
+		}
+		// anchored case-sensitive prefix can use index
+		return bson.D{{filter.Item, bson.D{{"$regex", "^" + regexp.QuoteMeta(filter.Value)}}}}, nil
+	}
+	return nil, constants.InvalidFilterError
+}
+
+// sort builds MongoDB sort of query, fields not in whitelist are rejected
+func (f queryFields) sort(query *dto.QueryData) (bson.D, error) {
+	if query == nil {
+		return nil, nil
+	}
+	if len(query.Sorts) > constants.MaxQuerySorts {
+		return nil, constants.InvalidSortError
+	}
+
+	var sort bson.D
+	for _, s := range query.Sorts {
+		if _, ok := f.fields[s.Item]; !ok {
+			return nil, constants.InvalidSortError
+		}
+		switch strings.ToUpper(s.Order) {
+		case "", constants.ASC:
+			sort = append(sort, bson.E{Key: s.Item, Value: 1})
+		case constants.DESC:
+			sort = append(sort, bson.E{Key: s.Item, Value: -1})
+		default:
+			return nil, constants.InvalidSortError
+		}
+	}
+	return sort, nil
+}
+
+// parseValue parses filter value by field kind
+func parseValue(kind fieldKind, value string) (interface{}, error) {
+	var v interface{}
+	var err error
+	switch kind {
+	case intField:
+		v, err = strconv.ParseInt(value, 10, 64)
+	case boolField:
+		v, err = strconv.ParseBool(value)
+	default:
+		v = value
+	}
+	if err != nil {
+		return nil, constants.InvalidFilterError
+	}
+	return v, nil
+}
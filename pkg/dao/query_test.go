@@ -0,0 +1,115 @@
+package dao
+
+import (
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// TestQueryFieldsFilter ...
+func TestQueryFieldsFilter(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          *dto.QueryData
+		expectedResult bson.D
+		expectedError  error
+	}{
+		{
+			name:           "no query, should match all",
+			query:          nil,
+			expectedResult: bson.D{},
+		},
+		{
+			name: "created between dates with symptom, should combine with and",
+			query: &dto.QueryData{Filters: []*dto.FilterData{
+				{Item: constants.CreatedAt, Operator: constants.RANGE, Value: "1588291200000,1588377600000"},
+				{Item: constants.HasSymptom, Operator: constants.EQ, Value: "true"},
+			}},
+			expectedResult: bson.D{{"$and", bson.A{
+				bson.D{{constants.CreatedAt, bson.D{{"$gte", int64(1588291200000)}, {"$lte", int64(1588377600000)}}}},
+				bson.D{{constants.HasSymptom, true}},
+			}}},
+		},
+		{
+			name:           "open range, should only set lower bound",
+			query:          &dto.QueryData{Filters: []*dto.FilterData{{Item: constants.CreatedAt, Operator: constants.RANGE, Value: "1588291200000,"}}},
+			expectedResult: bson.D{{constants.CreatedAt, bson.D{{"$gte", int64(1588291200000)}}}},
+		},
+		{
+			name:           "in user ids, should return in",
+			query:          &dto.QueryData{Filters: []*dto.FilterData{{Item: constants.UserId, Operator: constants.IN, Value: "a,b"}}},
+			expectedResult: bson.D{{constants.UserId, bson.D{{"$in", bson.A{"a", "b"}}}}},
+		},
+		{
+			name:           "prefix, should return escaped anchored regex",
+			query:          &dto.QueryData{Filters: []*dto.FilterData{{Item: constants.UserId, Operator: constants.PREFIX, Value: "a.b"}}},
+			expectedResult: bson.D{{constants.UserId, bson.D{{"$regex", `^a\.b`}}}},
+		},
+		{
+			name:           "search, should match searchable fields",
+			query:          &dto.QueryData{Search: "abc"},
+			expectedResult: bson.D{{"$or", bson.A{bson.D{{constants.UserId, bson.D{{"$regex", "abc"}, {"$options", "i"}}}}}}},
+		},
+		{
+			name:          "field not in whitelist, should return error",
+			query:         &dto.QueryData{Filters: []*dto.FilterData{{Item: "results", Operator: constants.EQ, Value: "true"}}},
+			expectedError: constants.InvalidFilterError,
+		},
+		{
+			name:          "invalid value, should return error",
+			query:         &dto.QueryData{Filters: []*dto.FilterData{{Item: constants.CreatedAt, Operator: constants.GT, Value: "yesterday"}}},
+			expectedError: constants.InvalidFilterError,
+		},
+		{
+			name:          "comparison on bool, should return error",
+			query:         &dto.QueryData{Filters: []*dto.FilterData{{Item: constants.HasSymptom, Operator: constants.GT, Value: "true"}}},
+			expectedError: constants.InvalidFilterError,
+		},
+		{
+			name:          "unknown operator, should return error",
+			query:         &dto.QueryData{Filters: []*dto.FilterData{{Item: constants.UserId, Operator: "like", Value: "a"}}},
+			expectedError: constants.InvalidFilterError,
+		},
+	}
+
+	for _, test := range tests {
+		filter, err := reportFields.filter(test.query)
+		assert.Equal(t, test.expectedError, err, test.name)
+		assert.Equal(t, test.expectedResult, filter, test.name)
+	}
+}
+
+// TestQueryFieldsSort ...
+func TestQueryFieldsSort(t *testing.T) {
+	tests := []struct {
+		name           string
+		sorts          []*dto.SortData
+		expectedResult bson.D
+		expectedError  error
+	}{
+		{
+			name:           "multiple fields, should keep order",
+			sorts:          []*dto.SortData{{Item: constants.HasSymptom, Order: constants.DESC}, {Item: constants.CreatedAt, Order: "asc"}},
+			expectedResult: bson.D{{constants.HasSymptom, -1}, {constants.CreatedAt, 1}},
+		},
+		{
+			name:          "field not in whitelist, should return error",
+			sorts:         []*dto.SortData{{Item: "results", Order: constants.ASC}},
+			expectedError: constants.InvalidSortError,
+		},
+		{
+			name:          "invalid order, should return error",
+			sorts:         []*dto.SortData{{Item: constants.CreatedAt, Order: "up"}},
+			expectedError: constants.InvalidSortError,
+		},
+	}
+
+	for _, test := range tests {
+		sort, err := reportFields.sort(&dto.QueryData{Sorts: test.sorts})
+		assert.Equal(t, test.expectedError, err, test.name)
+		assert.Equal(t, test.expectedResult, sort, test.name)
+	}
+}
@@ -2,7 +2,6 @@ package dao
 
 import (
 	"context"
-	"fmt"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
 	"go.mongodb.org/mongo-driver/bson"
@@ -22,159 +21,276 @@ func InitReportDAO(client *mongo.Client) IReportDAO {
 
 // Create creates new report
 func (v *ReportDAO) Create(ctx context.Context, report *dto.Report) (*dto.Report, error) {
+	ctx, span := startSpan(ctx, "ReportDAO.Create")
+	defer span.End()
+
 	// create report
+	report.Version = 1
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Reports)
 	if _, err := collection.InsertOne(ctx, report); err != nil {
-		return nil, err
+		return nil, wrapError(err)
 	}
 	return report, nil
 }
 
-// Update updates zone
+// Update updates report if it is still at its version, returns VersionError otherwise
 func (v *ReportDAO) Update(ctx context.Context, report *dto.Report) (*dto.Report, error) {
+	ctx, span := startSpan(ctx, "ReportDAO.Update")
+	defer span.End()
+
 	// update report
+	// update only if report is still at version read
+	expected := report.Version
+	report.Version++
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Reports)
-	_, err := collection.UpdateOne(ctx, bson.D{{constants.ID, report.ID}}, bson.D{
+	result, err := collection.UpdateOne(ctx, versionFilter(report.ID, expected), bson.D{
 		{"$set", report},
 	})
 	if err != nil {
-		return nil, err
+		report.Version = expected
+		return nil, wrapError(err)
+	}
+	if result.MatchedCount == 0 {
+		report.Version = expected
+		return nil, versionConflict(ctx, collection, report.ID)
+	}
+	return report, nil
+}
+
+// Patch sets only given fields of report if it is still at its version
+func (v *ReportDAO) Patch(ctx context.Context, report *dto.Report, fields []string) (*dto.Report, error) {
+	ctx, span := startSpan(ctx, "ReportDAO.Patch")
+	defer span.End()
+
+	set := bson.D{}
+	for _, field := range fields {
+		switch field {
+		case constants.HasSymptom:
+			set = append(set, bson.E{Key: constants.HasSymptom, Value: report.HasSymptom})
+		}
+	}
+	if len(set) == 0 {
+		return report, nil
+	}
+
+	// update only if report is still at version read
+	set = append(set, bson.E{Key: constants.Version, Value: report.Version + 1})
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Reports)
+	result, err := collection.UpdateOne(ctx, versionFilter(report.ID, report.Version), bson.D{{"$set", set}})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if result.MatchedCount == 0 {
+		return nil, versionConflict(ctx, collection, report.ID)
 	}
+	report.Version++
 	return report, nil
 }
 
+// UpdateMany sets symptom of reports by IDs to that of report, returns number of reports matched
+func (v *ReportDAO) UpdateMany(ctx context.Context, ids []string, report *dto.Report) (int64, error) {
+	ctx, span := startSpan(ctx, "ReportDAO.UpdateMany")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Reports)
+	result, err := collection.UpdateMany(ctx, active(byIDs(ids)), bson.D{
+		{"$set", bson.D{{constants.HasSymptom, report.HasSymptom}}},
+		{"$inc", bson.D{{constants.Version, 1}}},
+	})
+	if err != nil {
+		return 0, wrapError(err)
+	}
+	return result.MatchedCount, nil
+}
+
 // Get gets report by ID
 func (v *ReportDAO) Get(ctx context.Context, id string) (*dto.Report, error) {
+	ctx, span := startSpan(ctx, "ReportDAO.Get")
+	defer span.End()
+
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Reports)
 	report := &dto.Report{}
-	if err := collection.FindOne(ctx, bson.D{{constants.ID, id}}).Decode(&report); err != nil {
-		return nil, err
+	if err := collection.FindOne(ctx, active(bson.D{{constants.ID, id}})).Decode(&report); err != nil {
+		return nil, wrapError(err)
 	}
 	return report, nil
 }
 
-// BatchGet gets reports by slice of IDs
+// BatchGet gets reports by slice of IDs in a single query, reports not found are omitted
 func (v *ReportDAO) BatchGet(ctx context.Context, ids []string) ([]*dto.Report, error) {
+	ctx, span := startSpan(ctx, "ReportDAO.BatchGet")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Reports)
+	cursor, err := collection.Find(ctx, active(byIDs(ids)))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
 	var reports []*dto.Report
-	for _, id := range ids {
-		report, err := v.Get(ctx, id)
-		if err != nil {
-			return nil, err
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		report := &dto.Report{}
+		if err = cursor.Decode(&report); err != nil {
+			return nil, wrapError(err)
 		}
 		reports = append(reports, report)
 	}
+	if err = cursor.Err(); err != nil {
+		return nil, wrapError(err)
+	}
 	return reports, nil
 }
 
-// Query queries reports by sort, range, filter
-func (v *ReportDAO) Query(ctx context.Context, sort *dto.SortData, itemsRange *dto.RangeData, filter *dto.FilterData) (int64, []*dto.Report, error) {
+// GetByUserID gets all reports of user including soft deleted ones, which are held until purged, oldest first
+func (v *ReportDAO) GetByUserID(ctx context.Context, userID string) ([]*dto.Report, error) {
+	ctx, span := startSpan(ctx, "ReportDAO.GetByUserID")
+	defer span.End()
+
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Reports)
+	cursor, err := collection.Find(ctx, bson.D{{constants.UserId, userID}}, options.Find().
+		SetSort(bson.D{{constants.CreatedAt, 1}}))
+	if err != nil {
+		return nil, wrapError(err)
+	}
 
-	var cursor *mongo.Cursor
-	var err error
-	var count int64
 	var reports []*dto.Report
-
-	findOptions := options.Find()
-	// set range
-	if itemsRange != nil {
-		findOptions.SetSkip(int64(itemsRange.From))
-		findOptions.SetLimit(int64(itemsRange.To + 1 - itemsRange.From))
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		report := &dto.Report{}
+		if err = cursor.Decode(&report); err != nil {
+			return nil, wrapError(err)
+		}
+		reports = append(reports, report)
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, wrapError(err)
 	}
+	return reports, nil
+}
 
-	// set sorter
-	if sort != nil {
-		order := 1
-		if sort.Order == constants.DESC {
-			order = -1
-		}
-		findOptions.SetSort(bson.D{{sort.Item, order}})
-	}
-
-	// set filter
-	if filter != nil {
-		// special case: if filter item is primary key we can directly call Get
-		if filter.Item == "id" {
-			d, err := v.Get(ctx, filter.Value)
-			if err != nil {
-				return 0, nil, err
-			}
-			return 1, []*dto.Report{d}, nil
-		}
+// Query queries reports by filters, sorts and range or page, fields are whitelisted by reportFields, returns total,
+// reports and next page token
+func (v *ReportDAO) Query(ctx context.Context, query *dto.QueryData) (int64, []*dto.Report, string, error) {
+	ctx, span := startSpan(ctx, "ReportDAO.Query")
+	defer span.End()
 
-		// else: do query filter
-		if filter.Item == "q" {
-			query := bson.M{
-				constants.UserId: bson.M{
-					"$regex":   fmt.Sprintf("%s.*", filter.Value),
-					"$options": "i",
-				},
-			}
-			cursor, err = collection.Find(ctx, query, findOptions)
-			if err != nil {
-				return 0, nil, err
-			}
-			count, err = collection.CountDocuments(ctx, query)
-			if err != nil {
-				return 0, nil, err
-			}
-		} else {
-			cursor, err = collection.Find(
-				ctx, bson.D{
-					{filter.Item, filter.Value},
-				}, findOptions,
-			)
-			if err != nil {
-				return 0, nil, err
-			}
-			count, err = collection.CountDocuments(ctx, bson.D{
-				{filter.Item, filter.Value},
-			})
-			if err != nil {
-				return 0, nil, err
-			}
-		}
-	} else {
-		cursor, err = collection.Find(ctx, bson.D{{}}, findOptions)
-		if err != nil {
-			return 0, nil, err
-		}
-		count, err = collection.CountDocuments(ctx, bson.D{{}})
-		if err != nil {
-			return 0, nil, err
-		}
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Reports)
+	cursor, count, page, err := find(ctx, collection, query, reportFields)
+	if err != nil {
+		return 0, nil, "", err
 	}
 
+	var reports []*dto.Report
 	defer cursor.Close(ctx)
 	for cursor.Next(ctx) {
+		if !page.add(cursor.Current) {
+			break
+		}
 		report := &dto.Report{}
 		if err = cursor.Decode(&report); err != nil {
-			return 0, nil, err
+			return 0, nil, "", wrapError(err)
 		}
 		reports = append(reports, report)
 	}
 
-	return count, reports, nil
+	if err = cursor.Err(); err != nil {
+		return 0, nil, "", wrapError(err)
+	}
+	next, err := page.nextToken()
+	if err != nil {
+		return 0, nil, "", err
+	}
+
+	return count, reports, next, nil
 }
 
-// Delete deletes report by ID
-func (v *ReportDAO) Delete(ctx context.Context, id string) error {
+// Delete soft deletes report by ID at deletedAt
+func (v *ReportDAO) Delete(ctx context.Context, id string, deletedAt int64) error {
+	ctx, span := startSpan(ctx, "ReportDAO.Delete")
+	defer span.End()
+
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Reports)
-	if _, err := collection.DeleteOne(ctx, bson.D{{constants.ID, id}}); err != nil {
+	count, err := softDelete(ctx, collection, bson.D{{constants.ID, id}}, deletedAt)
+	if err != nil {
 		return err
 	}
+	if count == 0 {
+		return wrapError(mongo.ErrNoDocuments)
+	}
 	return nil
 }
 
-// BatchDelete deletes reports by IDs
-func (v *ReportDAO) BatchDelete(ctx context.Context, ids []string) ([]string, error) {
-	var deletedIDs []string
-	for _, id := range ids {
-		err := v.Delete(ctx, id)
-		if err != nil {
-			return nil, err
-		}
-		deletedIDs = append(deletedIDs, id)
+// BatchDelete soft deletes reports by IDs at deletedAt in a single operation, returns number of reports deleted
+func (v *ReportDAO) BatchDelete(ctx context.Context, ids []string, deletedAt int64) (int64, error) {
+	ctx, span := startSpan(ctx, "ReportDAO.BatchDelete")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Reports)
+	return softDelete(ctx, collection, byIDs(ids), deletedAt)
+}
+
+// DeleteByUserIDs soft deletes reports of users by IDs at deletedAt, returns number of reports deleted
+func (v *ReportDAO) DeleteByUserIDs(ctx context.Context, userIDs []string, deletedAt int64) (int64, error) {
+	ctx, span := startSpan(ctx, "ReportDAO.DeleteByUserIDs")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Reports)
+	return softDelete(ctx, collection, byUserIDs(userIDs), deletedAt)
+}
+
+// Restore restores soft deleted report by ID, returns report as it was deleted
+func (v *ReportDAO) Restore(ctx context.Context, id string) (*dto.Report, error) {
+	ctx, span := startSpan(ctx, "ReportDAO.Restore")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Reports)
+	report := &dto.Report{}
+	if err := restore(ctx, collection, id, report); err != nil {
+		return nil, wrapError(err)
+	}
+	return report, nil
+}
+
+// RestoreByUserID restores reports of user soft deleted at deletedAt, i.e. deleted along with user, returns number of
+// reports restored
+func (v *ReportDAO) RestoreByUserID(ctx context.Context, userID string, deletedAt int64) (int64, error) {
+	ctx, span := startSpan(ctx, "ReportDAO.RestoreByUserID")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Reports)
+	result, err := collection.UpdateMany(ctx, bson.D{{constants.UserId, userID}, {constants.DeletedAt, deletedAt}}, bson.D{
+		{"$set", bson.D{{constants.DeletedAt, 0}}},
+		{"$inc", bson.D{{constants.Version, 1}}},
+	})
+	if err != nil {
+		return 0, wrapError(err)
+	}
+	return result.ModifiedCount, nil
+}
+
+// Purge hard deletes reports soft deleted before given time, returns number of reports purged
+func (v *ReportDAO) Purge(ctx context.Context, before int64) (int64, error) {
+	ctx, span := startSpan(ctx, "ReportDAO.Purge")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Reports)
+	result, err := collection.DeleteMany(ctx, deletedBefore(before))
+	if err != nil {
+		return 0, wrapError(err)
+	}
+	return result.DeletedCount, nil
+}
+
+// PurgeByUserIDs hard deletes all reports of users by IDs, deleted or not, returns number of reports purged
+func (v *ReportDAO) PurgeByUserIDs(ctx context.Context, userIDs []string) (int64, error) {
+	ctx, span := startSpan(ctx, "ReportDAO.PurgeByUserIDs")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Reports)
+	result, err := collection.DeleteMany(ctx, byUserIDs(userIDs))
+	if err != nil {
+		return 0, wrapError(err)
 	}
-	return deletedIDs, nil
+	return result.DeletedCount, nil
 }
@@ -0,0 +1,86 @@
+package dao
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// notDeleted matches documents that are not soft deleted, every query of soft deletable collections includes it.
+// deletedAt is 0 rather than missing so that partial indexes, which cannot match missing fields, can exclude deleted
+// documents
+var notDeleted = bson.E{Key: constants.DeletedAt, Value: 0}
+
+// deleted matches soft deleted documents
+var deleted = bson.E{Key: constants.DeletedAt, Value: bson.D{{"$gt", 0}}}
+
+// active restricts filter to documents that are not soft deleted
+func active(filter bson.D) bson.D {
+	return append(filter[:len(filter):len(filter)], notDeleted)
+}
+
+// deletedBefore matches documents soft deleted before t
+func deletedBefore(t int64) bson.D {
+	return bson.D{{constants.DeletedAt, bson.D{{"$gt", 0}, {"$lt", t}}}}
+}
+
+// byIDs matches documents by IDs
+func byIDs(ids []string) bson.D {
+	return bson.D{{constants.ID, bson.D{{"$in", ids}}}}
+}
+
+// byUserIDs matches documents of users by IDs
+func byUserIDs(userIDs []string) bson.D {
+	return bson.D{{constants.UserId, bson.D{{"$in", userIDs}}}}
+}
+
+// softDelete sets deletedAt of documents matching filter that are not deleted yet, returns number of documents deleted
+func softDelete(ctx context.Context, collection *mongo.Collection, filter bson.D, deletedAt int64) (int64, error) {
+	result, err := collection.UpdateMany(ctx, active(filter), bson.D{
+		{"$set", bson.D{{constants.DeletedAt, deletedAt}}},
+		{"$inc", bson.D{{constants.Version, 1}}},
+	})
+	if err != nil {
+		return 0, wrapError(err)
+	}
+	return result.ModifiedCount, nil
+}
+
+// restore resets deletedAt of soft deleted document by ID and decodes document as it was deleted into doc, returns
+// driver error so that caller can map violation of unique index the document is back in
+func restore(ctx context.Context, collection *mongo.Collection, id string, doc interface{}) error {
+	return collection.FindOneAndUpdate(ctx, bson.D{{constants.ID, id}, deleted}, bson.D{
+		{"$set", bson.D{{constants.DeletedAt, 0}}},
+		{"$inc", bson.D{{constants.Version, 1}}},
+	}).Decode(doc)
+}
+
+// deletedIDs gets IDs of at most limit documents soft deleted before given time, oldest first
+func deletedIDs(ctx context.Context, collection *mongo.Collection, before int64, limit int64) ([]string, error) {
+	cursor, err := collection.Find(ctx, deletedBefore(before), options.Find().
+		SetProjection(bson.D{{constants.ID, 1}}).
+		SetSort(bson.D{{constants.DeletedAt, 1}}).
+		SetLimit(limit))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	var ids []string
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		doc := &struct {
+			ID string `bson:"id"`
+		}{}
+		if err = cursor.Decode(doc); err != nil {
+			return nil, wrapError(err)
+		}
+		ids = append(ids, doc.ID)
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, wrapError(err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"galasejahtera/pkg/constants"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// TestSoftDeleteFilters ...
+func TestSoftDeleteFilters(t *testing.T) {
+	tests := []struct {
+		name           string
+		filter         bson.D
+		expectedResult bson.D
+	}{
+		{
+			name:           "active by ID, should exclude deleted",
+			filter:         active(bson.D{{constants.ID, "a"}}),
+			expectedResult: bson.D{{constants.ID, "a"}, {constants.DeletedAt, 0}},
+		},
+		{
+			name:           "active by IDs, should match IDs and exclude deleted",
+			filter:         active(byIDs([]string{"a", "b"})),
+			expectedResult: bson.D{{constants.ID, bson.D{{"$in", []string{"a", "b"}}}}, {constants.DeletedAt, 0}},
+		},
+		{
+			name:           "deleted by IDs, should only match deleted",
+			filter:         append(byIDs([]string{"a"}), deleted),
+			expectedResult: bson.D{{constants.ID, bson.D{{"$in", []string{"a"}}}}, {constants.DeletedAt, bson.D{{"$gt", 0}}}},
+		},
+		{
+			name:           "deleted before, should match deleted earlier only, not active",
+			filter:         deletedBefore(1588291200000),
+			expectedResult: bson.D{{constants.DeletedAt, bson.D{{"$gt", 0}, {"$lt", int64(1588291200000)}}}},
+		},
+		{
+			name:           "by user IDs, should match deleted or not",
+			filter:         byUserIDs([]string{"a", "b"}),
+			expectedResult: bson.D{{constants.UserId, bson.D{{"$in", []string{"a", "b"}}}}},
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expectedResult, test.filter, test.name)
+	}
+}
+
+// TestActiveDoesNotAlias ...
+func TestActiveDoesNotAlias(t *testing.T) {
+	filter := make(bson.D, 1, 2)
+	filter[0] = bson.E{Key: constants.ID, Value: "a"}
+	a := active(filter)
+	b := append(filter, deleted)
+	assert.Equal(t, notDeleted, a[1], "active filter should not be overwritten by append to its base")
+	assert.Equal(t, deleted, b[1])
+}
@@ -0,0 +1,13 @@
+package dao
+
+import (
+	"context"
+	"galasejahtera/pkg/tracing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startSpan starts span of DAO method named e.g. "UserDAO.Get", MongoDB commands of the method are its children
+func startSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, method, trace.WithSpanKind(trace.SpanKindInternal))
+}
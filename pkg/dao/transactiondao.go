@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ErrNoTransactions is returned by VerifyTransactions when MongoDB is a standalone server
+var ErrNoTransactions = errors.New("transactions require MongoDB to run as a replica set or sharded cluster")
+
+// TransactionDAO ...
+type TransactionDAO struct {
+	client *mongo.Client
+}
+
+// InitTransactionDAO ...
+func InitTransactionDAO(client *mongo.Client) ITransactionDAO {
+	return &TransactionDAO{client: client}
+}
+
+// WithTransaction runs fn in a transaction, operations of DAOs using the context passed to fn are committed
+// if fn returns nil and aborted otherwise. fn may be retried on transient errors. Requires a replica set.
+func (v *TransactionDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, span := startSpan(ctx, "TransactionDAO.WithTransaction")
+	defer span.End()
+
+	session, err := v.client.StartSession()
+	if err != nil {
+		return wrapError(err)
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessCtx)
+	})
+	return wrapError(err)
+}
+
+// VerifyTransactions checks that deployment of client supports transactions, i.e. it is a replica set or a sharded
+// cluster, returns ErrNoTransactions otherwise
+func VerifyTransactions(ctx context.Context, client *mongo.Client) error {
+	reply := bson.M{}
+	err := client.Database("admin").RunCommand(ctx, bson.D{{"isMaster", 1}}).Decode(&reply)
+	if err != nil {
+		return wrapError(err)
+	}
+	if !supportsTransactions(reply) {
+		return ErrNoTransactions
+	}
+	return nil
+}
+
+// supportsTransactions reports whether isMaster reply is of replica set member or mongos
+func supportsTransactions(reply bson.M) bool {
+	if name, ok := reply["setName"].(string); ok && name != "" {
+		return true
+	}
+	return reply["msg"] == "isdbgrid"
+}
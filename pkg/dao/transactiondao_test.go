@@ -0,0 +1,25 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// TestSupportsTransactions ...
+func TestSupportsTransactions(t *testing.T) {
+	tests := []struct {
+		name           string
+		reply          bson.M
+		expectedResult bool
+	}{
+		{name: "standalone, should not support transactions", reply: bson.M{"ismaster": true}, expectedResult: false},
+		{name: "replica set member, should support transactions", reply: bson.M{"ismaster": true, "setName": "rs0"}, expectedResult: true},
+		{name: "mongos, should support transactions", reply: bson.M{"ismaster": true, "msg": "isdbgrid"}, expectedResult: true},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expectedResult, supportsTransactions(test.reply), test.name)
+	}
+}
@@ -2,13 +2,11 @@ package dao
 
 import (
 	"context"
-	"fmt"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
 	"galasejahtera/pkg/utility"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // UserDAO ...
@@ -23,12 +21,14 @@ func InitUserDAO(client *mongo.Client) IUserDAO {
 
 // Create creates new user
 func (v *UserDAO) Create(ctx context.Context, user *dto.User) (*dto.User, error) {
+	ctx, span := startSpan(ctx, "UserDAO.Create")
+	defer span.End()
 
 	// hash password
 	var err error
 	user.Password, err = utility.HashPassword(user.Password)
 	if err != nil {
-		return nil, err
+		return nil, wrapError(err)
 	}
 
 	// create location
@@ -37,21 +37,40 @@ func (v *UserDAO) Create(ctx context.Context, user *dto.User) (*dto.User, error)
 		Coordinates: []float64{user.Long, user.Lat},
 	}
 	user.Location = location
+	user.NormalizedEmail = utility.NormalizeEmail(user.Email)
+	user.Version = 1
 
-	// create user
+	// create user, uniqueness of id and email is enforced by unique indexes
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Users)
 	if _, err := collection.InsertOne(ctx, user); err != nil {
-		return nil, err
+		return nil, userWriteError(err)
 	}
 	return user, nil
 }
 
+// normalizedEmailIndex is name of unique index of normalized email
+const normalizedEmailIndex = constants.NormalizedEmail + "_1"
+
+// userWriteError maps unique index violation to its error
+func userWriteError(err error) error {
+	if isDuplicateKeyOn(err, normalizedEmailIndex) {
+		return constants.EmailAlreadyExistError
+	}
+	if isDuplicateKeyError(err) {
+		return constants.UserAlreadyExistError
+	}
+	return wrapError(err)
+}
+
 // Get gets user by ID
 func (v *UserDAO) Get(ctx context.Context, id string) (*dto.User, error) {
+	ctx, span := startSpan(ctx, "UserDAO.Get")
+	defer span.End()
+
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Users)
 	user := &dto.User{}
-	if err := collection.FindOne(ctx, bson.D{{constants.ID, id}}).Decode(&user); err != nil {
-		return nil, err
+	if err := collection.FindOne(ctx, active(bson.D{{constants.ID, id}})).Decode(&user); err != nil {
+		return nil, wrapError(err)
 	}
 
 	if user.Location != nil && len(user.Location.Coordinates) == 2 {
@@ -62,127 +81,77 @@ func (v *UserDAO) Get(ctx context.Context, id string) (*dto.User, error) {
 	return user, nil
 }
 
-// BatchGet gets users by slice of IDs
-func (v *UserDAO) BatchGet(ctx context.Context, ids []string) ([]*dto.User, error) {
-	var users []*dto.User
-	for _, id := range ids {
-		user, err := v.Get(ctx, id)
-		if err != nil {
-			return nil, err
-		}
-		users = append(users, user)
-	}
-	return users, nil
-}
+// GetByEmail gets user by case-insensitive email
+func (v *UserDAO) GetByEmail(ctx context.Context, email string) (*dto.User, error) {
+	ctx, span := startSpan(ctx, "UserDAO.GetByEmail")
+	defer span.End()
 
-// Query queries users by sort, range, filter
-func (v *UserDAO) Query(ctx context.Context, sort *dto.SortData, itemsRange *dto.RangeData, filter *dto.FilterData) (int64, []*dto.User, error) {
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Users)
+	user := &dto.User{}
+	if err := collection.FindOne(ctx, bson.D{{constants.NormalizedEmail, utility.NormalizeEmail(email)}, notDeleted}).Decode(&user); err != nil {
+		return nil, wrapError(err)
+	}
 
-	var cursor *mongo.Cursor
-	var err error
-	var count int64
-	var users []*dto.User
+	if user.Location != nil && len(user.Location.Coordinates) == 2 {
+		user.Long = user.Location.Coordinates[0]
+		user.Lat = user.Location.Coordinates[1]
+	}
+
+	return user, nil
+}
 
-	findOptions := options.Find()
-	// set range
-	if itemsRange != nil {
-		findOptions.SetSkip(int64(itemsRange.From))
-		findOptions.SetLimit(int64(itemsRange.To + 1 - itemsRange.From))
+// BatchGet gets users by slice of IDs in a single query, users not found are omitted
+func (v *UserDAO) BatchGet(ctx context.Context, ids []string) ([]*dto.User, error) {
+	ctx, span := startSpan(ctx, "UserDAO.BatchGet")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Users)
+	cursor, err := collection.Find(ctx, active(byIDs(ids)))
+	if err != nil {
+		return nil, wrapError(err)
 	}
 
-	// set sorter
-	if sort != nil {
-		order := 1
-		if sort.Order == constants.DESC {
-			order = -1
+	var users []*dto.User
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		user := &dto.User{}
+		if err = cursor.Decode(&user); err != nil {
+			return nil, wrapError(err)
 		}
-		findOptions.SetSort(bson.D{{sort.Item, order}})
-	}
-
-	// set filter
-	if filter != nil {
-		// special case: if filter item is primary key we can directly call Get
-		if filter.Item == "id" {
-			d, err := v.Get(ctx, filter.Value)
-			if err != nil {
-				return 0, nil, err
-			}
-			return 1, []*dto.User{d}, nil
+		if user.Location != nil && len(user.Location.Coordinates) == 2 {
+			user.Long = user.Location.Coordinates[0]
+			user.Lat = user.Location.Coordinates[1]
 		}
+		users = append(users, user)
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, wrapError(err)
+	}
+	return users, nil
+}
 
-		// else: do query filter
-		if filter.Item == "q" {
-			query := bson.M{
-				"$or": bson.A{
-					bson.M{
-						constants.Name: bson.M{
-							"$regex":   fmt.Sprintf("%s.*", filter.Value),
-							"$options": "i",
-						},
-					},
-					bson.M{
-						constants.Email: bson.M{
-							"$regex":   fmt.Sprintf("%s.*", filter.Value),
-							"$options": "i",
-						},
-					},
-					bson.M{
-						constants.PhoneNumber: bson.M{
-							"$regex":   fmt.Sprintf("%s.*", filter.Value),
-							"$options": "i",
-						},
-					},
-					bson.M{
-						constants.IC: bson.M{
-							"$regex":   fmt.Sprintf("%s.*", filter.Value),
-							"$options": "i",
-						},
-					},
-				},
-			}
-			cursor, err = collection.Find(ctx, query, findOptions)
-			if err != nil {
-				return 0, nil, err
-			}
-			count, err = collection.CountDocuments(ctx, query)
-			if err != nil {
-				return 0, nil, err
-			}
-		} else {
-			cursor, err = collection.Find(
-				ctx, bson.D{
-					{filter.Item, filter.Value},
-				}, findOptions,
-			)
-			if err != nil {
-				return 0, nil, err
-			}
-			count, err = collection.CountDocuments(ctx, bson.D{
-				{filter.Item, filter.Value},
-			})
-			if err != nil {
-				return 0, nil, err
-			}
-		}
-	} else {
-		cursor, err = collection.Find(ctx, bson.D{{}}, findOptions)
-		if err != nil {
-			return 0, nil, err
-		}
-		count, err = collection.CountDocuments(ctx, bson.D{{}})
-		if err != nil {
-			return 0, nil, err
-		}
+// Query queries users by filters, sorts and range or page, fields are whitelisted by userFields, returns total,
+// users and next page token
+func (v *UserDAO) Query(ctx context.Context, query *dto.QueryData) (int64, []*dto.User, string, error) {
+	ctx, span := startSpan(ctx, "UserDAO.Query")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Users)
+	cursor, count, page, err := find(ctx, collection, query, userFields)
+	if err != nil {
+		return 0, nil, "", err
 	}
 
+	var users []*dto.User
 	defer cursor.Close(ctx)
 	for cursor.Next(ctx) {
+		if !page.add(cursor.Current) {
+			break
+		}
 		user := &dto.User{}
 		if err = cursor.Decode(&user); err != nil {
-			return 0, nil, err
+			return 0, nil, "", wrapError(err)
 		}
-
 		if user.Location != nil && len(user.Location.Coordinates) == 2 {
 			user.Long = user.Location.Coordinates[0]
 			user.Lat = user.Location.Coordinates[1]
@@ -191,111 +160,269 @@ func (v *UserDAO) Query(ctx context.Context, sort *dto.SortData, itemsRange *dto
 		users = append(users, user)
 	}
 
-	return count, users, nil
+	if err = cursor.Err(); err != nil {
+		return 0, nil, "", wrapError(err)
+	}
+	next, err := page.nextToken()
+	if err != nil {
+		return 0, nil, "", err
+	}
+
+	return count, users, next, nil
 }
 
-// Delete deletes user by ID
-func (v *UserDAO) Delete(ctx context.Context, id string) error {
+// Delete soft deletes user by ID at deletedAt
+func (v *UserDAO) Delete(ctx context.Context, id string, deletedAt int64) error {
+	ctx, span := startSpan(ctx, "UserDAO.Delete")
+	defer span.End()
+
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Users)
-	if _, err := collection.DeleteOne(ctx, bson.D{{constants.ID, id}}); err != nil {
+	count, err := softDelete(ctx, collection, bson.D{{constants.ID, id}}, deletedAt)
+	if err != nil {
 		return err
 	}
+	if count == 0 {
+		return wrapError(mongo.ErrNoDocuments)
+	}
 	return nil
 }
 
-// BatchDelete deletes users by IDs
-func (v *UserDAO) BatchDelete(ctx context.Context, ids []string) ([]string, error) {
-	var deletedIDs []string
-	for _, id := range ids {
-		err := v.Delete(ctx, id)
-		if err != nil {
-			return nil, err
+// BatchDelete soft deletes users by IDs at deletedAt in a single operation, returns number of users deleted
+func (v *UserDAO) BatchDelete(ctx context.Context, ids []string, deletedAt int64) (int64, error) {
+	ctx, span := startSpan(ctx, "UserDAO.BatchDelete")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Users)
+	return softDelete(ctx, collection, byIDs(ids), deletedAt)
+}
+
+// Restore restores soft deleted user by ID, returns user as it was deleted. Restore is rejected with
+// constants.EmailAlreadyExistError if email has been taken by another user since
+func (v *UserDAO) Restore(ctx context.Context, id string) (*dto.User, error) {
+	ctx, span := startSpan(ctx, "UserDAO.Restore")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Users)
+	user := &dto.User{}
+	if err := restore(ctx, collection, id, user); err != nil {
+		return nil, userWriteError(err)
+	}
+	if user.Location != nil && len(user.Location.Coordinates) == 2 {
+		user.Long = user.Location.Coordinates[0]
+		user.Lat = user.Location.Coordinates[1]
+	}
+	return user, nil
+}
+
+// GetDeleted gets IDs of at most limit users soft deleted before deletedBefore
+func (v *UserDAO) GetDeleted(ctx context.Context, deletedBefore int64, limit int64) ([]string, error) {
+	ctx, span := startSpan(ctx, "UserDAO.GetDeleted")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Users)
+	return deletedIDs(ctx, collection, deletedBefore, limit)
+}
+
+// Purge hard deletes soft deleted users by IDs, returns number of users purged
+func (v *UserDAO) Purge(ctx context.Context, ids []string) (int64, error) {
+	ctx, span := startSpan(ctx, "UserDAO.Purge")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Users)
+	result, err := collection.DeleteMany(ctx, append(byIDs(ids), deleted))
+	if err != nil {
+		return 0, wrapError(err)
+	}
+	return result.DeletedCount, nil
+}
+
+// Patch sets only given fields of user if it is still at its version, lat and long set location
+func (v *UserDAO) Patch(ctx context.Context, user *dto.User, fields []string) (*dto.User, error) {
+	ctx, span := startSpan(ctx, "UserDAO.Patch")
+	defer span.End()
+
+	set := userSet(user, fields)
+	if len(set) == 0 {
+		return user, nil
+	}
+
+	// update only if user is still at version read
+	set = append(set, bson.E{Key: constants.Version, Value: user.Version + 1})
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Users)
+	result, err := collection.UpdateOne(ctx, versionFilter(user.ID, user.Version), bson.D{{"$set", set}})
+	if err != nil {
+		return nil, userWriteError(err)
+	}
+	if result.MatchedCount == 0 {
+		return nil, versionConflict(ctx, collection, user.ID)
+	}
+	user.Version++
+	return user, nil
+}
+
+// userSet builds $set of fields of user, lat and long are set together as location
+func userSet(user *dto.User, fields []string) bson.D {
+	set := bson.D{}
+	location := false
+	for _, field := range fields {
+		switch field {
+		case constants.Role:
+			set = append(set, bson.E{Key: constants.Role, Value: user.Role})
+		case constants.Email:
+			set = append(set, bson.E{Key: constants.Email, Value: user.Email},
+				bson.E{Key: constants.NormalizedEmail, Value: utility.NormalizeEmail(user.Email)})
+		case constants.IsActive:
+			set = append(set, bson.E{Key: constants.IsActive, Value: user.IsActive})
+		case constants.Name:
+			set = append(set, bson.E{Key: constants.Name, Value: user.Name})
+		case constants.Password:
+			set = append(set, bson.E{Key: constants.Password, Value: user.Password})
+		case constants.UserUpdated:
+			set = append(set, bson.E{Key: constants.UserUpdated, Value: user.LastUpdated})
+		case constants.Lat, constants.Long:
+			location = true
+		}
+	}
+	if location {
+		user.Location = &dto.Location{
+			Type:        "Point",
+			Coordinates: []float64{user.Long, user.Lat},
 		}
-		deletedIDs = append(deletedIDs, id)
+		set = append(set, bson.E{Key: constants.Location, Value: user.Location})
 	}
-	return deletedIDs, nil
+	return set
 }
 
-// Update updates user
-func (v *UserDAO) Update(ctx context.Context, user *dto.User) (*dto.User, error) {
+// UpdateMany sets fields of users by IDs to those of user, returns number of users matched
+func (v *UserDAO) UpdateMany(ctx context.Context, ids []string, user *dto.User, fields []string) (int64, error) {
+	ctx, span := startSpan(ctx, "UserDAO.UpdateMany")
+	defer span.End()
 
-	// create location
-	location := &dto.Location{
-		Type:        "Point",
-		Coordinates: []float64{user.Long, user.Lat},
+	set := userSet(user, fields)
+	if len(set) == 0 {
+		return 0, nil
 	}
-	user.Location = location
 
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Users)
-	_, err := collection.UpdateOne(ctx, bson.D{{constants.ID, user.ID}}, bson.D{
-		{"$set", user},
+	result, err := collection.UpdateMany(ctx, active(byIDs(ids)), bson.D{
+		{"$set", set},
+		{"$inc", bson.D{{constants.Version, 1}}},
 	})
 	if err != nil {
-		return nil, err
+		return 0, userWriteError(err)
 	}
-	return user, nil
+	return result.MatchedCount, nil
 }
 
-// GetNearbyUsers gets users within 50 meter
-func (v *UserDAO) GetNearbyUsers(ctx context.Context, user *dto.User) (int64, []*dto.User, error) {
-	if user.Location == nil || len(user.Location.Coordinates) != 2 {
-		return 0, nil, constants.InvalidArgumentError
+// DisableInactive sets active users last updated before given time to inactive, returns number of users updated
+func (v *UserDAO) DisableInactive(ctx context.Context, lastUpdated int64) (int64, error) {
+	ctx, span := startSpan(ctx, "UserDAO.DisableInactive")
+	defer span.End()
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Users)
+	result, err := collection.UpdateMany(ctx, bson.D{
+		{constants.Role, constants.User},
+		{constants.IsActive, true},
+		{constants.UserUpdated, bson.D{{"$lt", lastUpdated}}},
+		notDeleted,
+	}, bson.D{
+		{"$set", bson.D{{constants.IsActive, false}}},
+		{"$inc", bson.D{{constants.Version, 1}}},
+	})
+	if err != nil {
+		return 0, wrapError(err)
 	}
+	return result.ModifiedCount, nil
+}
+
+// CountActive counts active users
+func (v *UserDAO) CountActive(ctx context.Context) (int64, error) {
+	ctx, span := startSpan(ctx, "UserDAO.CountActive")
+	defer span.End()
 
 	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Users)
+	count, err := collection.CountDocuments(ctx, bson.D{
+		{constants.Role, constants.User},
+		{constants.IsActive, true},
+		notDeleted,
+	})
+	if err != nil {
+		return 0, wrapError(err)
+	}
+	return count, nil
+}
 
-	query := bson.D{{
-		"$and",
-		bson.A{
-			bson.D{{
-				constants.IsActive,
-				bson.D{{
-					"$eq",
-					true,
-				}},
+// nearbySymptomaticPipeline finds active users other than user within radius in meter, updated since lastUpdated, whose
+// latest report has symptom, ordered by distance
+func nearbySymptomaticPipeline(user *dto.User, radius float64, lastUpdated int64) mongo.Pipeline {
+	return mongo.Pipeline{
+		// $geoNear sorts by distance and requires 2dsphere index on location
+		{{"$geoNear", bson.D{
+			{"near", bson.D{
+				{constants.Type, "Point"},
+				{constants.Coordinates, bson.A{user.Location.Coordinates[0], user.Location.Coordinates[1]}},
 			}},
-			bson.D{{
-				constants.Role,
-				bson.D{{
-					"$eq",
-					constants.User,
-				}},
+			{"key", constants.Location},
+			{"distanceField", constants.Distance},
+			{"maxDistance", radius},
+			{"spherical", true},
+			{"query", bson.D{
+				{constants.IsActive, true},
+				{constants.Role, constants.User},
+				{constants.ID, bson.D{{"$ne", user.ID}}},
+				{constants.UserUpdated, bson.D{{"$gte", lastUpdated}}},
+				notDeleted,
 			}},
-			bson.D{{
-				constants.ID,
-				bson.D{{
-					"$ne",
-					user.ID,
-				}},
+		}}},
+		// join latest report of each user
+		{{"$lookup", bson.D{
+			{"from", constants.Reports},
+			{"let", bson.D{{"userId", "$" + constants.ID}}},
+			{"pipeline", mongo.Pipeline{
+				{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$" + constants.UserId, "$$userId"}}}}, notDeleted}}},
+				{{"$sort", bson.D{{constants.CreatedAt, -1}}}},
+				{{"$limit", 1}},
+				{{"$project", bson.D{{constants.HasSymptom, 1}}}},
 			}},
-			bson.M{
-				// radius set to 100 meter only
-				constants.Location: bson.M{
-					"$geoWithin": bson.M{
-						"$centerSphere": bson.A{
-							bson.A{
-								user.Location.Coordinates[0], user.Location.Coordinates[1],
-							}, 0.1 / 6378.1,
-						},
-					},
-				},
-			},
-		},
-	}}
-
-	cursor, err := collection.Find(ctx, query)
+			{"as", constants.LatestReport},
+		}}},
+		{{"$match", bson.D{{constants.LatestReport + "." + constants.HasSymptom, true}}}},
+		{{"$project", bson.D{{constants.LatestReport, 0}}}},
+	}
+}
+
+// GetNearbySymptomaticUsers gets users within radius in meter and updated since lastUpdated whose latest report has symptom,
+// ordered by distance, in a single aggregation
+func (v *UserDAO) GetNearbySymptomaticUsers(ctx context.Context, user *dto.User, radius float64, lastUpdated int64) (int64, []*dto.User, error) {
+	ctx, span := startSpan(ctx, "UserDAO.GetNearbySymptomaticUsers")
+	defer span.End()
+
+	if user.Location == nil || len(user.Location.Coordinates) != 2 {
+		return 0, nil, constants.InvalidArgumentError
+	}
+
+	collection := v.client.Database(constants.GalaSejahtera).Collection(constants.Users)
+
+	pipeline := nearbySymptomaticPipeline(user, radius, lastUpdated)
+	cursor, err := collection.Aggregate(ctx, pipeline)
 	if err != nil {
-		return 0, nil, err
+		return 0, nil, wrapError(err)
 	}
 
 	var users []*dto.User
 	defer cursor.Close(ctx)
 	for cursor.Next(ctx) {
-		u := &dto.User{}
-		if err = cursor.Decode(&u); err != nil {
-			return 0, nil, err
+		// distance is not a user field, decode it alongside
+		result := &struct {
+			User     dto.User `bson:",inline"`
+			Distance float64  `bson:"distance"`
+		}{}
+		if err = cursor.Decode(result); err != nil {
+			return 0, nil, wrapError(err)
 		}
 
+		u := &result.User
+		u.Distance = result.Distance
 		if u.Location != nil && len(u.Location.Coordinates) == 2 {
 			u.Long = u.Location.Coordinates[0]
 			u.Lat = u.Location.Coordinates[1]
@@ -304,10 +431,20 @@ func (v *UserDAO) GetNearbyUsers(ctx context.Context, user *dto.User) (int64, []
 		users = append(users, u)
 	}
 
-	// update current user's lat, long and active
-	_, err = v.Update(ctx, user)
+	// update current user's lat, long and active only, other fields may have been changed since user is read
+	_, err = collection.UpdateOne(ctx, bson.D{{constants.ID, user.ID}, notDeleted}, bson.D{
+		{"$set", bson.D{
+			{constants.IsActive, user.IsActive},
+			{constants.UserUpdated, user.LastUpdated},
+			{constants.Location, &dto.Location{
+				Type:        "Point",
+				Coordinates: []float64{user.Long, user.Lat},
+			}},
+		}},
+		{"$inc", bson.D{{constants.Version, 1}}},
+	})
 	if err != nil {
-		return 0, nil, err
+		return 0, nil, wrapError(err)
 	}
 
 	return int64(len(users)), users, nil
@@ -0,0 +1,52 @@
+package dao
+
+import (
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// TestNearbySymptomaticPipeline ...
+func TestNearbySymptomaticPipeline(t *testing.T) {
+	user := &dto.User{ID: "caller", Location: &dto.Location{Type: "Point", Coordinates: []float64{101.6869, 3.139}}}
+	pipeline := nearbySymptomaticPipeline(user, 100, 1588291200000)
+
+	var stages []string
+	for _, stage := range pipeline {
+		stages = append(stages, stage[0].Key)
+	}
+	assert.Equal(t, []string{"$geoNear", "$lookup", "$match", "$project"}, stages, "latest reports should be joined in one aggregation")
+
+	assert.Equal(t, bson.D{
+		{"near", bson.D{{constants.Type, "Point"}, {constants.Coordinates, bson.A{101.6869, 3.139}}}},
+		{"key", constants.Location},
+		{"distanceField", constants.Distance},
+		{"maxDistance", 100.0},
+		{"spherical", true},
+		{"query", bson.D{
+			{constants.IsActive, true},
+			{constants.Role, constants.User},
+			{constants.ID, bson.D{{"$ne", "caller"}}},
+			{constants.UserUpdated, bson.D{{"$gte", int64(1588291200000)}}},
+			notDeleted,
+		}},
+	}, pipeline[0][0].Value, "active users other than caller within radius and updated recently should be ordered by distance")
+	assert.Equal(t, bson.D{
+		{"from", constants.Reports},
+		{"let", bson.D{{"userId", "$" + constants.ID}}},
+		{"pipeline", mongo.Pipeline{
+			{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$" + constants.UserId, "$$userId"}}}}, notDeleted}}},
+			{{"$sort", bson.D{{constants.CreatedAt, -1}}}},
+			{{"$limit", 1}},
+			{{"$project", bson.D{{constants.HasSymptom, 1}}}},
+		}},
+		{"as", constants.LatestReport},
+	}, pipeline[1][0].Value, "only latest report not deleted should be joined")
+	assert.Equal(t, bson.D{{constants.LatestReport + "." + constants.HasSymptom, true}}, pipeline[2][0].Value,
+		"users whose latest report has symptom should be kept")
+	assert.Equal(t, bson.D{{constants.LatestReport, 0}}, pipeline[3][0].Value, "joined report should not be returned")
+}
@@ -0,0 +1,29 @@
+package dao
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// versionFilter matches document by ID at expected version, unless it is soft deleted
+func versionFilter(id string, version int64) bson.D {
+	return bson.D{{constants.ID, id}, {constants.Version, version}, notDeleted}
+}
+
+// versionConflict explains why conditional update of document by ID matched nothing, returns ErrNotFound if document
+// does not exist or is soft deleted, otherwise VersionError with its current version
+func versionConflict(ctx context.Context, collection *mongo.Collection, id string) error {
+	current := &struct {
+		Version int64 `bson:"version"`
+	}{}
+	err := collection.FindOne(ctx, bson.D{{constants.ID, id}, notDeleted},
+		options.FindOne().SetProjection(bson.D{{constants.Version, 1}})).Decode(current)
+	if err != nil {
+		return wrapError(err)
+	}
+	return &VersionError{Current: current.Version}
+}
@@ -0,0 +1,17 @@
+package dto
+
+// Audit is append-only record of administrative or security-relevant action
+type Audit struct {
+	ID string `json:"id" bson:"id"`
+	// Actor is ID of user taking action, empty if authentication is disabled
+	Actor  string `json:"actor" bson:"actor"`
+	Action string `json:"action" bson:"action"`
+	// Target is ID of user or report acted on
+	Target string `json:"target" bson:"target"`
+	// Before and After are values of fields changed by action, before and after it
+	Before map[string]interface{} `json:"before" bson:"before,omitempty"`
+	After  map[string]interface{} `json:"after" bson:"after,omitempty"`
+	// RequestID is ID of request taking action
+	RequestID string `json:"requestId" bson:"requestId"`
+	CreatedAt int64  `json:"createdAt" bson:"createdAt"`
+}
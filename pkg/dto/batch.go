@@ -0,0 +1,8 @@
+package dto
+
+// BatchResult is result of batch operation on one ID
+type BatchResult struct {
+	ID string
+	// Error is nil if operation succeeded on ID
+	Error error
+}
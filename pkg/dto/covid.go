@@ -19,14 +19,28 @@ type Container struct {
 
 // District ...
 type District struct {
-	Name  string `json:"name" bson:"name"`
-	Total int64  `json:"total" bson:"total"`
+	Name   string `json:"name" bson:"name"`
+	State  string `json:"-" bson:"-"`
+	Total  int64  `json:"total" bson:"total"`
+	Active int64  `json:"-" bson:"active"`
+	Trend  string `json:"-" bson:"trend"`
+	Risk   string `json:"-" bson:"risk"`
+}
+
+// PlaceMatch ...
+type PlaceMatch struct {
+	District *District
+	IsState  bool
+	Distance int
 }
 
 // State ...
 type State struct {
 	Name      string      `json:"name" bson:"name"`
 	Total     int64       `json:"total" bson:"total"`
+	Active    int64       `json:"-" bson:"active"`
+	Trend     string      `json:"-" bson:"trend"`
+	Risk      string      `json:"-" bson:"risk"`
 	Districts []*District `json:"districts" bson:"districts"`
 }
 
@@ -36,6 +50,14 @@ type Daily struct {
 	LastUpdated int64    `json:"last_updated" bson:"last_updated"`
 }
 
+// DistrictHistory ...
+type DistrictHistory struct {
+	LastUpdated int64   `json:"last_updated" bson:"last_updated"`
+	Total       int64   `json:"total" bson:"total"`
+	Delta       int64   `json:"delta" bson:"delta"`
+	Average     float64 `json:"average" bson:"average"`
+}
+
 // General ...
 type General struct {
 	TotalConfirmed int64 `json:"totalConfirmed" bson:"totalConfirmed"`
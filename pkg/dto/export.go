@@ -0,0 +1,12 @@
+package dto
+
+// UserData is all personal data held of user, as exported to the user
+type UserData struct {
+	ExportedAt int64
+	// User is user profile without password
+	User    *User
+	Reports []*Report
+	// Sessions are access, refresh and password reset tokens of user
+	Sessions  []*AuthObject
+	Locations []*LocationHistory
+}
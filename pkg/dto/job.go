@@ -0,0 +1,24 @@
+package dto
+
+// Job ...
+type Job struct {
+	Name         string `json:"name" bson:"name"`
+	Owner        string `json:"owner" bson:"owner"`
+	LeaseUntil   int64  `json:"leaseUntil" bson:"leaseUntil"`
+	LastRun      int64  `json:"lastRun" bson:"lastRun"`
+	LastDuration int64  `json:"lastDuration" bson:"lastDuration"`
+	LastError    string `json:"lastError" bson:"lastError"`
+	RunCount     int64  `json:"runCount" bson:"runCount"`
+	Spec         string `json:"spec" bson:"-"`
+	Timeout      int64  `json:"timeout" bson:"-"`
+	NextRun      int64  `json:"nextRun" bson:"-"`
+	Running      bool   `json:"running" bson:"-"`
+}
+
+// Migration ...
+type Migration struct {
+	Version   int64  `json:"version" bson:"version"`
+	Name      string `json:"name" bson:"name"`
+	AppliedAt int64  `json:"appliedAt" bson:"appliedAt"`
+	Duration  int64  `json:"duration" bson:"duration"`
+}
@@ -1,7 +1,67 @@
 package dto
 
+import "time"
+
 // Location ...
 type Location struct {
 	Type        string    `json:"-" bson:"type"`
 	Coordinates []float64 `json:"-" bson:"coordinates"`
 }
+
+// NearbyQuery ...
+type NearbyQuery struct {
+	// Radius is search radius in meter
+	Radius float64
+	// MaxAge is maximum age of nearby users location in millisecond
+	MaxAge int64
+}
+
+// NearbyCell ...
+type NearbyCell struct {
+	Geohash   string  `json:"geohash" bson:"geohash"`
+	Lat       float64 `json:"lat" bson:"lat"`
+	Long      float64 `json:"long" bson:"long"`
+	Count     int64   `json:"count" bson:"count"`
+	Direction string  `json:"direction" bson:"direction"`
+	Distance  float64 `json:"distance" bson:"distance"`
+}
+
+// Geometry ...
+type Geometry struct {
+	Type        string      `json:"type" bson:"type"`
+	Coordinates interface{} `json:"coordinates" bson:"coordinates"`
+}
+
+// DistrictBoundary ...
+type DistrictBoundary struct {
+	ID       string    `json:"id" bson:"id"`
+	State    string    `json:"state" bson:"state"`
+	Name     string    `json:"name" bson:"name"`
+	Geometry *Geometry `json:"geometry" bson:"geometry"`
+}
+
+// LocationHistory ...
+type LocationHistory struct {
+	ID        string    `json:"id" bson:"id"`
+	UserID    string    `json:"userId" bson:"userId"`
+	Location  *Location `json:"-" bson:"location"`
+	Geohash   string    `json:"geohash" bson:"geohash"`
+	CreatedAt int64     `json:"createdAt" bson:"createdAt"`
+	TTL       time.Time `json:"ttl" bson:"ttl"`
+}
+
+// BoundingBox ...
+type BoundingBox struct {
+	MinLat  float64
+	MinLong float64
+	MaxLat  float64
+	MaxLong float64
+}
+
+// HeatmapCell ...
+type HeatmapCell struct {
+	Geohash string  `json:"geohash" bson:"_id"`
+	Lat     float64 `json:"lat" bson:"-"`
+	Long    float64 `json:"long" bson:"-"`
+	Count   int64   `json:"count" bson:"count"`
+}
@@ -14,6 +14,29 @@ type RangeData struct {
 
 // FilterData ...
 type FilterData struct {
-	Item  string
+	Item string
+	// Operator is one of filter operators in constants, equality if empty
+	Operator string
+	// Value is filter value, comma separated for in and range operators
 	Value string
 }
+
+// PageData is keyset pagination of list query
+type PageData struct {
+	Size int
+	// Token is next page token of previous page, empty for first page
+	Token string
+}
+
+// QueryData is query of list endpoints, filters are combined with and
+type QueryData struct {
+	Filters []*FilterData
+	Sorts   []*SortData
+	// Range is ignored if Page is set
+	Range *RangeData
+	Page  *PageData
+	// Search matches searchable fields of entity case-insensitively
+	Search string
+	// NoTotal skips counting total
+	NoTotal bool
+}
@@ -7,4 +7,9 @@ type Report struct {
 	CreatedAt  int64  `json:"createdAt" bson:"createdAt"`
 	HasSymptom bool   `json:"hasSymptom" bson:"hasSymptom"`
 	Results    []bool `json:"results" bson:"results"`
+	// Version is incremented on every update, updates are conditional on it
+	Version int64 `json:"version" bson:"version"`
+	// DeletedAt is set when report is soft deleted and 0 otherwise, deleted reports are excluded from queries until
+	// purged
+	DeletedAt int64 `json:"deletedAt" bson:"deletedAt"`
 }
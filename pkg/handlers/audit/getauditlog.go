@@ -0,0 +1,101 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
+	"galasejahtera/pkg/logger"
+	"galasejahtera/pkg/model"
+	"galasejahtera/pkg/utility"
+
+	"github.com/golang/protobuf/jsonpb"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+)
+
+type GetAuditLogHandler struct {
+	Model model.IModel
+}
+
+func (s *GetAuditLogHandler) GetAuditLog(ctx context.Context, req *pb.GetAuditLogRequest) (*pb.GetAuditLogResponse, error) {
+	query, err := utility.ParseQuery(req.Filters, req.Sorts)
+	if err != nil {
+		return nil, err
+	}
+	if len(query.Sorts) == 0 {
+		query.Sorts = append(query.Sorts, &dto.SortData{
+			Item:  constants.CreatedAt,
+			Order: constants.DESC,
+		})
+	}
+
+	// audit log is always paginated as it only grows
+	if req.PageSize < 0 {
+		return nil, constants.InvalidArgumentError
+	}
+	query.Page = &dto.PageData{
+		Size:  int(req.PageSize),
+		Token: req.PageToken,
+	}
+	query.NoTotal = !req.IncludeTotal
+
+	total, audits, next, err := s.Model.QueryAudits(ctx, query)
+	if err != nil {
+		logger.WithContext(ctx).Error("GetAuditLogHandler: " + err.Error())
+		return nil, errs.ToStatus(err, nil)
+	}
+
+	resp, err := s.auditsToResponse(audits)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Total = total
+	resp.NextPageToken = next
+	return resp, nil
+}
+
+func (s *GetAuditLogHandler) auditsToResponse(audits []*dto.Audit) (*pb.GetAuditLogResponse, error) {
+	var resps []*pb.Audit
+	for _, audit := range audits {
+		before, err := toStruct(audit.Before)
+		if err != nil {
+			return nil, err
+		}
+		after, err := toStruct(audit.After)
+		if err != nil {
+			return nil, err
+		}
+
+		resps = append(resps, &pb.Audit{
+			Id:        audit.ID,
+			Actor:     audit.Actor,
+			Action:    audit.Action,
+			Target:    audit.Target,
+			Before:    before,
+			After:     after,
+			RequestId: audit.RequestID,
+			CreatedAt: audit.CreatedAt,
+		})
+	}
+
+	return &pb.GetAuditLogResponse{Data: resps}, nil
+}
+
+// toStruct converts field values to struct, nil if there are none
+func toStruct(values map[string]interface{}) (*_struct.Struct, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	st := &_struct.Struct{}
+	if err = jsonpb.UnmarshalString(string(b), st); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
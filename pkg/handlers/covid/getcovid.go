@@ -5,11 +5,9 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/model"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type GetCovidHandler struct {
@@ -19,11 +17,8 @@ type GetCovidHandler struct {
 func (s *GetCovidHandler) GetCovid(ctx context.Context, req *pb.GetCovidRequest) (*pb.GetCovidResponse, error) {
 	covid, err := s.Model.GetCovid(ctx, req.Id)
 	if err != nil {
-		logger.Log.Error("GetCovidHandler: " + err.Error())
-		if status.Code(err) == codes.Unknown {
-			return nil, constants.CovidNotFoundError
-		}
-		return nil, constants.InternalError
+		logger.WithContext(ctx).Error("GetCovidHandler: " + err.Error())
+		return nil, errs.ToStatus(err, constants.CovidNotFoundError)
 	}
 
 	resp, err := s.covidToResponse(covid)
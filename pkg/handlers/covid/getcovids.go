@@ -5,10 +5,10 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/model"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"galasejahtera/pkg/utility"
 )
 
 type GetCovidsHandler struct {
@@ -16,38 +16,52 @@ type GetCovidsHandler struct {
 }
 
 func (s *GetCovidsHandler) GetCovids(ctx context.Context, req *pb.GetCovidsRequest) (*pb.GetCovidsResponse, error) {
-	var sort *dto.SortData
-	var itemsRange *dto.RangeData
-	var filter *dto.FilterData
+	// legacy sort and filter are applied after sorts and filters
+	query, err := utility.ParseQuery(req.Filters, req.Sorts)
+	if err != nil {
+		return nil, err
+	}
 
 	if req.Item != "" && req.Order != "" {
-		sort = &dto.SortData{
+		query.Sorts = append(query.Sorts, &dto.SortData{
 			Item:  req.Item,
 			Order: req.Order,
-		}
+		})
 	}
 
-	if req.To != 0 {
-		itemsRange = &dto.RangeData{
+	// page token takes precedence over from and to
+	if req.PageSize != 0 || req.PageToken != "" {
+		if req.PageSize < 0 {
+			return nil, constants.InvalidArgumentError
+		}
+		query.Page = &dto.PageData{
+			Size:  int(req.PageSize),
+			Token: req.PageToken,
+		}
+		query.NoTotal = !req.IncludeTotal
+	} else if req.To != 0 {
+		query.Range = &dto.RangeData{
 			From: int(req.From),
 			To:   int(req.To),
 		}
 	}
 
 	if req.FilterItem != "" && req.FilterValue != "" {
-		filter = &dto.FilterData{
-			Item:  req.FilterItem,
-			Value: req.FilterValue,
+		if req.FilterItem == constants.Search {
+			query.Search = req.FilterValue
+		} else {
+			query.Filters = append(query.Filters, &dto.FilterData{
+				Item:     req.FilterItem,
+				Operator: constants.EQ,
+				Value:    req.FilterValue,
+			})
 		}
 	}
 
-	total, covids, err := s.Model.QueryCovids(ctx, sort, itemsRange, filter)
+	total, covids, next, err := s.Model.QueryCovids(ctx, query)
 	if err != nil {
-		logger.Log.Error("GetCovidsHandler: " + err.Error())
-		if status.Code(err) == codes.Unknown {
-			return nil, constants.CovidNotFoundError
-		}
-		return nil, constants.InternalError
+		logger.WithContext(ctx).Error("GetCovidsHandler: " + err.Error())
+		return nil, errs.ToStatus(err, constants.CovidNotFoundError)
 	}
 
 	resp, err := s.covidsToResponses(covids)
@@ -56,6 +70,7 @@ func (s *GetCovidsHandler) GetCovids(ctx context.Context, req *pb.GetCovidsReque
 	}
 
 	resp.Total = total
+	resp.NextPageToken = next
 	return resp, nil
 }
 
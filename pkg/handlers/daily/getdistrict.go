@@ -5,12 +5,10 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/model"
 	"galasejahtera/pkg/utility"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type GetDailyHandler struct {
@@ -20,29 +18,16 @@ type GetDailyHandler struct {
 func (s *GetDailyHandler) GetDistrict(ctx context.Context, req *pb.GetDistrictRequest) (*pb.GetDistrictResponse, error) {
 	daily, err := s.Model.GetDaily(ctx)
 	if err != nil {
-		logger.Log.Error("GetDailyHandler: " + err.Error())
-		if status.Code(err) == codes.Unknown {
-			return nil, constants.DailyNotFoundError
-		}
-		return nil, constants.InternalError
+		logger.WithContext(ctx).Error("GetDailyHandler: " + err.Error())
+		return nil, errs.ToStatus(err, constants.DailyNotFoundError)
 	}
 
-	d := &dto.District{}
-	for _, state := range daily.States {
-		for _, district := range state.Districts {
-			if utility.NormalizePlace(district.Name) == utility.NormalizePlace(req.Id) {
-				d = district
-				break
-			}
-		}
-		if utility.NormalizePlace(state.Name) == utility.NormalizePlace(req.Id) {
-			d.Name = state.Name
-			d.Total = state.Total
-			break
-		}
+	match, suggestions := utility.MatchPlace(daily, req.Id)
+	if match == nil && len(suggestions) == 0 {
+		return nil, constants.PlaceNotFoundError
 	}
 
-	resp, err := s.districtToResponse(d)
+	resp, err := s.districtToResponse(match, suggestions)
 	if err != nil {
 		return nil, err
 	}
@@ -50,13 +35,25 @@ func (s *GetDailyHandler) GetDistrict(ctx context.Context, req *pb.GetDistrictRe
 	return resp, nil
 }
 
-func (s *GetDailyHandler) districtToResponse(district *dto.District) (*pb.GetDistrictResponse, error) {
-	resp := &pb.GetDistrictResponse{
-		Data: &pb.District{
-			Name:  district.Name,
-			Total: district.Total,
-		},
+func (s *GetDailyHandler) districtToResponse(match *dto.PlaceMatch, suggestions []*dto.PlaceMatch) (*pb.GetDistrictResponse, error) {
+	resp := &pb.GetDistrictResponse{}
+	if match != nil {
+		resp.Data = s.districtToPb(match.District)
+	}
+	for _, suggestion := range suggestions {
+		resp.Suggestions = append(resp.Suggestions, s.districtToPb(suggestion.District))
 	}
 
 	return resp, nil
 }
+
+func (s *GetDailyHandler) districtToPb(district *dto.District) *pb.District {
+	return &pb.District{
+		Name:   district.Name,
+		State:  district.State,
+		Total:  district.Total,
+		Active: district.Active,
+		Trend:  district.Trend,
+		Risk:   district.Risk,
+	}
+}
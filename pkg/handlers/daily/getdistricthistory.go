@@ -0,0 +1,74 @@
+package daily
+
+import (
+	"context"
+	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
+	"galasejahtera/pkg/logger"
+	"galasejahtera/pkg/model"
+	"galasejahtera/pkg/utility"
+	"time"
+)
+
+type GetDistrictHistoryHandler struct {
+	Model model.IModel
+}
+
+func (s *GetDistrictHistoryHandler) GetDistrictHistory(ctx context.Context, req *pb.GetDistrictHistoryRequest) (*pb.GetDistrictHistoryResponse, error) {
+	if req.Id == "" {
+		return nil, constants.InvalidArgumentError
+	}
+
+	// get time range, default to last 30 days and capped to last 90 days of range
+	endTime := req.EndTime
+	if endTime == 0 {
+		endTime = utility.TimeToMilli(utility.MalaysiaTime(time.Now()))
+	}
+	startTime := req.StartTime
+	if startTime == 0 {
+		startTime = endTime - constants.DistrictHistoryRange.Milliseconds()
+	}
+	if startTime > endTime {
+		return nil, constants.InvalidArgumentError
+	}
+	if endTime-startTime > constants.MaxDistrictHistoryRange.Milliseconds() {
+		startTime = endTime - constants.MaxDistrictHistoryRange.Milliseconds()
+	}
+
+	dailies, err := s.Model.GetDailies(ctx, startTime, endTime)
+	if err != nil {
+		logger.WithContext(ctx).Error("GetDistrictHistoryHandler: " + err.Error())
+		return nil, errs.ToStatus(err, constants.DailyNotFoundError)
+	}
+
+	name, history := utility.BuildDistrictHistory(dailies, req.Id)
+	if len(history) == 0 {
+		return nil, constants.DailyNotFoundError
+	}
+
+	resp, err := s.historyToResponse(name, history)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *GetDistrictHistoryHandler) historyToResponse(name string, history []*dto.DistrictHistory) (*pb.GetDistrictHistoryResponse, error) {
+	var data []*pb.DistrictHistory
+	for _, h := range history {
+		data = append(data, &pb.DistrictHistory{
+			LastUpdated: h.LastUpdated,
+			Total:       h.Total,
+			Delta:       h.Delta,
+			Average:     h.Average,
+		})
+	}
+
+	return &pb.GetDistrictHistoryResponse{
+		Name: name,
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package daily
+
+import (
+	"context"
+	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
+	"galasejahtera/pkg/logger"
+	"galasejahtera/pkg/model"
+)
+
+type GetMyDistrictHandler struct {
+	Model model.IModel
+}
+
+func (s *GetMyDistrictHandler) GetMyDistrict(ctx context.Context, req *pb.GetMyDistrictRequest, caller *dto.User) (*pb.GetMyDistrictResponse, error) {
+	// authenticated caller takes precedence over request user id
+	id := req.Id
+	if caller != nil && caller.ID != "" {
+		id = caller.ID
+	}
+	if id == "" {
+		return nil, constants.InvalidArgumentError
+	}
+
+	u, err := s.Model.GetUser(ctx, id)
+	if err != nil {
+		logger.WithContext(ctx).Error("GetMyDistrictHandler: " + err.Error())
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
+	}
+	if u.Location == nil || len(u.Location.Coordinates) != 2 {
+		return nil, constants.InvalidArgumentError
+	}
+
+	boundary, district, err := s.Model.GetUserDistrict(ctx, u)
+	if err != nil {
+		logger.WithContext(ctx).Error("GetMyDistrictHandler: " + err.Error())
+		return nil, errs.ToStatus(err, constants.DistrictNotFoundError)
+	}
+
+	resp, err := s.districtToResponse(boundary, district)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *GetMyDistrictHandler) districtToResponse(boundary *dto.DistrictBoundary, district *dto.District) (*pb.GetMyDistrictResponse, error) {
+	resp := &pb.GetMyDistrictResponse{
+		State: boundary.State,
+		Data: &pb.District{
+			Name:   district.Name,
+			State:  boundary.State,
+			Total:  district.Total,
+			Active: district.Active,
+			Trend:  district.Trend,
+			Risk:   district.Risk,
+		},
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,65 @@
+package daily
+
+import (
+	"context"
+	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
+	"galasejahtera/pkg/logger"
+	"galasejahtera/pkg/model"
+
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+type ListDistrictsHandler struct {
+	Model model.IModel
+}
+
+func (s *ListDistrictsHandler) ListDistricts(ctx context.Context, req *empty.Empty) (*pb.ListDistrictsResponse, error) {
+	daily, err := s.Model.GetDaily(ctx)
+	if err != nil {
+		logger.WithContext(ctx).Error("ListDistrictsHandler: " + err.Error())
+		return nil, errs.ToStatus(err, constants.DailyNotFoundError)
+	}
+	if len(daily.States) == 0 {
+		return nil, constants.DailyNotFoundError
+	}
+
+	resp, err := s.dailyToResponse(daily)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *ListDistrictsHandler) dailyToResponse(daily *dto.Daily) (*pb.ListDistrictsResponse, error) {
+	var states []*pb.State
+	for _, state := range daily.States {
+		var districts []*pb.District
+		for _, district := range state.Districts {
+			districts = append(districts, &pb.District{
+				Name:   district.Name,
+				State:  state.Name,
+				Total:  district.Total,
+				Active: district.Active,
+				Trend:  district.Trend,
+				Risk:   district.Risk,
+			})
+		}
+		states = append(states, &pb.State{
+			Name:      state.Name,
+			Total:     state.Total,
+			Active:    state.Active,
+			Trend:     state.Trend,
+			Risk:      state.Risk,
+			Districts: districts,
+		})
+	}
+
+	return &pb.ListDistrictsResponse{
+		Data:        states,
+		LastUpdated: daily.LastUpdated,
+	}, nil
+}
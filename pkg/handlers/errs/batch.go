@@ -0,0 +1,26 @@
+package errs
+
+import (
+	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/dto"
+
+	"google.golang.org/grpc/status"
+)
+
+// ToBatchResults maps results of batch operation to response results, returns IDs succeeded and result per ID
+func ToBatchResults(results []*dto.BatchResult) ([]string, []*pb.BatchResult) {
+	var ids []string
+	var resp []*pb.BatchResult
+	for _, r := range results {
+		s := status.Convert(ToStatus(r.Error, nil))
+		if r.Error == nil {
+			ids = append(ids, r.ID)
+		}
+		resp = append(resp, &pb.BatchResult{
+			Id:      r.ID,
+			Code:    int32(s.Code()),
+			Message: s.Message(),
+		})
+	}
+	return ids, resp
+}
@@ -0,0 +1,53 @@
+package errs
+
+import (
+	"context"
+	"errors"
+	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dao"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ToStatus maps error returned by model to gRPC status error. Status errors are returned as is,
+// DAO errors are mapped by their kind, notFound is returned for dao.ErrNotFound if set and any
+// other error is hidden behind constants.InternalError. dao.VersionError carries current version
+// as pb.VersionConflict detail.
+func ToStatus(err error, notFound error) error {
+	if err == nil {
+		return nil
+	}
+	if s, ok := status.FromError(err); ok && s.Code() != codes.Unknown {
+		return err
+	}
+
+	var versionErr *dao.VersionError
+	if errors.As(err, &versionErr) {
+		s, err := status.Convert(constants.VersionConflictError).WithDetails(&pb.VersionConflict{CurrentVersion: versionErr.Current})
+		if err != nil {
+			return constants.VersionConflictError
+		}
+		return s.Err()
+	}
+
+	switch {
+	case errors.Is(err, dao.ErrNotFound):
+		if notFound != nil {
+			return notFound
+		}
+		return constants.NotFoundError
+	case errors.Is(err, dao.ErrVersionConflict):
+		return constants.VersionConflictError
+	case errors.Is(err, dao.ErrConflict):
+		return constants.ConflictError
+	case errors.Is(err, dao.ErrTimeout), errors.Is(err, context.DeadlineExceeded):
+		return constants.TimeoutError
+	case errors.Is(err, dao.ErrUnavailable):
+		return constants.UnavailableError
+	case errors.Is(err, context.Canceled):
+		return constants.CanceledError
+	}
+	return constants.InternalError
+}
@@ -0,0 +1,48 @@
+package errs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dao"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestToStatus ...
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		notFound       error
+		expectedResult error
+	}{
+		{name: "nil error, should return nil", err: nil, expectedResult: nil},
+		{name: "status error, should return as is", err: constants.EmailAlreadyExistError, expectedResult: constants.EmailAlreadyExistError},
+		{name: "not found with entity error, should return entity error", err: &dao.Error{Kind: dao.ErrNotFound, Err: errors.New("no documents")}, notFound: constants.UserNotFoundError, expectedResult: constants.UserNotFoundError},
+		{name: "not found without entity error, should return not found", err: &dao.Error{Kind: dao.ErrNotFound, Err: errors.New("no documents")}, expectedResult: constants.NotFoundError},
+		{name: "conflict, should return already exists", err: &dao.Error{Kind: dao.ErrConflict, Err: errors.New("dup key")}, expectedResult: constants.ConflictError},
+		{name: "timeout, should return deadline exceeded", err: &dao.Error{Kind: dao.ErrTimeout, Err: context.DeadlineExceeded}, expectedResult: constants.TimeoutError},
+		{name: "unavailable, should return unavailable", err: &dao.Error{Kind: dao.ErrUnavailable, Err: errors.New("connection refused")}, expectedResult: constants.UnavailableError},
+		{name: "canceled context, should return canceled", err: context.Canceled, expectedResult: constants.CanceledError},
+		{name: "unknown error, should return internal", err: errors.New("boom"), notFound: constants.UserNotFoundError, expectedResult: constants.InternalError},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expectedResult, ToStatus(test.err, test.notFound), test.name)
+	}
+}
+
+// TestToStatusVersionConflict ...
+func TestToStatusVersionConflict(t *testing.T) {
+	s := status.Convert(ToStatus(fmt.Errorf("update: %w", &dao.VersionError{Current: 4}), nil))
+	assert.Equal(t, codes.Aborted, s.Code())
+	if assert.Len(t, s.Details(), 1) {
+		assert.Equal(t, int64(4), s.Details()[0].(*pb.VersionConflict).CurrentVersion)
+	}
+}
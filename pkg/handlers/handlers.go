@@ -6,36 +6,52 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/audit"
 	"galasejahtera/pkg/handlers/covid"
 	"galasejahtera/pkg/handlers/daily"
+	"galasejahtera/pkg/handlers/job"
 	"galasejahtera/pkg/handlers/kase"
+	"galasejahtera/pkg/handlers/location"
 	"galasejahtera/pkg/handlers/report"
 	"galasejahtera/pkg/handlers/user"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/model"
+	"galasejahtera/pkg/scheduler"
+	"galasejahtera/pkg/utility"
+	"net"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 // Handlers ...
 type Handlers struct {
-	Model model.IModel
+	Model     model.IModel
+	Scheduler scheduler.IScheduler
+	// nearbyLimiter limits nearby users requests per user or client address, so that symptomatic users cannot be triangulated
+	nearbyLimiter *utility.RateLimiter
 }
 
 // NewHandlers ...
-func NewHandlers(model model.IModel) IHandlers {
-	return &Handlers{Model: model}
+func NewHandlers(model model.IModel, scheduler scheduler.IScheduler) IHandlers {
+	limit := utility.GetEnvInt("NEARBY_RATE_LIMIT", constants.NearbyRateLimit)
+	return &Handlers{
+		Model:         model,
+		Scheduler:     scheduler,
+		nearbyLimiter: utility.NewRateLimiter(limit, time.Minute, limit),
+	}
 }
 
 func (s *Handlers) GetKases(ctx context.Context, req *empty.Empty) (*pb.GetKasesResponse, error) {
 	handler := &kase.GetKasesHandler{Model: s.Model}
 	resp, err := handler.GetKases(ctx, req)
 	if err != nil {
-		logger.Log.Error("GetKasesHandler: " + err.Error())
+		logger.WithContext(ctx).Error("GetKasesHandler: " + err.Error())
 		return nil, err
 	}
 	return resp, nil
@@ -45,17 +61,30 @@ func (s *Handlers) GetRecentKases(ctx context.Context, req *empty.Empty) (*pb.Ge
 	handler := &kase.GetRecentKasesHandler{Model: s.Model}
 	resp, err := handler.GetRecentKases(ctx, req)
 	if err != nil {
-		logger.Log.Error("GetRecentKasesHandler: " + err.Error())
+		logger.WithContext(ctx).Error("GetRecentKasesHandler: " + err.Error())
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (s *Handlers) GetNearbyUsers(ctx context.Context, req *pb.GetNearbyUsersRequest) (*pb.GetNearbyUsersResponse, error) {
+	u, err := s.validateUser(ctx, constants.AllCanAccess)
+	if err != nil {
+		return nil, constants.UnauthorizedAccessError
+	}
+	// without authenticated user, request user id is chosen by client, so requests are limited per address
+	key := u.ID
+	if key == "" {
+		key = clientAddress(ctx)
+	}
+	if !s.nearbyLimiter.Allow(key) {
+		logger.WithContext(ctx).Warn("ClientGetNearbyUsersHandler: rate limited", zap.String("RateLimitKey", key))
+		return nil, constants.RateLimitedError
+	}
 	handler := &user.GetNearbyUsersHandler{Model: s.Model}
-	resp, err := handler.GetNearbyUsers(ctx, req)
+	resp, err := handler.GetNearbyUsers(ctx, req, u)
 	if err != nil {
-		logger.Log.Error("ClientGetNearbyUsersHandler: " + err.Error())
+		logger.WithContext(ctx).Error("ClientGetNearbyUsersHandler: "+err.Error(), zap.String("UserID", u.ID))
 		return nil, err
 	}
 	return resp, nil
@@ -65,7 +94,7 @@ func (s *Handlers) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*
 	handler := &user.CreateUserHandler{Model: s.Model}
 	resp, err := handler.CreateUser(ctx, req)
 	if err != nil {
-		logger.Log.Error("CreateUserHandler: " + err.Error())
+		logger.WithContext(ctx).Error("CreateUserHandler: " + err.Error())
 		return nil, err
 	}
 	return resp, nil
@@ -75,7 +104,7 @@ func (s *Handlers) GetUsers(ctx context.Context, req *pb.GetUsersRequest) (*pb.G
 	handler := &user.GetUsersHandler{Model: s.Model}
 	resp, err := handler.GetUsers(ctx, req)
 	if err != nil {
-		logger.Log.Error("GetUsersHandler: " + err.Error())
+		logger.WithContext(ctx).Error("GetUsersHandler: " + err.Error())
 		return nil, err
 	}
 	return resp, nil
@@ -85,54 +114,122 @@ func (s *Handlers) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.Get
 	handler := &user.GetUserHandler{Model: s.Model}
 	resp, err := handler.GetUser(ctx, req)
 	if err != nil {
-		logger.Log.Error("GetUserHandler: "+err.Error(), zap.String("UserID", req.Id))
+		logger.WithContext(ctx).Error("GetUserHandler: "+err.Error(), zap.String("UserID", req.Id))
 		return nil, err
 	}
-	logger.Log.Info("GetUserHandler", zap.String("UserID", req.Id))
+	logger.WithContext(ctx).Info("GetUserHandler", zap.String("UserID", req.Id))
 	return resp, nil
 }
 
 func (s *Handlers) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	u, err := s.validateUser(ctx, constants.AdminCanAccess)
+	if err != nil {
+		return nil, constants.UnauthorizedAccessError
+	}
 	handler := &user.DeleteUserHandler{Model: s.Model}
-	resp, err := handler.DeleteUser(ctx, req)
+	resp, err := handler.DeleteUser(ctx, req, u)
+	if err != nil {
+		logger.WithContext(ctx).Error("DeleteUserHandler: "+err.Error(), zap.String("UserID", u.ID), zap.String("TargetUserID", req.Id))
+		return nil, err
+	}
+	logger.WithContext(ctx).Info("DeleteUserHandler", zap.String("UserID", u.ID), zap.String("TargetUserID", req.Id))
+	return resp, nil
+}
+
+func (s *Handlers) RestoreUser(ctx context.Context, req *pb.RestoreUserRequest) (*pb.RestoreUserResponse, error) {
+	u, err := s.validateUser(ctx, constants.AdminCanAccess)
+	if err != nil {
+		return nil, constants.UnauthorizedAccessError
+	}
+	handler := &user.RestoreUserHandler{Model: s.Model}
+	resp, err := handler.RestoreUser(ctx, req, u)
+	if err != nil {
+		logger.WithContext(ctx).Error("RestoreUserHandler: "+err.Error(), zap.String("UserID", u.ID), zap.String("TargetUserID", req.Id))
+		return nil, err
+	}
+	logger.WithContext(ctx).Info("RestoreUserHandler", zap.String("UserID", u.ID), zap.String("TargetUserID", req.Id))
+	return resp, nil
+}
+
+func (s *Handlers) ExportMyData(ctx context.Context, req *pb.ExportMyDataRequest) (*pb.ExportMyDataResponse, error) {
+	u, err := s.validateUser(ctx, constants.AllCanAccess)
+	if err != nil {
+		return nil, constants.UnauthorizedAccessError
+	}
+	handler := &user.ExportMyDataHandler{Model: s.Model}
+	resp, err := handler.ExportMyData(ctx, req, u)
+	if err != nil {
+		logger.WithContext(ctx).Error("ExportMyDataHandler: "+err.Error(), zap.String("UserID", u.ID))
+		return nil, err
+	}
+	logger.WithContext(ctx).Info("ExportMyDataHandler", zap.String("UserID", u.ID))
+	return resp, nil
+}
+
+func (s *Handlers) EraseMyAccount(ctx context.Context, req *pb.EraseMyAccountRequest) (*empty.Empty, error) {
+	u, err := s.validateUser(ctx, constants.AllCanAccess)
 	if err != nil {
-		logger.Log.Error("DeleteUserHandler: "+err.Error(), zap.String("TargetUserID", req.Id))
+		return nil, constants.UnauthorizedAccessError
+	}
+	handler := &user.EraseMyAccountHandler{Model: s.Model}
+	resp, err := handler.EraseMyAccount(ctx, req, u)
+	if err != nil {
+		logger.WithContext(ctx).Error("EraseMyAccountHandler: "+err.Error(), zap.String("UserID", u.ID))
 		return nil, err
 	}
-	logger.Log.Info("DeleteUserHandler", zap.String("TargetUserID", req.Id))
+	logger.WithContext(ctx).Info("EraseMyAccountHandler", zap.String("UserID", u.ID))
 	return resp, nil
 }
 
 func (s *Handlers) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
+	u, err := s.validateUser(ctx, constants.AllCanAccess)
+	if err != nil {
+		return nil, constants.UnauthorizedAccessError
+	}
+	version, err := ifMatch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if version != 0 && req.Data != nil {
+		req.Data.Version = version
+	}
 	handler := &user.UpdateUserHandler{Model: s.Model}
-	resp, err := handler.UpdateUser(ctx, req)
+	resp, err := handler.UpdateUser(ctx, req, u)
 	if err != nil {
-		logger.Log.Error("UpdateUserHandler: "+err.Error(), zap.String("TargetUserID", req.Id))
+		logger.WithContext(ctx).Error("UpdateUserHandler: "+err.Error(), zap.String("UserID", u.ID), zap.String("TargetUserID", req.Id))
 		return nil, err
 	}
-	logger.Log.Info("UpdateUserHandler", zap.String("TargetUserID", req.Id))
+	logger.WithContext(ctx).Info("UpdateUserHandler", zap.String("UserID", u.ID), zap.String("TargetUserID", req.Id))
 	return resp, nil
 }
 
 func (s *Handlers) DeleteUsers(ctx context.Context, req *pb.DeleteUsersRequest) (*pb.DeleteUsersResponse, error) {
+	u, err := s.validateUser(ctx, constants.AdminCanAccess)
+	if err != nil {
+		return nil, constants.UnauthorizedAccessError
+	}
 	handler := &user.DeleteUsersHandler{Model: s.Model}
-	resp, err := handler.DeleteUsers(ctx, req)
+	resp, err := handler.DeleteUsers(ctx, req, u)
 	if err != nil {
-		logger.Log.Error("DeleteUsersHandler: "+err.Error(), zap.Strings("TargetUserIDs", req.Ids))
+		logger.WithContext(ctx).Error("DeleteUsersHandler: "+err.Error(), zap.String("UserID", u.ID), zap.Strings("TargetUserIDs", req.Ids))
 		return nil, err
 	}
-	logger.Log.Info("DeleteUsersHandler", zap.Strings("TargetUserIDs", req.Ids))
+	logger.WithContext(ctx).Info("DeleteUsersHandler", zap.String("UserID", u.ID), zap.Strings("TargetUserIDs", req.Ids))
 	return resp, nil
 }
 
 func (s *Handlers) UpdateUsers(ctx context.Context, req *pb.UpdateUsersRequest) (*pb.UpdateUsersResponse, error) {
+	u, err := s.validateUser(ctx, constants.AdminCanAccess)
+	if err != nil {
+		return nil, constants.UnauthorizedAccessError
+	}
 	handler := &user.UpdateUsersHandler{Model: s.Model}
-	resp, err := handler.UpdateUsers(ctx, req)
+	resp, err := handler.UpdateUsers(ctx, req, u)
 	if err != nil {
-		logger.Log.Error("UpdateUsersHandler: "+err.Error(), zap.Strings("TargetUserIDs", req.Ids))
+		logger.WithContext(ctx).Error("UpdateUsersHandler: "+err.Error(), zap.String("UserID", u.ID), zap.Strings("TargetUserIDs", req.Ids))
 		return nil, err
 	}
-	logger.Log.Info("UpdateUsersHandler", zap.Strings("TargetUserIDs", req.Ids))
+	logger.WithContext(ctx).Info("UpdateUsersHandler", zap.String("UserID", u.ID), zap.Strings("TargetUserIDs", req.Ids))
 	return resp, nil
 }
 
@@ -140,10 +237,10 @@ func (s *Handlers) GetPasswordReset(ctx context.Context, req *pb.GetPasswordRese
 	handler := &user.GetPasswordResetHandler{Model: s.Model}
 	resp, err := handler.GetPasswordReset(ctx, req)
 	if err != nil {
-		logger.Log.Error("GetPasswordResetHandler: "+err.Error(), zap.String("TargetUserID", req.Id))
+		logger.WithContext(ctx).Error("GetPasswordResetHandler: "+err.Error(), zap.String("TargetUserID", req.Id))
 		return nil, err
 	}
-	logger.Log.Info("GetPasswordResetHandler", zap.String("TargetUserID", req.Id))
+	logger.WithContext(ctx).Info("GetPasswordResetHandler", zap.String("TargetUserID", req.Id))
 	return resp, nil
 }
 
@@ -151,10 +248,10 @@ func (s *Handlers) UpdatePassword(ctx context.Context, req *pb.UpdatePasswordReq
 	handler := &user.UpdatePasswordHandler{Model: s.Model}
 	resp, err := handler.UpdatePassword(ctx, req)
 	if err != nil {
-		logger.Log.Error("UpdatePasswordHandler: " + err.Error())
+		logger.WithContext(ctx).Error("UpdatePasswordHandler: " + err.Error())
 		return nil, err
 	}
-	logger.Log.Info("UpdatePasswordHandler")
+	logger.WithContext(ctx).Info("UpdatePasswordHandler")
 	return resp, nil
 }
 
@@ -162,10 +259,10 @@ func (s *Handlers) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginRe
 	handler := &user.LoginHandler{Model: s.Model}
 	resp, err := handler.Login(ctx, req)
 	if err != nil {
-		logger.Log.Error("LoginHandler: "+err.Error(), zap.String("email", req.Email))
+		logger.WithContext(ctx).Error("LoginHandler: "+err.Error(), zap.String("email", req.Email))
 		return nil, err
 	}
-	logger.Log.Info("LoginHandler", zap.String("email", req.Email))
+	logger.WithContext(ctx).Info("LoginHandler", zap.String("email", req.Email))
 	return resp, nil
 }
 
@@ -193,7 +290,7 @@ func (s *Handlers) GetCovids(ctx context.Context, req *pb.GetCovidsRequest) (*pb
 	handler := &covid.GetCovidsHandler{Model: s.Model}
 	resp, err := handler.GetCovids(ctx, req)
 	if err != nil {
-		logger.Log.Error("GetCovidsHandler: " + err.Error())
+		logger.WithContext(ctx).Error("GetCovidsHandler: " + err.Error())
 		return nil, err
 	}
 	return resp, nil
@@ -203,10 +300,10 @@ func (s *Handlers) GetCovid(ctx context.Context, req *pb.GetCovidRequest) (*pb.G
 	handler := &covid.GetCovidHandler{Model: s.Model}
 	resp, err := handler.GetCovid(ctx, req)
 	if err != nil {
-		logger.Log.Error("GetCovidHandler: "+err.Error(), zap.String("CovidID", req.Id))
+		logger.WithContext(ctx).Error("GetCovidHandler: "+err.Error(), zap.String("CovidID", req.Id))
 		return nil, err
 	}
-	logger.Log.Info("GetCovidHandler", zap.String("CovidID", req.Id))
+	logger.WithContext(ctx).Info("GetCovidHandler", zap.String("CovidID", req.Id))
 	return resp, nil
 }
 
@@ -216,10 +313,10 @@ func (s *Handlers) CreateReport(ctx context.Context, req *pb.CreateReportRequest
 	handler := &report.CreateReportHandler{Model: s.Model}
 	resp, err := handler.CreateReport(ctx, req)
 	if err != nil {
-		logger.Log.Error("CreateReportHandler: " + err.Error())
+		logger.WithContext(ctx).Error("CreateReportHandler: " + err.Error())
 		return nil, err
 	}
-	logger.Log.Info("CreateReportHandler")
+	logger.WithContext(ctx).Info("CreateReportHandler")
 	return resp, nil
 }
 
@@ -227,7 +324,7 @@ func (s *Handlers) GetReports(ctx context.Context, req *pb.GetReportsRequest) (*
 	handler := &report.GetReportsHandler{Model: s.Model}
 	resp, err := handler.GetReports(ctx, req)
 	if err != nil {
-		logger.Log.Error("GetReportsHandler: " + err.Error())
+		logger.WithContext(ctx).Error("GetReportsHandler: " + err.Error())
 		return nil, err
 	}
 	return resp, nil
@@ -241,10 +338,10 @@ func (s *Handlers) GetReport(ctx context.Context, req *pb.GetReportRequest) (*pb
 	handler := &report.GetReportHandler{Model: s.Model}
 	resp, err := handler.GetReport(ctx, req)
 	if err != nil {
-		logger.Log.Error("GetReportHandler: "+err.Error(), zap.String("UserID", u.ID), zap.String("ReportID", req.Id))
+		logger.WithContext(ctx).Error("GetReportHandler: "+err.Error(), zap.String("UserID", u.ID), zap.String("ReportID", req.Id))
 		return nil, err
 	}
-	logger.Log.Info("GetReportHandler", zap.String("UserID", u.ID), zap.String("ReportID", req.Id))
+	logger.WithContext(ctx).Info("GetReportHandler", zap.String("UserID", u.ID), zap.String("ReportID", req.Id))
 	return resp, nil
 }
 
@@ -254,12 +351,12 @@ func (s *Handlers) DeleteReport(ctx context.Context, req *pb.DeleteReportRequest
 		return nil, constants.UnauthorizedAccessError
 	}
 	handler := &report.DeleteReportHandler{Model: s.Model}
-	resp, err := handler.DeleteReport(ctx, req)
+	resp, err := handler.DeleteReport(ctx, req, u)
 	if err != nil {
-		logger.Log.Error("DeleteReportHandler: "+err.Error(), zap.String("UserID", u.ID), zap.String("TargetReportID", req.Id))
+		logger.WithContext(ctx).Error("DeleteReportHandler: "+err.Error(), zap.String("UserID", u.ID), zap.String("TargetReportID", req.Id))
 		return nil, err
 	}
-	logger.Log.Info("DeleteReportHandler", zap.String("UserID", u.ID), zap.String("TargetReportID", req.Id))
+	logger.WithContext(ctx).Info("DeleteReportHandler", zap.String("UserID", u.ID), zap.String("TargetReportID", req.Id))
 	return resp, nil
 }
 
@@ -268,13 +365,35 @@ func (s *Handlers) UpdateReport(ctx context.Context, req *pb.UpdateReportRequest
 	if err != nil {
 		return nil, constants.UnauthorizedAccessError
 	}
+	version, err := ifMatch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if version != 0 && req.Data != nil {
+		req.Data.Version = version
+	}
 	handler := &report.UpdateReportHandler{Model: s.Model}
-	resp, err := handler.UpdateReport(ctx, req)
+	resp, err := handler.UpdateReport(ctx, req, u)
+	if err != nil {
+		logger.WithContext(ctx).Error("UpdateReportHandler: "+err.Error(), zap.String("UserID", u.ID), zap.String("TargetReportID", req.Id))
+		return nil, err
+	}
+	logger.WithContext(ctx).Info("UpdateReportHandler", zap.String("UserID", u.ID), zap.String("TargetReportID", req.Id))
+	return resp, nil
+}
+
+func (s *Handlers) RestoreReport(ctx context.Context, req *pb.RestoreReportRequest) (*pb.RestoreReportResponse, error) {
+	u, err := s.validateUser(ctx, constants.AdminCanAccess)
 	if err != nil {
-		logger.Log.Error("UpdateReportHandler: "+err.Error(), zap.String("UserID", u.ID), zap.String("TargetReportID", req.Id))
+		return nil, constants.UnauthorizedAccessError
+	}
+	handler := &report.RestoreReportHandler{Model: s.Model}
+	resp, err := handler.RestoreReport(ctx, req, u)
+	if err != nil {
+		logger.WithContext(ctx).Error("RestoreReportHandler: "+err.Error(), zap.String("UserID", u.ID), zap.String("TargetReportID", req.Id))
 		return nil, err
 	}
-	logger.Log.Info("UpdateReportHandler", zap.String("UserID", u.ID), zap.String("TargetReportID", req.Id))
+	logger.WithContext(ctx).Info("RestoreReportHandler", zap.String("UserID", u.ID), zap.String("TargetReportID", req.Id))
 	return resp, nil
 }
 
@@ -284,12 +403,12 @@ func (s *Handlers) DeleteReports(ctx context.Context, req *pb.DeleteReportsReque
 		return nil, constants.UnauthorizedAccessError
 	}
 	handler := &report.DeleteReportsHandler{Model: s.Model}
-	resp, err := handler.DeleteReports(ctx, req)
+	resp, err := handler.DeleteReports(ctx, req, u)
 	if err != nil {
-		logger.Log.Error("DeleteReportsHandler: "+err.Error(), zap.String("UserID", u.ID), zap.Strings("TargetReportIDs", req.Ids))
+		logger.WithContext(ctx).Error("DeleteReportsHandler: "+err.Error(), zap.String("UserID", u.ID), zap.Strings("TargetReportIDs", req.Ids))
 		return nil, err
 	}
-	logger.Log.Info("DeleteReportsHandler", zap.String("UserID", u.ID), zap.Strings("TargetReportIDs", req.Ids))
+	logger.WithContext(ctx).Info("DeleteReportsHandler", zap.String("UserID", u.ID), zap.Strings("TargetReportIDs", req.Ids))
 	return resp, nil
 }
 
@@ -301,10 +420,10 @@ func (s *Handlers) UpdateReports(ctx context.Context, req *pb.UpdateReportsReque
 	handler := &report.UpdateReportsHandler{Model: s.Model}
 	resp, err := handler.UpdateReports(ctx, req)
 	if err != nil {
-		logger.Log.Error("UpdateReportsHandler: "+err.Error(), zap.String("UserID", u.ID), zap.Strings("TargetReportIDs", req.Ids))
+		logger.WithContext(ctx).Error("UpdateReportsHandler: "+err.Error(), zap.String("UserID", u.ID), zap.Strings("TargetReportIDs", req.Ids))
 		return nil, err
 	}
-	logger.Log.Info("UpdateReportsHandler", zap.String("UserID", u.ID), zap.Strings("TargetReportIDs", req.Ids))
+	logger.WithContext(ctx).Info("UpdateReportsHandler", zap.String("UserID", u.ID), zap.Strings("TargetReportIDs", req.Ids))
 	return resp, nil
 }
 
@@ -312,19 +431,151 @@ func (s *Handlers) UpdateReports(ctx context.Context, req *pb.UpdateReportsReque
 
 // -------------------- Daily ------------------------
 
+func (s *Handlers) ListDistricts(ctx context.Context, req *empty.Empty) (*pb.ListDistrictsResponse, error) {
+	handler := &daily.ListDistrictsHandler{Model: s.Model}
+	resp, err := handler.ListDistricts(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).Error("ListDistrictsHandler: " + err.Error())
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (s *Handlers) GetDistrict(ctx context.Context, req *pb.GetDistrictRequest) (*pb.GetDistrictResponse, error) {
 	handler := &daily.GetDailyHandler{Model: s.Model}
 	resp, err := handler.GetDistrict(ctx, req)
 	if err != nil {
-		logger.Log.Error("GetDistrictHandler: "+err.Error(), zap.String("District", req.Id))
+		logger.WithContext(ctx).Error("GetDistrictHandler: "+err.Error(), zap.String("District", req.Id))
 		return nil, err
 	}
-	logger.Log.Info("GetDistrictHandler", zap.String("District", req.Id))
+	logger.WithContext(ctx).Info("GetDistrictHandler", zap.String("District", req.Id))
+	return resp, nil
+}
+
+func (s *Handlers) GetMyDistrict(ctx context.Context, req *pb.GetMyDistrictRequest) (*pb.GetMyDistrictResponse, error) {
+	u, err := s.validateUser(ctx, constants.AllCanAccess)
+	if err != nil {
+		return nil, constants.UnauthorizedAccessError
+	}
+	handler := &daily.GetMyDistrictHandler{Model: s.Model}
+	resp, err := handler.GetMyDistrict(ctx, req, u)
+	if err != nil {
+		logger.WithContext(ctx).Error("GetMyDistrictHandler: "+err.Error(), zap.String("UserID", u.ID))
+		return nil, err
+	}
+	logger.WithContext(ctx).Info("GetMyDistrictHandler", zap.String("UserID", u.ID))
+	return resp, nil
+}
+
+func (s *Handlers) GetDistrictHistory(ctx context.Context, req *pb.GetDistrictHistoryRequest) (*pb.GetDistrictHistoryResponse, error) {
+	handler := &daily.GetDistrictHistoryHandler{Model: s.Model}
+	resp, err := handler.GetDistrictHistory(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).Error("GetDistrictHistoryHandler: "+err.Error(), zap.String("District", req.Id))
+		return nil, err
+	}
+	logger.WithContext(ctx).Info("GetDistrictHistoryHandler", zap.String("District", req.Id))
 	return resp, nil
 }
 
 // -------------------- Daily ------------------------
 
+// -------------------- Location ------------------------
+
+func (s *Handlers) GetHeatmap(ctx context.Context, req *pb.GetHeatmapRequest) (*pb.GetHeatmapResponse, error) {
+	u, err := s.validateUser(ctx, constants.AllCanAccess)
+	if err != nil {
+		return nil, constants.UnauthorizedAccessError
+	}
+	handler := &location.GetHeatmapHandler{Model: s.Model}
+	resp, err := handler.GetHeatmap(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).Error("GetHeatmapHandler: "+err.Error(), zap.String("UserID", u.ID))
+		return nil, err
+	}
+	logger.WithContext(ctx).Info("GetHeatmapHandler", zap.String("UserID", u.ID))
+	return resp, nil
+}
+
+// -------------------- Location ------------------------
+
+// -------------------- Job ------------------------
+
+func (s *Handlers) ListJobs(ctx context.Context, req *empty.Empty) (*pb.ListJobsResponse, error) {
+	u, err := s.validateUser(ctx, constants.AdminCanAccess)
+	if err != nil {
+		return nil, constants.UnauthorizedAccessError
+	}
+	handler := &job.ListJobsHandler{Scheduler: s.Scheduler}
+	resp, err := handler.ListJobs(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).Error("ListJobsHandler: "+err.Error(), zap.String("UserID", u.ID))
+		return nil, err
+	}
+	logger.WithContext(ctx).Info("ListJobsHandler", zap.String("UserID", u.ID))
+	return resp, nil
+}
+
+func (s *Handlers) TriggerJob(ctx context.Context, req *pb.TriggerJobRequest) (*pb.TriggerJobResponse, error) {
+	u, err := s.validateUser(ctx, constants.AdminCanAccess)
+	if err != nil {
+		return nil, constants.UnauthorizedAccessError
+	}
+	handler := &job.TriggerJobHandler{Scheduler: s.Scheduler}
+	resp, err := handler.TriggerJob(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).Error("TriggerJobHandler: "+err.Error(), zap.String("UserID", u.ID), zap.String("Job", req.Name))
+		return nil, err
+	}
+	logger.WithContext(ctx).Info("TriggerJobHandler", zap.String("UserID", u.ID), zap.String("Job", req.Name))
+	return resp, nil
+}
+
+// -------------------- Job ------------------------
+
+// -------------------- Audit ------------------------
+
+func (s *Handlers) GetAuditLog(ctx context.Context, req *pb.GetAuditLogRequest) (*pb.GetAuditLogResponse, error) {
+	u, err := s.validateUser(ctx, constants.AdminCanAccess)
+	if err != nil {
+		return nil, constants.UnauthorizedAccessError
+	}
+	handler := &audit.GetAuditLogHandler{Model: s.Model}
+	resp, err := handler.GetAuditLog(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).Error("GetAuditLogHandler: "+err.Error(), zap.String("UserID", u.ID))
+		return nil, err
+	}
+	logger.WithContext(ctx).Info("GetAuditLogHandler", zap.String("UserID", u.ID))
+	return resp, nil
+}
+
+// -------------------- Audit ------------------------
+
+// clientAddress gets address of gRPC peer, for REST gateway on loopback it is the address the gateway received request from
+func clientAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return host
+	}
+
+	// gateway appends remote address as last forwarded address, earlier ones are set by client
+	md, _ := metadata.FromIncomingContext(ctx)
+	forwarded := md.Get("x-forwarded-for")
+	if len(forwarded) == 0 {
+		return host
+	}
+	addrs := strings.Split(forwarded[len(forwarded)-1], ",")
+	return strings.TrimSpace(addrs[len(addrs)-1])
+}
+
 func (s *Handlers) validateUser(ctx context.Context, roles []string) (*dto.User, error) {
 	if os.Getenv("AUTH_ENABLED") != "true" {
 		return &dto.User{}, nil
@@ -368,4 +619,15 @@ func (s *Handlers) validateUser(ctx context.Context, roles []string) (*dto.User,
 	return u, nil
 }
 
+// ifMatch gets version expected by If-Match header, forwarded by gateway, or if-match metadata, 0 if not set.
+// It takes precedence over version in payload.
+func ifMatch(ctx context.Context) (int64, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	tags := append(md.Get("grpcgateway-if-match"), md.Get("if-match")...)
+	if len(tags) == 0 {
+		return 0, nil
+	}
+	return utility.ParseETag(tags[0])
+}
+
 // -------------------- Users ------------------------
@@ -19,6 +19,9 @@ type IHandlers interface {
 	UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error)
 	DeleteUsers(ctx context.Context, req *pb.DeleteUsersRequest) (*pb.DeleteUsersResponse, error)
 	UpdateUsers(ctx context.Context, req *pb.UpdateUsersRequest) (*pb.UpdateUsersResponse, error)
+	RestoreUser(ctx context.Context, req *pb.RestoreUserRequest) (*pb.RestoreUserResponse, error)
+	ExportMyData(ctx context.Context, req *pb.ExportMyDataRequest) (*pb.ExportMyDataResponse, error)
+	EraseMyAccount(ctx context.Context, req *pb.EraseMyAccountRequest) (*empty.Empty, error)
 	Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error)
 	Logout(ctx context.Context, req *empty.Empty) (*empty.Empty, error)
 	Refresh(ctx context.Context, req *empty.Empty) (*pb.RefreshResponse, error)
@@ -33,6 +36,7 @@ type IHandlers interface {
 	UpdateReport(ctx context.Context, req *pb.UpdateReportRequest) (*pb.UpdateReportResponse, error)
 	DeleteReports(ctx context.Context, req *pb.DeleteReportsRequest) (*pb.DeleteReportsResponse, error)
 	UpdateReports(ctx context.Context, req *pb.UpdateReportsRequest) (*pb.UpdateReportsResponse, error)
+	RestoreReport(ctx context.Context, req *pb.RestoreReportRequest) (*pb.RestoreReportResponse, error)
 	// -------------- Report ----------------
 
 	// -------------- Covid ----------------
@@ -41,9 +45,25 @@ type IHandlers interface {
 	// -------------- Covid ----------------
 
 	// -------------- Daily ----------------
+	ListDistricts(ctx context.Context, req *empty.Empty) (*pb.ListDistrictsResponse, error)
 	GetDistrict(ctx context.Context, req *pb.GetDistrictRequest) (*pb.GetDistrictResponse, error)
+	GetMyDistrict(ctx context.Context, req *pb.GetMyDistrictRequest) (*pb.GetMyDistrictResponse, error)
+	GetDistrictHistory(ctx context.Context, req *pb.GetDistrictHistoryRequest) (*pb.GetDistrictHistoryResponse, error)
 	// -------------- Daily ----------------
 
+	// -------------- Location ----------------
+	GetHeatmap(ctx context.Context, req *pb.GetHeatmapRequest) (*pb.GetHeatmapResponse, error)
+	// -------------- Location ----------------
+
+	// -------------- Job ----------------
+	ListJobs(ctx context.Context, req *empty.Empty) (*pb.ListJobsResponse, error)
+	TriggerJob(ctx context.Context, req *pb.TriggerJobRequest) (*pb.TriggerJobResponse, error)
+	// -------------- Job ----------------
+
+	// -------------- Audit ----------------
+	GetAuditLog(ctx context.Context, req *pb.GetAuditLogRequest) (*pb.GetAuditLogResponse, error)
+	// -------------- Audit ----------------
+
 	GetKases(ctx context.Context, req *empty.Empty) (*pb.GetKasesResponse, error)
 	GetRecentKases(ctx context.Context, req *empty.Empty) (*pb.GetRecentKasesResponse, error)
 }
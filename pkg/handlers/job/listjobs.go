@@ -0,0 +1,55 @@
+package job
+
+import (
+	"context"
+	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
+	"galasejahtera/pkg/logger"
+	"galasejahtera/pkg/scheduler"
+
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+type ListJobsHandler struct {
+	Scheduler scheduler.IScheduler
+}
+
+func (s *ListJobsHandler) ListJobs(ctx context.Context, req *empty.Empty) (*pb.ListJobsResponse, error) {
+	jobs, err := s.Scheduler.Jobs(ctx)
+	if err != nil {
+		logger.WithContext(ctx).Error("ListJobsHandler: " + err.Error())
+		return nil, errs.ToStatus(err, nil)
+	}
+
+	resp, err := s.jobsToResponse(jobs)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *ListJobsHandler) jobsToResponse(jobs []*dto.Job) (*pb.ListJobsResponse, error) {
+	var resps []*pb.Job
+	for _, job := range jobs {
+		resps = append(resps, jobToPb(job))
+	}
+
+	return &pb.ListJobsResponse{Data: resps}, nil
+}
+
+func jobToPb(job *dto.Job) *pb.Job {
+	return &pb.Job{
+		Name:         job.Name,
+		Spec:         job.Spec,
+		Timeout:      job.Timeout,
+		LastRun:      job.LastRun,
+		LastDuration: job.LastDuration,
+		LastError:    job.LastError,
+		NextRun:      job.NextRun,
+		Running:      job.Running,
+		Owner:        job.Owner,
+		RunCount:     job.RunCount,
+	}
+}
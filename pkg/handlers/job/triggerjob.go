@@ -0,0 +1,36 @@
+package job
+
+import (
+	"context"
+	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
+	"galasejahtera/pkg/scheduler"
+)
+
+type TriggerJobHandler struct {
+	Scheduler scheduler.IScheduler
+}
+
+func (s *TriggerJobHandler) TriggerJob(ctx context.Context, req *pb.TriggerJobRequest) (*pb.TriggerJobResponse, error) {
+	if req.Name == "" {
+		return nil, constants.InvalidArgumentError
+	}
+
+	job, err := s.Scheduler.Trigger(ctx, req.Name)
+	if err != nil {
+		return nil, errs.ToStatus(err, constants.JobNotFoundError)
+	}
+
+	resp, err := s.jobToResponse(job)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *TriggerJobHandler) jobToResponse(job *dto.Job) (*pb.TriggerJobResponse, error) {
+	return &pb.TriggerJobResponse{Data: jobToPb(job)}, nil
+}
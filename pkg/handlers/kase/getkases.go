@@ -14,7 +14,7 @@ type GetKasesHandler struct {
 }
 
 func (s *GetKasesHandler) GetKases(ctx context.Context, req *empty.Empty) (*pb.GetKasesResponse, error) {
-	general := utility.CrawlGeneral()
+	general := utility.CrawlGeneral(ctx)
 	resp, err := s.kasesToResponses(general)
 	if err != nil {
 		return nil, err
@@ -20,7 +20,7 @@ func (s *GetRecentKasesHandler) GetRecentKases(ctx context.Context, req *empty.E
 	t2 := utility.TimeToDateStringWithDash(now)
 	t1 := utility.TimeToDateStringWithDash(now.Add(-24 * 8 * time.Hour))
 
-	kases := utility.CrawlCasesByDate(t1, t2)
+	kases := utility.CrawlCasesByDate(ctx, t1, t2)
 	resp, err := s.kasesToResponses(kases)
 	if err != nil {
 		return nil, err
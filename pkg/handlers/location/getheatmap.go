@@ -0,0 +1,82 @@
+package location
+
+import (
+	"context"
+	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
+	"galasejahtera/pkg/logger"
+	"galasejahtera/pkg/model"
+	"galasejahtera/pkg/utility"
+	"time"
+)
+
+type GetHeatmapHandler struct {
+	Model model.IModel
+}
+
+func (s *GetHeatmapHandler) GetHeatmap(ctx context.Context, req *pb.GetHeatmapRequest) (*pb.GetHeatmapResponse, error) {
+	// bounding box crossing the antimeridian is not supported
+	if req.MinLat < -90 || req.MaxLat > 90 || req.MinLong < -180 || req.MaxLong > 180 ||
+		req.MinLat >= req.MaxLat || req.MinLong >= req.MaxLong {
+		return nil, constants.InvalidArgumentError
+	}
+
+	// get time range, default to last 24 hours
+	endTime := req.EndTime
+	if endTime == 0 {
+		endTime = utility.TimeToMilli(utility.MalaysiaTime(time.Now()))
+	}
+	startTime := req.StartTime
+	if startTime == 0 {
+		startTime = utility.TimeToMilli(utility.MilliToTime(endTime).Add(-24 * time.Hour))
+	}
+	if startTime > endTime {
+		return nil, constants.InvalidArgumentError
+	}
+
+	// get precision, capped to keep cells coarse
+	precision := int(req.Precision)
+	if precision < 0 {
+		return nil, constants.InvalidArgumentError
+	}
+	if precision == 0 {
+		precision = constants.HeatmapPrecision
+	}
+	if precision > constants.MaxHeatmapPrecision {
+		precision = constants.MaxHeatmapPrecision
+	}
+
+	cells, err := s.Model.GetHeatmap(ctx, &dto.BoundingBox{
+		MinLat:  req.MinLat,
+		MinLong: req.MinLong,
+		MaxLat:  req.MaxLat,
+		MaxLong: req.MaxLong,
+	}, startTime, endTime, precision)
+	if err != nil {
+		logger.WithContext(ctx).Error("GetHeatmapHandler: " + err.Error())
+		return nil, errs.ToStatus(err, nil)
+	}
+
+	resp, err := s.cellsToResponse(cells)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *GetHeatmapHandler) cellsToResponse(cells []*dto.HeatmapCell) (*pb.GetHeatmapResponse, error) {
+	var data []*pb.HeatmapCell
+	for _, cell := range cells {
+		data = append(data, &pb.HeatmapCell{
+			Geohash: cell.Geohash,
+			Lat:     cell.Lat,
+			Long:    cell.Long,
+			Count:   cell.Count,
+		})
+	}
+
+	return &pb.GetHeatmapResponse{Data: data}, nil
+}
@@ -5,11 +5,10 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/model"
 	"galasejahtera/pkg/utility"
 	"github.com/twinj/uuid"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"time"
 )
 
@@ -31,10 +30,7 @@ func (s *CreateReportHandler) CreateReport(ctx context.Context, req *pb.CreateRe
 
 	rslt, err := s.Model.CreateReport(ctx, report)
 	if err != nil {
-		if status.Code(err) == codes.AlreadyExists {
-			return nil, constants.ReportAlreadyExistError
-		}
-		return nil, constants.InternalError
+		return nil, errs.ToStatus(err, nil)
 	}
 	resp := s.reportToResp(rslt)
 	return resp, nil
@@ -48,6 +44,7 @@ func (s *CreateReportHandler) reportToResp(report *dto.Report) *pb.CreateReportR
 			HasSymptom: report.HasSymptom,
 			UserId:     report.UserID,
 			Results:    report.Results,
+			Version:    report.Version,
 		},
 	}
 }
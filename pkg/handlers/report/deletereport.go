@@ -5,23 +5,18 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/model"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type DeleteReportHandler struct {
 	Model model.IModel
 }
 
-func (s *DeleteReportHandler) DeleteReport(ctx context.Context, req *pb.DeleteReportRequest) (*pb.DeleteReportResponse, error) {
-	rslt, err := s.Model.DeleteReport(ctx, req.Id)
+func (s *DeleteReportHandler) DeleteReport(ctx context.Context, req *pb.DeleteReportRequest, caller *dto.User) (*pb.DeleteReportResponse, error) {
+	rslt, err := s.Model.DeleteReport(ctx, req.Id, caller)
 	if err != nil {
-		if status.Code(err) == codes.Unknown {
-			return nil, constants.ReportNotFoundError
-		}
-		return nil, constants.InternalError
+		return nil, errs.ToStatus(err, constants.ReportNotFoundError)
 	}
 
 	resp := s.reportToResp(rslt)
@@ -36,6 +31,7 @@ func (s *DeleteReportHandler) reportToResp(report *dto.Report) *pb.DeleteReportR
 			HasSymptom: report.HasSymptom,
 			UserId:     report.UserID,
 			Results:    report.Results,
+			Version:    report.Version,
 		},
 	}
 	return resp
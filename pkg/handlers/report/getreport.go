@@ -5,11 +5,9 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/model"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type GetReportHandler struct {
@@ -19,11 +17,8 @@ type GetReportHandler struct {
 func (s *GetReportHandler) GetReport(ctx context.Context, req *pb.GetReportRequest) (*pb.GetReportResponse, error) {
 	report, err := s.Model.GetReport(ctx, req.Id)
 	if err != nil {
-		logger.Log.Error("GetReportHandler: " + err.Error())
-		if status.Code(err) == codes.Unknown {
-			return nil, constants.ReportNotFoundError
-		}
-		return nil, constants.InternalError
+		logger.WithContext(ctx).Error("GetReportHandler: " + err.Error())
+		return nil, errs.ToStatus(err, constants.ReportNotFoundError)
 	}
 
 	resp, err := s.reportToResponse(report)
@@ -42,6 +37,7 @@ func (s *GetReportHandler) reportToResponse(report *dto.Report) (*pb.GetReportRe
 			HasSymptom: report.HasSymptom,
 			UserId:     report.UserID,
 			Results:    report.Results,
+			Version:    report.Version,
 		},
 	}
 
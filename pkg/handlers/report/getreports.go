@@ -5,10 +5,10 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/model"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"galasejahtera/pkg/utility"
 )
 
 type GetReportsHandler struct {
@@ -16,18 +16,11 @@ type GetReportsHandler struct {
 }
 
 func (s *GetReportsHandler) GetReports(ctx context.Context, req *pb.GetReportsRequest) (*pb.GetReportsResponse, error) {
-	var sort *dto.SortData
-	var itemsRange *dto.RangeData
-	var filter *dto.FilterData
-
 	// If the request is batch get, call batch get model
 	if len(req.Ids) > 0 {
 		reports, err := s.Model.BatchGetReports(ctx, req.Ids)
 		if err != nil {
-			if status.Code(err) == codes.Unknown {
-				return nil, constants.ReportNotFoundError
-			}
-			return nil, constants.InternalError
+			return nil, errs.ToStatus(err, constants.ReportNotFoundError)
 		}
 		resp, err := s.reportsToResponses(reports)
 		if err != nil {
@@ -37,34 +30,52 @@ func (s *GetReportsHandler) GetReports(ctx context.Context, req *pb.GetReportsRe
 		return resp, nil
 	}
 
+	// legacy sort and filter are applied after sorts and filters
+	query, err := utility.ParseQuery(req.Filters, req.Sorts)
+	if err != nil {
+		return nil, err
+	}
+
 	if req.Item != "" && req.Order != "" {
-		sort = &dto.SortData{
+		query.Sorts = append(query.Sorts, &dto.SortData{
 			Item:  req.Item,
 			Order: req.Order,
-		}
+		})
 	}
 
-	if req.To != 0 {
-		itemsRange = &dto.RangeData{
+	// page token takes precedence over from and to
+	if req.PageSize != 0 || req.PageToken != "" {
+		if req.PageSize < 0 {
+			return nil, constants.InvalidArgumentError
+		}
+		query.Page = &dto.PageData{
+			Size:  int(req.PageSize),
+			Token: req.PageToken,
+		}
+		query.NoTotal = !req.IncludeTotal
+	} else if req.To != 0 {
+		query.Range = &dto.RangeData{
 			From: int(req.From),
 			To:   int(req.To),
 		}
 	}
 
 	if req.FilterItem != "" && req.FilterValue != "" {
-		filter = &dto.FilterData{
-			Item:  req.FilterItem,
-			Value: req.FilterValue,
+		if req.FilterItem == constants.Search {
+			query.Search = req.FilterValue
+		} else {
+			query.Filters = append(query.Filters, &dto.FilterData{
+				Item:     req.FilterItem,
+				Operator: constants.EQ,
+				Value:    req.FilterValue,
+			})
 		}
 	}
 
-	total, reports, err := s.Model.QueryReports(ctx, sort, itemsRange, filter)
+	total, reports, next, err := s.Model.QueryReports(ctx, query)
 	if err != nil {
-		logger.Log.Error("GetReportsHandler: " + err.Error())
-		if status.Code(err) == codes.Unknown {
-			return nil, constants.ReportNotFoundError
-		}
-		return nil, constants.InternalError
+		logger.WithContext(ctx).Error("GetReportsHandler: " + err.Error())
+		return nil, errs.ToStatus(err, constants.ReportNotFoundError)
 	}
 
 	resp, err := s.reportsToResponses(reports)
@@ -73,6 +84,7 @@ func (s *GetReportsHandler) GetReports(ctx context.Context, req *pb.GetReportsRe
 	}
 
 	resp.Total = total
+	resp.NextPageToken = next
 	return resp, nil
 }
 
@@ -85,6 +97,7 @@ func (s *GetReportsHandler) reportsToResponses(reports []*dto.Report) (*pb.GetRe
 			HasSymptom: report.HasSymptom,
 			UserId:     report.UserID,
 			Results:    report.Results,
+			Version:    report.Version,
 		}
 
 		resps = append(resps, resp)
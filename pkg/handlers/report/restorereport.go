@@ -0,0 +1,42 @@
+package report
+
+import (
+	"context"
+	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
+	"galasejahtera/pkg/model"
+)
+
+type RestoreReportHandler struct {
+	Model model.IModel
+}
+
+func (s *RestoreReportHandler) RestoreReport(ctx context.Context, req *pb.RestoreReportRequest, caller *dto.User) (*pb.RestoreReportResponse, error) {
+	if req.Id == "" {
+		return nil, constants.InvalidArgumentError
+	}
+
+	rslt, err := s.Model.RestoreReport(ctx, req.Id, caller)
+	if err != nil {
+		return nil, errs.ToStatus(err, constants.ReportNotFoundError)
+	}
+
+	resp := s.reportToResp(rslt)
+	return resp, nil
+}
+
+func (s *RestoreReportHandler) reportToResp(report *dto.Report) *pb.RestoreReportResponse {
+	resp := &pb.RestoreReportResponse{
+		Data: &pb.Report{
+			Id:         report.ID,
+			CreatedAt:  report.CreatedAt,
+			HasSymptom: report.HasSymptom,
+			UserId:     report.UserID,
+			Results:    report.Results,
+			Version:    report.Version,
+		},
+	}
+	return resp
+}
@@ -5,27 +5,28 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/model"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type UpdateReportHandler struct {
 	Model model.IModel
 }
 
-func (s *UpdateReportHandler) UpdateReport(ctx context.Context, req *pb.UpdateReportRequest) (*pb.UpdateReportResponse, error) {
+func (s *UpdateReportHandler) UpdateReport(ctx context.Context, req *pb.UpdateReportRequest, caller *dto.User) (*pb.UpdateReportResponse, error) {
 	if req.Data == nil {
 		return nil, constants.InvalidArgumentError
 	}
 	report := s.reqToReport(req)
 
-	v, err := s.Model.UpdateReport(ctx, report)
+	// caller is unknown when auth is disabled
+	if caller != nil && caller.ID == "" {
+		caller = nil
+	}
+
+	v, err := s.Model.UpdateReport(ctx, report, req.UpdateMask.GetPaths(), caller)
 	if err != nil {
-		if status.Code(err) == codes.Unknown {
-			return nil, constants.ReportNotFoundError
-		}
-		return nil, constants.InternalError
+		return nil, errs.ToStatus(err, constants.ReportNotFoundError)
 	}
 	resp := s.reportToResp(v)
 	return resp, nil
@@ -35,6 +36,7 @@ func (s *UpdateReportHandler) reqToReport(req *pb.UpdateReportRequest) *dto.Repo
 	report := &dto.Report{
 		ID:         req.Id,
 		HasSymptom: req.Data.HasSymptom,
+		Version:    req.Data.Version,
 	}
 	return report
 }
@@ -47,6 +49,7 @@ func (s *UpdateReportHandler) reportToResp(report *dto.Report) *pb.UpdateReportR
 			HasSymptom: report.HasSymptom,
 			UserId:     report.UserID,
 			Results:    report.Results,
+			Version:    report.Version,
 		},
 	}
 	return resp
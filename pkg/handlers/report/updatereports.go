@@ -5,9 +5,8 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/model"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type UpdateReportsHandler struct {
@@ -18,25 +17,14 @@ func (s *UpdateReportsHandler) UpdateReports(ctx context.Context, req *pb.Update
 	if req.Data == nil {
 		return nil, constants.InvalidArgumentError
 	}
-	if len(req.Ids) > 1 {
-		return nil, constants.OperationUnsupportedError
-	}
 	report := s.reqToReport(req)
 
-	// get report
-	_, err := s.Model.GetReport(ctx, req.Ids[0])
-	if err != nil {
-		return nil, err
-	}
-
-	ids, err := s.Model.UpdateReports(ctx, report, req.Ids)
+	results, err := s.Model.UpdateReports(ctx, report, req.Ids, req.Versions)
 	if err != nil {
-		if status.Code(err) == codes.Unknown {
-			return nil, constants.ReportNotFoundError
-		}
-		return nil, constants.InternalError
+		return nil, errs.ToStatus(err, constants.ReportNotFoundError)
 	}
-	return &pb.UpdateReportsResponse{Data: ids}, nil
+	ids, resp := errs.ToBatchResults(results)
+	return &pb.UpdateReportsResponse{Data: ids, Results: resp}, nil
 }
 
 func (s *UpdateReportsHandler) reqToReport(req *pb.UpdateReportsRequest) *dto.Report {
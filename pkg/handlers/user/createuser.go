@@ -5,6 +5,7 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/model"
 	"galasejahtera/pkg/utility"
@@ -36,19 +37,11 @@ func (s *CreateUserHandler) CreateUser(ctx context.Context, req *pb.CreateUserRe
 		return nil, err
 	}
 
-	// check if email exist
-	count, _, err := s.Model.QueryUsers(ctx, nil, nil, &dto.FilterData{
-		Item:  constants.Email,
-		Value: user.Email,
-	})
-	if count > 0 {
-		return nil, constants.EmailAlreadyExistError
-	}
-
+	// existing email is rejected by unique index
 	rslt, err := s.Model.CreateUser(ctx, user)
 	if err != nil {
-		logger.Log.Error("CreateUser: " + err.Error())
-		return nil, constants.InternalError
+		logger.WithContext(ctx).Error("CreateUser: " + err.Error())
+		return nil, errs.ToStatus(err, nil)
 	}
 	resp := s.userToResp(rslt)
 	return resp, nil
@@ -83,6 +76,7 @@ func (s *CreateUserHandler) userToResp(user *dto.User) *pb.CreateUserResponse {
 			Long:        user.Long,
 			IsActive:    user.IsActive,
 			Name:        user.Name,
+			Version:     user.Version,
 		},
 	}
 }
@@ -5,23 +5,18 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/model"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type DeleteUserHandler struct {
 	Model model.IModel
 }
 
-func (s *DeleteUserHandler) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
-	rslt, err := s.Model.DeleteUser(ctx, req.Id)
+func (s *DeleteUserHandler) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest, caller *dto.User) (*pb.DeleteUserResponse, error) {
+	rslt, err := s.Model.DeleteUser(ctx, req.Id, caller)
 	if err != nil {
-		if status.Code(err) == codes.Unknown {
-			return nil, constants.UserNotFoundError
-		}
-		return nil, constants.InternalError
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
 	}
 
 	resp := s.userToResp(rslt)
@@ -39,6 +34,7 @@ func (s *DeleteUserHandler) userToResp(user *dto.User) *pb.DeleteUserResponse {
 			Long:        user.Long,
 			IsActive:    user.IsActive,
 			Name:        user.Name,
+			Version:     user.Version,
 		},
 	}
 	return resp
@@ -4,32 +4,22 @@ import (
 	"context"
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/model"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type DeleteUsersHandler struct {
 	Model model.IModel
 }
 
-func (s *DeleteUsersHandler) DeleteUsers(ctx context.Context, req *pb.DeleteUsersRequest) (*pb.DeleteUsersResponse, error) {
-	var ids []string
-
-	// remove users
-	for _, id := range req.Ids {
-		u, err := s.Model.DeleteUser(ctx, id)
-		if err != nil {
-			if status.Code(err) == codes.Unknown {
-				return nil, constants.UserNotFoundError
-			}
-			return nil, constants.InternalError
-		}
-
-		// add user into deleted user IDs
-		ids = append(ids, u.ID)
+func (s *DeleteUsersHandler) DeleteUsers(ctx context.Context, req *pb.DeleteUsersRequest, caller *dto.User) (*pb.DeleteUsersResponse, error) {
+	// remove users, missing users are reported per ID
+	results, err := s.Model.DeleteUsers(ctx, req.Ids, caller)
+	if err != nil {
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
 	}
 
-	return &pb.DeleteUsersResponse{Data: ids}, nil
+	ids, resp := errs.ToBatchResults(results)
+	return &pb.DeleteUsersResponse{Data: ids, Results: resp}, nil
 }
@@ -0,0 +1,29 @@
+package user
+
+import (
+	"context"
+	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
+	"galasejahtera/pkg/model"
+
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+type EraseMyAccountHandler struct {
+	Model model.IModel
+}
+
+func (s *EraseMyAccountHandler) EraseMyAccount(ctx context.Context, req *pb.EraseMyAccountRequest, caller *dto.User) (*empty.Empty, error) {
+	// only authenticated caller can erase its account, there is none when auth is disabled
+	if caller == nil || caller.ID == "" {
+		return nil, constants.UnauthorizedAccessError
+	}
+
+	err := s.Model.EraseUser(ctx, caller.ID)
+	if err != nil {
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
+	}
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,77 @@
+package user
+
+import (
+	"context"
+	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
+	"galasejahtera/pkg/model"
+	"galasejahtera/pkg/utility"
+)
+
+type ExportMyDataHandler struct {
+	Model model.IModel
+}
+
+func (s *ExportMyDataHandler) ExportMyData(ctx context.Context, req *pb.ExportMyDataRequest, caller *dto.User) (*pb.ExportMyDataResponse, error) {
+	// data is only exported to authenticated caller, there is none when auth is disabled
+	if caller == nil || caller.ID == "" {
+		return nil, constants.UnauthorizedAccessError
+	}
+
+	rslt, err := s.Model.ExportUserData(ctx, caller.ID)
+	if err != nil {
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
+	}
+
+	resp := s.userDataToResp(rslt)
+	return resp, nil
+}
+
+func (s *ExportMyDataHandler) userDataToResp(data *dto.UserData) *pb.ExportMyDataResponse {
+	resp := &pb.ExportMyDataResponse{
+		ExportedAt: data.ExportedAt,
+		User: &pb.User{
+			Id:          data.User.ID,
+			Role:        data.User.Role,
+			Email:       data.User.Email,
+			LastUpdated: data.User.LastUpdated,
+			Lat:         data.User.Lat,
+			Long:        data.User.Long,
+			IsActive:    data.User.IsActive,
+			Name:        data.User.Name,
+			Version:     data.User.Version,
+		},
+	}
+	for _, report := range data.Reports {
+		resp.Reports = append(resp.Reports, &pb.Report{
+			Id:         report.ID,
+			CreatedAt:  report.CreatedAt,
+			HasSymptom: report.HasSymptom,
+			UserId:     report.UserID,
+			Results:    report.Results,
+			Version:    report.Version,
+			DeletedAt:  report.DeletedAt,
+		})
+	}
+	// token values are credentials and left out
+	for _, session := range data.Sessions {
+		resp.Sessions = append(resp.Sessions, &pb.Session{
+			Type:      session.Type,
+			ExpiresAt: utility.TimeToMilli(session.TTL),
+		})
+	}
+	for _, location := range data.Locations {
+		l := &pb.LocationHistory{
+			Geohash:   location.Geohash,
+			CreatedAt: location.CreatedAt,
+		}
+		if location.Location != nil && len(location.Location.Coordinates) == 2 {
+			l.Long = location.Location.Coordinates[0]
+			l.Lat = location.Location.Coordinates[1]
+		}
+		resp.Locations = append(resp.Locations, l)
+	}
+	return resp
+}
@@ -5,6 +5,7 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/model"
 	"galasejahtera/pkg/utility"
@@ -15,14 +16,20 @@ type GetNearbyUsersHandler struct {
 	Model model.IModel
 }
 
-func (s *GetNearbyUsersHandler) GetNearbyUsers(ctx context.Context, req *pb.GetNearbyUsersRequest) (*pb.GetNearbyUsersResponse, error) {
-	if req.User == nil {
+func (s *GetNearbyUsersHandler) GetNearbyUsers(ctx context.Context, req *pb.GetNearbyUsersRequest, caller *dto.User) (*pb.GetNearbyUsersResponse, error) {
+	if req.User == nil || req.Radius < 0 || req.MaxAge < 0 {
 		return nil, constants.InvalidArgumentError
 	}
 
-	u, err := s.Model.GetUser(ctx, req.User.Id)
+	// authenticated caller takes precedence over request user id
+	id := req.User.Id
+	if caller != nil && caller.ID != "" {
+		id = caller.ID
+	}
+
+	u, err := s.Model.GetUser(ctx, id)
 	if err != nil {
-		return nil, err
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
 	}
 
 	// patch user
@@ -35,14 +42,17 @@ func (s *GetNearbyUsersHandler) GetNearbyUsers(ctx context.Context, req *pb.GetN
 		Coordinates: []float64{req.User.Long, req.User.Lat},
 	}
 
-	// get users
-	total, users, err := s.Model.GetNearbyUsers(ctx, u)
+	// get users grouped by cell, exact locations are not returned
+	total, cells, err := s.Model.GetNearbyCells(ctx, u, &dto.NearbyQuery{
+		Radius: req.Radius,
+		MaxAge: req.MaxAge,
+	})
 	if err != nil {
-		logger.Log.Error("GetNearbyUsersHandler: " + err.Error())
-		return nil, err
+		logger.WithContext(ctx).Error("GetNearbyUsersHandler: " + err.Error())
+		return nil, errs.ToStatus(err, nil)
 	}
 
-	resp, err := s.usersToResponses(users)
+	resp, err := s.cellsToResponse(cells)
 	if err != nil {
 		return nil, err
 	}
@@ -52,18 +62,20 @@ func (s *GetNearbyUsersHandler) GetNearbyUsers(ctx context.Context, req *pb.GetN
 	return resp, nil
 }
 
-func (s *GetNearbyUsersHandler) usersToResponses(users []*dto.User) (*pb.GetNearbyUsersResponse, error) {
-	var resps []*pb.User
-	for _, user := range users {
-		resp := &pb.User{
-			Lat:  user.Lat,
-			Long: user.Long,
-		}
-
-		resps = append(resps, resp)
+func (s *GetNearbyUsersHandler) cellsToResponse(cells []*dto.NearbyCell) (*pb.GetNearbyUsersResponse, error) {
+	var resps []*pb.NearbyCell
+	for _, cell := range cells {
+		resps = append(resps, &pb.NearbyCell{
+			Geohash:   cell.Geohash,
+			Lat:       cell.Lat,
+			Long:      cell.Long,
+			Count:     cell.Count,
+			Direction: cell.Direction,
+			Distance:  cell.Distance,
+		})
 	}
 	rslt := &pb.GetNearbyUsersResponse{
-		Users: resps,
+		Cells: resps,
 	}
 
 	return rslt, nil
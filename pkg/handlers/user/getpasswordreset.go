@@ -5,7 +5,7 @@ import (
 	"fmt"
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
-	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/model"
 	"galasejahtera/pkg/utility"
@@ -18,17 +18,10 @@ type GetPasswordResetHandler struct {
 
 func (s *GetPasswordResetHandler) GetPasswordReset(ctx context.Context, req *pb.GetPasswordResetRequest) (*pb.GetPasswordResetResponse, error) {
 	// get user
-	_, us, err := s.Model.QueryUsers(ctx, nil, nil, &dto.FilterData{
-		Item:  constants.Email,
-		Value: req.Email,
-	})
+	user, err := s.Model.GetUserByEmail(ctx, req.Email)
 	if err != nil {
-		return nil, err
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
 	}
-	if len(us) < 1 {
-		return nil, constants.UserNotFoundError
-	}
-	user := us[0]
 
 	// generate random password
 	user.Password = utility.NormalizeContent(uuid.NewV4().String())
@@ -36,14 +29,14 @@ func (s *GetPasswordResetHandler) GetPasswordReset(ctx context.Context, req *pb.
 	// update user password
 	_, err = s.Model.UpdateUserPassword(ctx, user)
 	if err != nil {
-		return nil, err
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
 	}
 
 	// send password reset email
 	err = utility.SendPasswordResetEmail(user.Email, user.Email, user.Password)
 	if err != nil {
-		logger.Log.Warn("GetPasswordReset: " + err.Error())
-		return nil, err
+		logger.WithContext(ctx).Warn("GetPasswordReset: " + err.Error())
+		return nil, errs.ToStatus(err, nil)
 	}
 
 	return &pb.GetPasswordResetResponse{
@@ -5,11 +5,9 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/model"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type GetUserHandler struct {
@@ -19,11 +17,8 @@ type GetUserHandler struct {
 func (s *GetUserHandler) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
 	user, err := s.Model.GetUser(ctx, req.Id)
 	if err != nil {
-		logger.Log.Error("GetUserHandler: " + err.Error())
-		if status.Code(err) == codes.Unknown {
-			return nil, constants.UserNotFoundError
-		}
-		return nil, constants.InternalError
+		logger.WithContext(ctx).Error("GetUserHandler: " + err.Error())
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
 	}
 
 	resp, err := s.userToResponse(user)
@@ -45,6 +40,7 @@ func (s *GetUserHandler) userToResponse(user *dto.User) (*pb.GetUserResponse, er
 			Lat:         user.Lat,
 			Long:        user.Long,
 			Name:        user.Name,
+			Version:     user.Version,
 		},
 	}
 
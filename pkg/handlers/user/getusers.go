@@ -5,11 +5,10 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/model"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"galasejahtera/pkg/utility"
 )
 
 type GetUsersHandler struct {
@@ -17,18 +16,11 @@ type GetUsersHandler struct {
 }
 
 func (s *GetUsersHandler) GetUsers(ctx context.Context, req *pb.GetUsersRequest) (*pb.GetUsersResponse, error) {
-	var sort *dto.SortData
-	var itemsRange *dto.RangeData
-	var filter *dto.FilterData
-
 	// If the request is batch get, call batch get model
 	if len(req.Ids) > 0 {
 		users, err := s.Model.BatchGetUsers(ctx, req.Ids)
 		if err != nil {
-			if status.Code(err) == codes.Unknown {
-				return nil, constants.UserNotFoundError
-			}
-			return nil, constants.InternalError
+			return nil, errs.ToStatus(err, constants.UserNotFoundError)
 		}
 		resp, err := s.usersToResponses(users)
 		if err != nil {
@@ -38,34 +30,52 @@ func (s *GetUsersHandler) GetUsers(ctx context.Context, req *pb.GetUsersRequest)
 		return resp, nil
 	}
 
+	// legacy sort and filter are applied after sorts and filters
+	query, err := utility.ParseQuery(req.Filters, req.Sorts)
+	if err != nil {
+		return nil, err
+	}
+
 	if req.Item != "" && req.Order != "" {
-		sort = &dto.SortData{
+		query.Sorts = append(query.Sorts, &dto.SortData{
 			Item:  req.Item,
 			Order: req.Order,
-		}
+		})
 	}
 
-	if req.To != 0 {
-		itemsRange = &dto.RangeData{
+	// page token takes precedence over from and to
+	if req.PageSize != 0 || req.PageToken != "" {
+		if req.PageSize < 0 {
+			return nil, constants.InvalidArgumentError
+		}
+		query.Page = &dto.PageData{
+			Size:  int(req.PageSize),
+			Token: req.PageToken,
+		}
+		query.NoTotal = !req.IncludeTotal
+	} else if req.To != 0 {
+		query.Range = &dto.RangeData{
 			From: int(req.From),
 			To:   int(req.To),
 		}
 	}
 
 	if req.FilterItem != "" && req.FilterValue != "" {
-		filter = &dto.FilterData{
-			Item:  req.FilterItem,
-			Value: req.FilterValue,
+		if req.FilterItem == constants.Search {
+			query.Search = req.FilterValue
+		} else {
+			query.Filters = append(query.Filters, &dto.FilterData{
+				Item:     req.FilterItem,
+				Operator: constants.EQ,
+				Value:    req.FilterValue,
+			})
 		}
 	}
 
-	total, users, err := s.Model.QueryUsers(ctx, sort, itemsRange, filter)
+	total, users, next, err := s.Model.QueryUsers(ctx, query)
 	if err != nil {
-		logger.Log.Error("GetUsersHandler: " + err.Error())
-		if status.Code(err) == codes.Unknown {
-			return nil, constants.UserNotFoundError
-		}
-		return nil, constants.InternalError
+		logger.WithContext(ctx).Error("GetUsersHandler: " + err.Error())
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
 	}
 
 	resp, err := s.usersToResponses(users)
@@ -74,6 +84,7 @@ func (s *GetUsersHandler) GetUsers(ctx context.Context, req *pb.GetUsersRequest)
 	}
 
 	resp.Total = total
+	resp.NextPageToken = next
 	return resp, nil
 }
 
@@ -89,6 +100,7 @@ func (s *GetUsersHandler) usersToResponses(users []*dto.User) (*pb.GetUsersRespo
 			Lat:         user.Lat,
 			Long:        user.Long,
 			Name:        user.Name,
+			Version:     user.Version,
 		}
 
 		resps = append(resps, resp)
@@ -3,7 +3,9 @@ package user
 import (
 	"context"
 	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/model"
 )
@@ -15,8 +17,8 @@ type LoginHandler struct {
 func (s *LoginHandler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
 	user, err := s.Model.Login(ctx, req.Email, req.Password)
 	if err != nil {
-		logger.Log.Error("Login: " + err.Error())
-		return nil, err
+		logger.WithContext(ctx).Error("Login: " + err.Error())
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
 	}
 	resp := s.userToResp(user)
 	return resp, nil
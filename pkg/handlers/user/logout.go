@@ -23,7 +23,7 @@ func (s *LogoutHandler) Logout(ctx context.Context) (*empty.Empty, error) {
 	tokenSlice := md.Get("authorization")
 	err := s.Model.Logout(ctx, strings.Join(tokenSlice, " "))
 	if err != nil {
-		logger.Log.Error("Logout: " + err.Error())
+		logger.WithContext(ctx).Error("Logout: " + err.Error())
 	}
 	return &empty.Empty{}, nil
 }
@@ -5,6 +5,7 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/model"
 	"strings"
@@ -24,8 +25,8 @@ func (s *RefreshHandler) Refresh(ctx context.Context) (*pb.RefreshResponse, erro
 	tokenSlice := md.Get("authorization")
 	user, err := s.Model.Refresh(ctx, strings.Join(tokenSlice, " "))
 	if err != nil {
-		logger.Log.Error("Refresh: " + err.Error())
-		return nil, err
+		logger.WithContext(ctx).Error("Refresh: " + err.Error())
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
 	}
 
 	resp := s.userToResp(user)
@@ -0,0 +1,45 @@
+package user
+
+import (
+	"context"
+	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
+	"galasejahtera/pkg/model"
+)
+
+type RestoreUserHandler struct {
+	Model model.IModel
+}
+
+func (s *RestoreUserHandler) RestoreUser(ctx context.Context, req *pb.RestoreUserRequest, caller *dto.User) (*pb.RestoreUserResponse, error) {
+	if req.Id == "" {
+		return nil, constants.InvalidArgumentError
+	}
+
+	rslt, err := s.Model.RestoreUser(ctx, req.Id, caller)
+	if err != nil {
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
+	}
+
+	resp := s.userToResp(rslt)
+	return resp, nil
+}
+
+func (s *RestoreUserHandler) userToResp(user *dto.User) *pb.RestoreUserResponse {
+	resp := &pb.RestoreUserResponse{
+		Data: &pb.User{
+			Id:          user.ID,
+			Role:        user.Role,
+			Email:       user.Email,
+			LastUpdated: user.LastUpdated,
+			Lat:         user.Lat,
+			Long:        user.Long,
+			IsActive:    user.IsActive,
+			Name:        user.Name,
+			Version:     user.Version,
+		},
+	}
+	return resp
+}
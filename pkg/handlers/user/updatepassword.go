@@ -4,6 +4,7 @@ import (
 	"context"
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/model"
 
@@ -18,16 +19,16 @@ func (s *UpdatePasswordHandler) UpdatePassword(ctx context.Context, req *pb.Upda
 	// get user
 	user, err := s.Model.GetUser(ctx, req.UserId)
 	if err != nil {
-		logger.Log.Error("UpdatePassword: " + err.Error())
-		return nil, constants.UserNotFoundError
+		logger.WithContext(ctx).Error("UpdatePassword: " + err.Error())
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
 	}
 	user.Password = req.Password
 
 	// update user password
 	_, err = s.Model.UpdateUserPassword(ctx, user)
 	if err != nil {
-		logger.Log.Error("UpdatePassword: " + err.Error())
-		return nil, constants.InternalError
+		logger.WithContext(ctx).Error("UpdatePassword: " + err.Error())
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
 	}
 
 	return &empty.Empty{}, nil
@@ -5,6 +5,7 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/model"
 	"galasejahtera/pkg/utility"
@@ -14,40 +15,28 @@ type UpdateUserHandler struct {
 	Model model.IModel
 }
 
-func (s *UpdateUserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
+func (s *UpdateUserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest, caller *dto.User) (*pb.UpdateUserResponse, error) {
 	if req.Data == nil {
 		return nil, constants.InvalidArgumentError
 	}
 	user := s.reqToUser(req)
+	mask := req.UpdateMask.GetPaths()
 
-	err := s.validateAndProcessReq(user)
+	err := s.validateAndProcessReq(user, mask)
 	if err != nil {
 		return nil, err
 	}
 
-	u, err := s.Model.GetUser(ctx, req.Id)
-	if err != nil {
-		return nil, err
-	}
-
-	if u.Email != user.Email {
-		// check if email exist
-		count, _, err := s.Model.QueryUsers(ctx, nil, nil, &dto.FilterData{
-			Item:  constants.Email,
-			Value: user.Email,
-		})
-		if err != nil {
-			return nil, err
-		}
-		if count > 0 {
-			return nil, constants.EmailAlreadyExistError
-		}
+	// caller is unknown when auth is disabled
+	if caller != nil && caller.ID == "" {
+		caller = nil
 	}
 
-	v, err := s.Model.UpdateUser(ctx, user)
+	// existing email is rejected by unique index
+	v, err := s.Model.UpdateUser(ctx, user, mask, caller)
 	if err != nil {
-		logger.Log.Error("UpdateUser: " + err.Error())
-		return nil, constants.InternalError
+		logger.WithContext(ctx).Error("UpdateUser: " + err.Error())
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
 	}
 	resp := s.userToResp(v)
 	return resp, nil
@@ -62,6 +51,7 @@ func (s *UpdateUserHandler) reqToUser(req *pb.UpdateUserRequest) *dto.User {
 		Lat:      req.Data.Lat,
 		Long:     req.Data.Long,
 		Name:     req.Data.Name,
+		Version:  req.Data.Version,
 	}
 	return user
 }
@@ -77,20 +67,36 @@ func (s *UpdateUserHandler) userToResp(user *dto.User) *pb.UpdateUserResponse {
 			Lat:         user.Lat,
 			Long:        user.Long,
 			Name:        user.Name,
+			Version:     user.Version,
 		},
 	}
 	return resp
 }
 
-func (s *UpdateUserHandler) validateAndProcessReq(user *dto.User) error {
-	valid := utility.ValidateEmail(user.Email)
-	if !valid {
-		return constants.InvalidEmailError
+// validateAndProcessReq validates fields of user in mask, all fields if mask is empty
+func (s *UpdateUserHandler) validateAndProcessReq(user *dto.User, mask []string) error {
+	masked := func(field string) bool {
+		if len(mask) == 0 {
+			return true
+		}
+		for _, f := range mask {
+			if f == field {
+				return true
+			}
+		}
+		return false
 	}
-	if user.Email == "" {
-		return constants.InvalidEmailError
+
+	if masked(constants.Email) {
+		valid := utility.ValidateEmail(user.Email)
+		if !valid {
+			return constants.InvalidEmailError
+		}
+		if user.Email == "" {
+			return constants.InvalidEmailError
+		}
 	}
-	if user.Role == "" {
+	if masked(constants.Role) && user.Role == "" {
 		return constants.InvalidRoleError
 	}
 
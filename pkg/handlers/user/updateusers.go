@@ -5,6 +5,7 @@ import (
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/handlers/errs"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/model"
 	"galasejahtera/pkg/utility"
@@ -14,45 +15,31 @@ type UpdateUsersHandler struct {
 	Model model.IModel
 }
 
-func (s *UpdateUsersHandler) UpdateUsers(ctx context.Context, req *pb.UpdateUsersRequest) (*pb.UpdateUsersResponse, error) {
+func (s *UpdateUsersHandler) UpdateUsers(ctx context.Context, req *pb.UpdateUsersRequest, caller *dto.User) (*pb.UpdateUsersResponse, error) {
 	if req.Data == nil {
 		return nil, constants.InvalidArgumentError
 	}
-	if len(req.Ids) > 1 {
-		return nil, constants.OperationUnsupportedError
-	}
 	user := s.reqToUser(req)
-	err := s.validateAndProcessReq(user)
-	if err != nil {
-		return nil, err
-	}
+	mask := req.UpdateMask.GetPaths()
 
-	// get user
-	u, err := s.Model.GetUser(ctx, req.Ids[0])
+	err := s.validateAndProcessReq(user, mask)
 	if err != nil {
 		return nil, err
 	}
 
-	if u.Email != user.Email {
-		// check if email exist
-		count, _, err := s.Model.QueryUsers(ctx, nil, nil, &dto.FilterData{
-			Item:  constants.Email,
-			Value: user.Email,
-		})
-		if err != nil {
-			return nil, err
-		}
-		if count > 0 {
-			return nil, constants.EmailAlreadyExistError
-		}
+	// caller is unknown when auth is disabled
+	if caller != nil && caller.ID == "" {
+		caller = nil
 	}
 
-	ids, err := s.Model.UpdateUsers(ctx, user, req.Ids)
+	// existing email is rejected by unique index
+	results, err := s.Model.UpdateUsers(ctx, user, req.Ids, mask, req.Versions, caller)
 	if err != nil {
-		logger.Log.Error("UpdateUsers: " + err.Error())
-		return nil, constants.InternalError
+		logger.WithContext(ctx).Error("UpdateUsers: " + err.Error())
+		return nil, errs.ToStatus(err, constants.UserNotFoundError)
 	}
-	return &pb.UpdateUsersResponse{Data: ids}, nil
+	ids, resp := errs.ToBatchResults(results)
+	return &pb.UpdateUsersResponse{Data: ids, Results: resp}, nil
 }
 
 func (s *UpdateUsersHandler) reqToUser(req *pb.UpdateUsersRequest) *dto.User {
@@ -68,15 +55,27 @@ func (s *UpdateUsersHandler) reqToUser(req *pb.UpdateUsersRequest) *dto.User {
 	return user
 }
 
-func (s *UpdateUsersHandler) validateAndProcessReq(user *dto.User) error {
-	valid := utility.ValidateEmail(user.Email)
-	if !valid {
-		return constants.InvalidEmailError
+// validateAndProcessReq validates fields of user in mask, all fields but email if mask is empty
+func (s *UpdateUsersHandler) validateAndProcessReq(user *dto.User, mask []string) error {
+	masked := func(field string) bool {
+		for _, f := range mask {
+			if f == field {
+				return true
+			}
+		}
+		return len(mask) == 0 && field != constants.Email
 	}
-	if user.Email == "" {
-		return constants.InvalidEmailError
+
+	if masked(constants.Email) {
+		valid := utility.ValidateEmail(user.Email)
+		if !valid {
+			return constants.InvalidEmailError
+		}
+		if user.Email == "" {
+			return constants.InvalidEmailError
+		}
 	}
-	if user.Role == "" {
+	if masked(constants.Role) && user.Role == "" {
 		return constants.InvalidRoleError
 	}
 
@@ -0,0 +1,92 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/migration"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+// readyTimeout is timeout of readiness check
+const readyTimeout = 5 * time.Second
+
+// IScheduler reports liveness of background jobs
+type IScheduler interface {
+	// Alive returns error if jobs are no longer scheduled
+	Alive() error
+}
+
+// Checker checks readiness of service to receive traffic
+type Checker struct {
+	client    *mongo.Client
+	scheduler IScheduler
+	draining  chan struct{}
+	once      sync.Once
+}
+
+// NewChecker creates readiness checker of MongoDB client and scheduler
+func NewChecker(client *mongo.Client, scheduler IScheduler) *Checker {
+	return &Checker{
+		client:    client,
+		scheduler: scheduler,
+		draining:  make(chan struct{}),
+	}
+}
+
+// Ready returns error if service is shutting down, MongoDB is unreachable, indexes are missing or scheduler is not alive
+func (c *Checker) Ready(ctx context.Context) error {
+	select {
+	case <-c.draining:
+		return errors.New("shutting down")
+	default:
+	}
+	if err := c.client.Ping(ctx, readpref.Primary()); err != nil {
+		return fmt.Errorf("mongodb is unreachable: %v", err)
+	}
+	if err := migration.Verify(ctx, c.client.Database(constants.GalaSejahtera)); err != nil {
+		return err
+	}
+	if err := c.scheduler.Alive(); err != nil {
+		return fmt.Errorf("scheduler is not alive: %v", err)
+	}
+	return nil
+}
+
+// Drain fails readiness from now on, so that load balancers stop routing traffic before shutdown
+func (c *Checker) Drain() {
+	c.once.Do(func() {
+		close(c.draining)
+	})
+}
+
+// Draining returns channel closed by Drain
+func (c *Checker) Draining() <-chan struct{} {
+	return c.draining
+}
+
+// LiveHandler serves 200 as long as process is up
+func LiveHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+}
+
+// ReadyHandler serves 200 if service is ready, 503 with reason otherwise
+func (c *Checker) ReadyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), readyTimeout)
+		defer cancel()
+		if err := c.Ready(ctx); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
+}
@@ -0,0 +1,32 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDrain ...
+func TestDrain(t *testing.T) {
+	c := NewChecker(nil, nil)
+	c.Drain()
+	c.Drain()
+
+	select {
+	case <-c.Draining():
+	default:
+		t.Error("draining, should be closed")
+	}
+	assert.Error(t, c.Ready(context.Background()), "draining, should fail without checking dependencies")
+
+	rec := httptest.NewRecorder()
+	c.ReadyHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code, "draining, should be unavailable")
+
+	rec = httptest.NewRecorder()
+	LiveHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code, "draining, should still be live")
+}
@@ -0,0 +1,30 @@
+package logger
+
+import (
+	"context"
+	"galasejahtera/pkg/utility"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// RequestIDField is log field of request ID
+const RequestIDField = "request-id"
+
+// TraceIDField is log field of trace ID
+const TraceIDField = "trace-id"
+
+// WithContext returns logger adding request ID and trace ID carried by ctx to every log line
+func WithContext(ctx context.Context) *zap.Logger {
+	var fields []zap.Field
+	if id := utility.RequestID(ctx); id != "" {
+		fields = append(fields, zap.String(RequestIDField, id))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		fields = append(fields, zap.String(TraceIDField, sc.TraceID().String()))
+	}
+	if len(fields) == 0 {
+		return Log
+	}
+	return Log.With(fields...)
+}
@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// countTimeout bounds count query of a scrape, so that a slow database does not stall the metrics endpoint
+const countTimeout = 5 * time.Second
+
+// activeUsers reports number of active users counted on each scrape
+type activeUsers struct {
+	desc  *prometheus.Desc
+	count func(ctx context.Context) (int64, error)
+}
+
+// Describe ...
+func (c *activeUsers) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect counts active users, the gauge is left out of the scrape if counting fails
+func (c *activeUsers) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), countTimeout)
+	defer cancel()
+
+	active, err := c.count(ctx)
+	if err != nil {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(active))
+}
+
+// RegisterActiveUsers registers gauge of number of active users, queried by count on each scrape
+func RegisterActiveUsers(count func(ctx context.Context) (int64, error)) error {
+	return prometheus.Register(&activeUsers{
+		desc: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "active_users"),
+			"Number of users that updated their location recently.", nil, nil),
+		count: count,
+	})
+}
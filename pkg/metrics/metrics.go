@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace prefixes all metric names
+const namespace = "galasejahtera"
+
+// InactiveUsersDisabled counts users set to inactive by the inactive user job
+var InactiveUsersDisabled = prometheus.NewCounter(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "inactive_users_disabled_total",
+	Help:      "Number of users set to inactive for not updating their location.",
+})
+
+// ReportsCreated counts reports created by whether symptom is reported
+var ReportsCreated = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "reports_created_total",
+	Help:      "Number of reports created, increase over 1d is reports per day.",
+}, []string{"has_symptom"})
+
+// RPCRequests counts handled gRPC requests by method and status code
+var RPCRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "grpc_requests_total",
+	Help:      "Number of gRPC requests handled by method and status code.",
+}, []string{"method", "code"})
+
+// RPCDuration observes gRPC request latency by method
+var RPCDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Name:      "grpc_request_duration_seconds",
+	Help:      "Latency of gRPC requests by method.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"method"})
+
+// CrawlerRequests counts crawler requests by upstream host and status code, code is "error" if request failed
+var CrawlerRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "crawler_requests_total",
+	Help:      "Number of crawler requests by upstream host and status code.",
+}, []string{"upstream", "code"})
+
+// CrawlerDuration observes crawler request latency by upstream host
+var CrawlerDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Name:      "crawler_request_duration_seconds",
+	Help:      "Latency of crawler requests by upstream host.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"upstream"})
+
+// JobRuns counts scheduler job runs by job and result, success or failure
+var JobRuns = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "job_runs_total",
+	Help:      "Number of scheduler job runs by job and result.",
+}, []string{"job", "result"})
+
+// JobDuration observes scheduler job run duration by job
+var JobDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Name:      "job_duration_seconds",
+	Help:      "Duration of scheduler job runs by job.",
+	// 100ms up to about 27 minutes
+	Buckets: prometheus.ExponentialBuckets(0.1, 4, 8),
+}, []string{"job"})
+
+// MongoConnections is number of open MongoDB connections of the pool
+var MongoConnections = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: namespace,
+	Name:      "mongo_pool_connections",
+	Help:      "Number of open MongoDB connections.",
+})
+
+// MongoConnectionsInUse is number of MongoDB connections checked out of the pool
+var MongoConnectionsInUse = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: namespace,
+	Name:      "mongo_pool_connections_in_use",
+	Help:      "Number of MongoDB connections checked out of the pool.",
+})
+
+// MongoCheckOutFailures counts failures to check out MongoDB connections, e.g. pool exhausted or server unreachable
+var MongoCheckOutFailures = prometheus.NewCounter(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "mongo_pool_checkout_failures_total",
+	Help:      "Number of failures to check out a MongoDB connection.",
+})
+
+func init() {
+	prometheus.MustRegister(InactiveUsersDisabled, ReportsCreated, RPCRequests, RPCDuration,
+		CrawlerRequests, CrawlerDuration, JobRuns, JobDuration, MongoConnections, MongoConnectionsInUse,
+		MongoCheckOutFailures)
+}
+
+// Handler serves metrics in Prometheus exposition format
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,23 @@
+package metrics
+
+import "go.mongodb.org/mongo-driver/event"
+
+// PoolMonitor records MongoDB connection pool stats, for options.Client().SetPoolMonitor
+func PoolMonitor() *event.PoolMonitor {
+	return &event.PoolMonitor{
+		Event: func(e *event.PoolEvent) {
+			switch e.Type {
+			case event.ConnectionCreated:
+				MongoConnections.Inc()
+			case event.ConnectionClosed:
+				MongoConnections.Dec()
+			case event.GetSucceeded:
+				MongoConnectionsInUse.Inc()
+			case event.ConnectionReturned:
+				MongoConnectionsInUse.Dec()
+			case event.GetFailed:
+				MongoCheckOutFailures.Inc()
+			}
+		},
+	}
+}
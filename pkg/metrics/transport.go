@@ -0,0 +1,31 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// transport records outcome and latency of requests per upstream host
+type transport struct {
+	next http.RoundTripper
+}
+
+// Transport wraps next so that requests sent by it are recorded in crawler metrics
+func Transport(next http.RoundTripper) http.RoundTripper {
+	return &transport{next: next}
+}
+
+// RoundTrip ...
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	res, err := t.next.RoundTrip(req)
+	CrawlerDuration.WithLabelValues(req.URL.Host).Observe(time.Since(start).Seconds())
+
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(res.StatusCode)
+	}
+	CrawlerRequests.WithLabelValues(req.URL.Host, code).Inc()
+	return res, err
+}
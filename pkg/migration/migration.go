@@ -0,0 +1,149 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/logger"
+	"galasejahtera/pkg/utility"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// Migration is a versioned schema change or data backfill. Replicas starting together may apply the same
+// migration concurrently, so Up must be idempotent.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      func(ctx context.Context, db *mongo.Database) error
+}
+
+// Run applies pending migrations in version order and records them in migrations collection
+func Run(ctx context.Context, db *mongo.Database, migrations []*Migration) (int, error) {
+	collection := db.Collection(constants.Migrations)
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.M{constants.Version: 1},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	applied, err := Applied(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+	done := map[int64]bool{}
+	for _, m := range applied {
+		done[m.Version] = true
+	}
+
+	pending := make([]*Migration, 0, len(migrations))
+	for _, m := range migrations {
+		if !done[m.Version] {
+			pending = append(pending, m)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].Version < pending[j].Version })
+
+	for _, m := range pending {
+		start := time.Now()
+		if err := m.Up(ctx, db); err != nil {
+			return 0, fmt.Errorf("migration %d %s: %v", m.Version, m.Name, err)
+		}
+		record := &dto.Migration{
+			Version:   m.Version,
+			Name:      m.Name,
+			AppliedAt: utility.TimeToMilli(utility.MalaysiaTime(start)),
+			Duration:  time.Since(start).Milliseconds(),
+		}
+
+		// another replica may have recorded the same migration
+		_, err := collection.UpdateOne(ctx, bson.D{{constants.Version, m.Version}},
+			bson.D{{"$setOnInsert", record}}, options.Update().SetUpsert(true))
+		if err != nil {
+			return 0, fmt.Errorf("migration %d %s: failed to record: %v", m.Version, m.Name, err)
+		}
+		logger.Log.Info("applied migration", zap.Int64("version", m.Version), zap.String("name", m.Name), zap.Int64("duration-ms", record.Duration))
+	}
+	return len(pending), nil
+}
+
+// Applied gets applied migrations in version order
+func Applied(ctx context.Context, db *mongo.Database) ([]*dto.Migration, error) {
+	cursor, err := db.Collection(constants.Migrations).Find(ctx, bson.D{},
+		options.Find().SetSort(bson.D{{constants.Version, 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var migrations []*dto.Migration
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		m := &dto.Migration{}
+		if err = cursor.Decode(&m); err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, m)
+	}
+	return migrations, nil
+}
+
+// Verify checks that all indexes expected by the application exist
+func Verify(ctx context.Context, db *mongo.Database) error {
+	for collectionName, names := range Indexes {
+		cursor, err := db.Collection(collectionName).Indexes().List(ctx)
+		if err != nil {
+			return err
+		}
+
+		existing := map[string]bool{}
+		for cursor.Next(ctx) {
+			index := struct {
+				Name string `bson:"name"`
+			}{}
+			if err = cursor.Decode(&index); err != nil {
+				cursor.Close(ctx)
+				return err
+			}
+			existing[index.Name] = true
+		}
+		cursor.Close(ctx)
+
+		for _, name := range names {
+			if !existing[name] {
+				return fmt.Errorf("index %s on %s is missing, run migrations", name, collectionName)
+			}
+		}
+	}
+	return nil
+}
+
+// createIndexes returns migration step creating indexes on collection, creating an existing index is no-op
+func createIndexes(collection string, indexes ...mongo.IndexModel) func(ctx context.Context, db *mongo.Database) error {
+	return func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection(collection).Indexes().CreateMany(ctx, indexes)
+		return err
+	}
+}
+
+// MongoDB error codes of dropping index of missing collection or missing index
+const (
+	namespaceNotFoundCode = 26
+	indexNotFoundCode     = 27
+)
+
+// dropIndex drops index by name, dropping missing index is no-op
+func dropIndex(ctx context.Context, db *mongo.Database, collection string, name string) error {
+	_, err := db.Collection(collection).Indexes().DropOne(ctx, name)
+	if e, ok := err.(mongo.CommandError); ok && (e.Code == namespaceNotFoundCode || e.Code == indexNotFoundCode) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,29 @@
+package migration
+
+import (
+	"galasejahtera/pkg/constants"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// TestMigrations ...
+func TestMigrations(t *testing.T) {
+	for i, m := range Migrations {
+		assert.Equal(t, int64(i+1), m.Version, "migration versions should be consecutive: "+m.Name)
+		assert.NotEmpty(t, m.Name)
+		assert.NotNil(t, m.Up, m.Name)
+	}
+}
+
+// TestDuplicateEmailsPipeline ...
+func TestDuplicateEmailsPipeline(t *testing.T) {
+	pipeline := duplicateEmailsPipeline()
+	assert.Equal(t, bson.D{{"$match", bson.D{{constants.NormalizedEmail, bson.D{{"$gt", ""}}}}}}, pipeline[0],
+		"users without email should not be duplicates")
+	assert.Equal(t, "$"+constants.NormalizedEmail, pipeline[1].Map()["$group"].(bson.D).Map()["_id"],
+		"users should be grouped by normalized email")
+	assert.Equal(t, bson.D{{"$match", bson.D{{"count", bson.D{{"$gt", 1}}}}}}, pipeline[2],
+		"only emails shared by more than one user should be returned")
+}
@@ -0,0 +1,297 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/utility"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Migrations are all migrations, append new migration with next version and never change applied ones
+var Migrations = []*Migration{
+	{
+		Version: 1,
+		Name:    "create auth token ttl index",
+		Up: createIndexes(constants.AuthTokens, mongo.IndexModel{
+			Keys: bson.M{
+				constants.TTL: 1,
+			}, Options: options.Index().SetExpireAfterSeconds(1),
+		}),
+	},
+	{
+		Version: 2,
+		Name:    "create user indexes",
+		Up: createIndexes(constants.Users,
+			mongo.IndexModel{
+				Keys:    bson.M{constants.ID: 1},
+				Options: options.Index().SetUnique(true),
+			},
+			// email is left without unique index, existing users may share or lack email
+			mongo.IndexModel{
+				Keys: bson.M{constants.Location: "2dsphere"},
+			},
+		),
+	},
+	{
+		Version: 3,
+		Name:    "create report user index",
+		Up: createIndexes(constants.Reports, mongo.IndexModel{
+			Keys: bson.D{
+				{constants.UserId, 1},
+				{constants.CreatedAt, -1},
+			},
+		}),
+	},
+	{
+		Version: 4,
+		Name:    "create location history indexes",
+		Up: createIndexes(constants.Locations,
+			mongo.IndexModel{
+				Keys: bson.M{
+					constants.TTL: 1,
+				}, Options: options.Index().SetExpireAfterSeconds(1),
+			},
+			mongo.IndexModel{
+				Keys: bson.D{
+					{constants.Location, "2dsphere"},
+					{constants.CreatedAt, 1},
+				},
+			},
+			mongo.IndexModel{
+				Keys: bson.D{
+					{constants.UserId, 1},
+					{constants.CreatedAt, -1},
+				},
+			},
+		),
+	},
+	{
+		Version: 5,
+		Name:    "create job index",
+		Up: createIndexes(constants.Jobs, mongo.IndexModel{
+			Keys:    bson.M{constants.Name: 1},
+			Options: options.Index().SetUnique(true),
+		}),
+	},
+	{
+		Version: 6,
+		Name:    "create district boundary index",
+		Up: createIndexes(constants.Districts, mongo.IndexModel{
+			Keys: bson.M{constants.Geometry: "2dsphere"},
+		}),
+	},
+	{
+		Version: 7,
+		Name:    "backfill normalized user email",
+		Up:      backfillNormalizedEmail,
+	},
+	{
+		Version: 8,
+		Name:    "create unique normalized user email index",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			// emails differing only by case cannot be unique, they are resolved by hand before migrating
+			if err := checkDuplicateEmails(ctx, db); err != nil {
+				return err
+			}
+			// users without email, e.g. backend user, are left out of uniqueness
+			return createIndexes(constants.Users, mongo.IndexModel{
+				Keys: bson.M{constants.NormalizedEmail: 1},
+				Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{
+					constants.NormalizedEmail: bson.M{"$gt": ""},
+				}),
+			})(ctx, db)
+		},
+	},
+	{
+		Version: 9,
+		Name:    "backfill user and report version",
+		Up:      backfillVersion(constants.Users, constants.Reports),
+	},
+	{
+		Version: 10,
+		Name:    "create auth token user index",
+		Up: createIndexes(constants.AuthTokens, mongo.IndexModel{
+			Keys: bson.M{constants.UserId: 1},
+		}),
+	},
+	{
+		Version: 11,
+		Name:    "create audit indexes",
+		Up: createIndexes(constants.Audits,
+			mongo.IndexModel{
+				Keys:    bson.M{constants.ID: 1},
+				Options: options.Index().SetUnique(true),
+			},
+			mongo.IndexModel{
+				Keys: bson.D{
+					{constants.Target, 1},
+					{constants.CreatedAt, -1},
+				},
+			},
+			mongo.IndexModel{
+				Keys: bson.D{
+					{constants.Actor, 1},
+					{constants.CreatedAt, -1},
+				},
+			},
+			mongo.IndexModel{
+				Keys: bson.M{constants.CreatedAt: -1},
+			},
+		),
+	},
+	{
+		Version: 12,
+		Name:    "redact personal data in audit log",
+		Up:      redactAudits,
+	},
+	{
+		Version: 13,
+		Name:    "exclude soft deleted users from unique normalized email index",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			// partial index cannot match missing deletedAt
+			if err := backfillDeletedAt(constants.Users, constants.Reports)(ctx, db); err != nil {
+				return err
+			}
+			if err := dropIndex(ctx, db, constants.Users, constants.NormalizedEmail+"_1"); err != nil {
+				return err
+			}
+			// email of soft deleted user can be taken by another user, restore of the user is then rejected
+			return createIndexes(constants.Users, mongo.IndexModel{
+				Keys: bson.M{constants.NormalizedEmail: 1},
+				Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.D{
+					{constants.NormalizedEmail, bson.D{{"$gt", ""}}},
+					{constants.DeletedAt, 0},
+				}),
+			})(ctx, db)
+		},
+	},
+}
+
+// backfillVersion sets version of documents created before updates are versioned to 1
+func backfillVersion(collections ...string) func(ctx context.Context, db *mongo.Database) error {
+	return func(ctx context.Context, db *mongo.Database) error {
+		for _, c := range collections {
+			_, err := db.Collection(c).UpdateMany(ctx, bson.D{{constants.Version, bson.D{{"$exists", false}}}}, bson.D{
+				{"$set", bson.D{{constants.Version, 1}}},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// backfillDeletedAt sets deletedAt of documents created before it is always set to 0, i.e. not deleted
+func backfillDeletedAt(collections ...string) func(ctx context.Context, db *mongo.Database) error {
+	return func(ctx context.Context, db *mongo.Database) error {
+		for _, c := range collections {
+			_, err := db.Collection(c).UpdateMany(ctx, bson.D{{constants.DeletedAt, bson.D{{"$exists", false}}}}, bson.D{
+				{"$set", bson.D{{constants.DeletedAt, 0}}},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// redactAudits replaces values of personal fields recorded in audit log before they are redacted by model
+func redactAudits(ctx context.Context, db *mongo.Database) error {
+	collection := db.Collection(constants.Audits)
+	for _, side := range []string{constants.Before, constants.After} {
+		for _, field := range constants.AuditPersonalFields {
+			key := side + "." + field
+			_, err := collection.UpdateMany(ctx, bson.D{{key, bson.D{{"$exists", true}}}}, bson.D{
+				{"$set", bson.D{{key, constants.AuditRedacted}}},
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// backfillNormalizedEmail sets normalized email of users created before it is maintained by UserDAO
+func backfillNormalizedEmail(ctx context.Context, db *mongo.Database) error {
+	collection := db.Collection(constants.Users)
+	cursor, err := collection.Find(ctx, bson.D{{constants.NormalizedEmail, bson.D{{"$exists", false}}}})
+	if err != nil {
+		return err
+	}
+
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		user := &dto.User{}
+		if err = cursor.Decode(&user); err != nil {
+			return err
+		}
+		_, err = collection.UpdateOne(ctx, bson.D{{constants.ID, user.ID}}, bson.D{
+			{"$set", bson.D{{constants.NormalizedEmail, utility.NormalizeEmail(user.Email)}}},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+// checkDuplicateEmails fails listing IDs of users sharing normalized email, i.e. emails differing only by case
+func checkDuplicateEmails(ctx context.Context, db *mongo.Database) error {
+	cursor, err := db.Collection(constants.Users).Aggregate(ctx, duplicateEmailsPipeline())
+	if err != nil {
+		return err
+	}
+
+	var duplicates []string
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		group := &struct {
+			IDs []string `bson:"ids"`
+		}{}
+		if err = cursor.Decode(group); err != nil {
+			return err
+		}
+		duplicates = append(duplicates, "["+strings.Join(group.IDs, ", ")+"]")
+	}
+	if err = cursor.Err(); err != nil {
+		return err
+	}
+	if len(duplicates) > 0 {
+		return fmt.Errorf("users with emails differing only by case must be changed or deleted before migrating, user IDs by email: %s",
+			strings.Join(duplicates, ", "))
+	}
+	return nil
+}
+
+// duplicateEmailsPipeline groups IDs of users by normalized email shared by more than one user
+func duplicateEmailsPipeline() mongo.Pipeline {
+	return mongo.Pipeline{
+		{{"$match", bson.D{{constants.NormalizedEmail, bson.D{{"$gt", ""}}}}}},
+		{{"$group", bson.D{
+			{"_id", "$" + constants.NormalizedEmail},
+			{"ids", bson.D{{"$push", "$" + constants.ID}}},
+			{"count", bson.D{{"$sum", 1}}},
+		}}},
+		{{"$match", bson.D{{"count", bson.D{{"$gt", 1}}}}}},
+	}
+}
+
+// Indexes are indexes by collection expected by the application, named by MongoDB default index name
+var Indexes = map[string][]string{
+	constants.AuthTokens: {"ttl_1", "userId_1"},
+	constants.Users:      {"id_1", "normalizedEmail_1", "location_2dsphere"},
+	constants.Reports:    {"userId_1_createdAt_-1"},
+	constants.Locations:  {"ttl_1", "location_2dsphere_createdAt_1", "userId_1_createdAt_-1"},
+	constants.Jobs:       {"name_1"},
+	constants.Districts:  {"geometry_2dsphere"},
+	constants.Audits:     {"id_1", "target_1_createdAt_-1", "actor_1_createdAt_-1", "createdAt_-1"},
+}
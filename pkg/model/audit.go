@@ -0,0 +1,83 @@
+package model
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/utility"
+	"reflect"
+	"time"
+
+	"github.com/twinj/uuid"
+)
+
+// audit appends records of action by caller, one per record given with target and changed values set. It is called
+// in the transaction of the action, so that the action is rolled back and its error returned if it cannot be recorded
+func (m *Model) audit(ctx context.Context, caller *dto.User, action string, audits ...*dto.Audit) error {
+	if len(audits) == 0 {
+		return nil
+	}
+
+	actor := ""
+	if caller != nil {
+		actor = caller.ID
+	}
+	requestID := utility.RequestID(ctx)
+	now := utility.TimeToMilli(utility.MalaysiaTime(time.Now()))
+	for _, a := range audits {
+		a.ID = uuid.NewV4().String()
+		a.Actor = actor
+		a.Action = action
+		a.RequestID = requestID
+		a.CreatedAt = now
+	}
+	return m.auditDAO.BatchCreate(ctx, audits)
+}
+
+// QueryAudits queries audit records by filters, sorts and range or page, returns total, audit records and next page token
+func (m *Model) QueryAudits(ctx context.Context, query *dto.QueryData) (int64, []*dto.Audit, string, error) {
+	return m.auditDAO.Query(ctx, query)
+}
+
+// userAuditFields are values of user fields compared for audit log, password is never recorded and values of personal
+// fields are redacted by changeAudit
+func userAuditFields(u *dto.User) map[string]interface{} {
+	return map[string]interface{}{
+		constants.Role:     u.Role,
+		constants.Email:    u.Email,
+		constants.IsActive: u.IsActive,
+		constants.Lat:      u.Lat,
+		constants.Long:     u.Long,
+		constants.Name:     u.Name,
+	}
+}
+
+// diff returns values before and after of fields whose value changed, nil if none changed
+func diff(before map[string]interface{}, after map[string]interface{}) (map[string]interface{}, map[string]interface{}) {
+	var changedBefore, changedAfter map[string]interface{}
+	for field, value := range after {
+		if reflect.DeepEqual(before[field], value) {
+			continue
+		}
+		if changedBefore == nil {
+			changedBefore, changedAfter = map[string]interface{}{}, map[string]interface{}{}
+		}
+		changedBefore[field] = before[field]
+		changedAfter[field] = value
+	}
+	return changedBefore, changedAfter
+}
+
+// changeAudit is audit record of update of target from before to after, changed personal fields are recorded without
+// their values
+func changeAudit(target string, before map[string]interface{}, after map[string]interface{}) *dto.Audit {
+	a := &dto.Audit{Target: target}
+	a.Before, a.After = diff(before, after)
+	for _, field := range constants.AuditPersonalFields {
+		if _, ok := a.After[field]; ok {
+			a.Before[field] = constants.AuditRedacted
+			a.After[field] = constants.AuditRedacted
+		}
+	}
+	return a
+}
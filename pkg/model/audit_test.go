@@ -0,0 +1,60 @@
+package model
+
+import (
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDiff ...
+func TestDiff(t *testing.T) {
+	user := &dto.User{Role: constants.User, Email: "a@example.com", Name: "A", IsActive: true}
+	tests := []struct {
+		name           string
+		after          *dto.User
+		expectedBefore map[string]interface{}
+		expectedAfter  map[string]interface{}
+	}{
+		{name: "nothing changed, should be nil", after: user},
+		{
+			name:           "role and name changed, should only have role and name",
+			after:          &dto.User{Role: constants.Admin, Email: "a@example.com", Name: "B", IsActive: true},
+			expectedBefore: map[string]interface{}{constants.Role: constants.User, constants.Name: "A"},
+			expectedAfter:  map[string]interface{}{constants.Role: constants.Admin, constants.Name: "B"},
+		},
+		{
+			name:           "password changed, should not be recorded",
+			after:          &dto.User{Role: constants.User, Email: "a@example.com", Name: "A", IsActive: false, Password: "secret"},
+			expectedBefore: map[string]interface{}{constants.IsActive: true},
+			expectedAfter:  map[string]interface{}{constants.IsActive: false},
+		},
+	}
+
+	for _, test := range tests {
+		before, after := diff(userAuditFields(user), userAuditFields(test.after))
+		assert.Equal(t, test.expectedBefore, before, test.name)
+		assert.Equal(t, test.expectedAfter, after, test.name)
+	}
+}
+
+// TestChangeAudit ...
+func TestChangeAudit(t *testing.T) {
+	before := &dto.User{Role: constants.User, Email: "a@example.com", Name: "A", Lat: 1, Long: 2}
+	after := &dto.User{Role: constants.Admin, Email: "b@example.com", Name: "A", Lat: 3, Long: 2}
+
+	a := changeAudit("a", userAuditFields(before), userAuditFields(after))
+	assert.Equal(t, "a", a.Target)
+	assert.Equal(t, map[string]interface{}{
+		constants.Role:  constants.User,
+		constants.Email: constants.AuditRedacted,
+		constants.Lat:   constants.AuditRedacted,
+	}, a.Before, "personal fields should be recorded without values")
+	assert.Equal(t, map[string]interface{}{
+		constants.Role:  constants.Admin,
+		constants.Email: constants.AuditRedacted,
+		constants.Lat:   constants.AuditRedacted,
+	}, a.After, "personal fields should be recorded without values")
+	assert.Equal(t, &dto.Audit{Target: "a"}, changeAudit("a", userAuditFields(before), userAuditFields(before)))
+}
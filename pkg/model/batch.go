@@ -0,0 +1,67 @@
+package model
+
+import (
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+)
+
+// batchIDs removes empty and duplicated IDs of batch operation keeping order, rejects empty or oversized batch
+func batchIDs(ids []string) ([]string, error) {
+	var unique []string
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	if len(unique) == 0 || len(unique) > constants.MaxBatchSize {
+		return nil, constants.InvalidArgumentError
+	}
+	return unique, nil
+}
+
+// contains reports whether ids contains id
+func contains(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// without returns ids without id
+func without(ids []string, id string) []string {
+	var rest []string
+	for _, i := range ids {
+		if i != id {
+			rest = append(rest, i)
+		}
+	}
+	return rest
+}
+
+// batchResults reports success for found IDs and notFound error for the others, in order of ids
+func batchResults(ids []string, found map[string]bool, notFound error) []*dto.BatchResult {
+	var results []*dto.BatchResult
+	for _, id := range ids {
+		result := &dto.BatchResult{ID: id}
+		if !found[id] {
+			result.Error = notFound
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// batchFailed sets error of results of failed IDs
+func batchFailed(results []*dto.BatchResult, failed map[string]error) []*dto.BatchResult {
+	for _, r := range results {
+		if err, ok := failed[r.ID]; ok {
+			r.Error = err
+		}
+	}
+	return results
+}
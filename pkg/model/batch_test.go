@@ -0,0 +1,176 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dao"
+	"galasejahtera/pkg/dto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDeleteReports ...
+func TestDeleteReports(t *testing.T) {
+	tests := []struct {
+		name           string
+		ids            []string
+		err            error
+		auditErr       error
+		expectedIDs    []string
+		expectedErrors []error
+		expectedLeft   int
+		expectedAudits int
+		expectedError  error
+	}{
+		{name: "all found, should delete all", ids: []string{"a", "b"}, expectedIDs: []string{"a", "b"}, expectedErrors: []error{nil, nil}, expectedLeft: 1, expectedAudits: 2},
+		{name: "some missing, should report not found per ID", ids: []string{"a", "x", "c"}, expectedIDs: []string{"a", "x", "c"}, expectedErrors: []error{nil, constants.ReportNotFoundError, nil}, expectedLeft: 1, expectedAudits: 2},
+		{name: "duplicated and empty IDs, should be removed", ids: []string{"a", "", "a"}, expectedIDs: []string{"a"}, expectedErrors: []error{nil}, expectedLeft: 2, expectedAudits: 1},
+		{name: "no IDs, should return invalid argument", ids: []string{""}, expectedLeft: 3, expectedError: constants.InvalidArgumentError},
+		{name: "delete fails, should delete nothing", ids: []string{"a", "b"}, err: dao.ErrUnavailable, expectedLeft: 3, expectedError: dao.ErrUnavailable},
+		{name: "audit fails, should delete nothing", ids: []string{"a", "b"}, auditErr: dao.ErrUnavailable, expectedLeft: 3, expectedError: dao.ErrUnavailable},
+	}
+
+	for _, test := range tests {
+		store := &memStore{reports: map[string]*dto.Report{"a": {ID: "a"}, "b": {ID: "b"}, "c": {ID: "c"}},
+			reportErr: test.err, auditErr: test.auditErr}
+		m := newMemModel(store)
+
+		results, err := m.DeleteReports(context.Background(), test.ids, &dto.User{ID: "admin"})
+		assert.True(t, errors.Is(err, test.expectedError), test.name)
+		assert.Len(t, results, len(test.expectedIDs), test.name)
+		for i, r := range results {
+			assert.Equal(t, test.expectedIDs[i], r.ID, test.name)
+			assert.Equal(t, test.expectedErrors[i], r.Error, test.name)
+		}
+		left, _ := m.reportDAO.BatchGet(context.Background(), reportIDs(store.reports))
+		assert.Len(t, left, test.expectedLeft, test.name)
+		assert.Len(t, store.audits, test.expectedAudits, test.name)
+		for _, a := range store.audits {
+			assert.Equal(t, "admin", a.Actor, test.name)
+			assert.Equal(t, constants.AuditDeleteReport, a.Action, test.name)
+		}
+	}
+}
+
+// TestUpdateUsers ...
+func TestUpdateUsers(t *testing.T) {
+	admin := &dto.User{ID: "admin", Role: constants.Admin}
+	user := &dto.User{Role: constants.Admin, Email: "new@example.com", IsActive: true, Lat: 1, Long: 2, Name: "New"}
+	tests := []struct {
+		name           string
+		ids            []string
+		mask           []string
+		versions       map[string]int64
+		caller         *dto.User
+		expectedErrors []error
+		expectedUsers  map[string]dto.User
+		expectedTokens []string
+		expectedError  error
+	}{
+		{
+			name: "no mask, should update all fields but email", ids: []string{"a", "b"}, caller: admin,
+			expectedErrors: []error{nil, nil},
+			expectedUsers: map[string]dto.User{
+				"a": {ID: "a", Role: constants.Admin, Email: "a@example.com", IsActive: true, Lat: 1, Long: 2, Name: "New", Version: 2},
+				"b": {ID: "b", Role: constants.Admin, Email: "b@example.com", IsActive: true, Lat: 1, Long: 2, Name: "New", Version: 3},
+			},
+			expectedTokens: []string{"a", "b"},
+		},
+		{
+			name: "name in mask, should only update name", ids: []string{"a", "b"}, mask: []string{constants.Name}, caller: admin,
+			expectedErrors: []error{nil, nil},
+			expectedUsers: map[string]dto.User{
+				"a": {ID: "a", Role: constants.User, Email: "a@example.com", Lat: 5, Long: 6, Name: "New", Version: 2},
+				"b": {ID: "b", Role: constants.User, Email: "b@example.com", Lat: 7, Long: 8, Name: "New", Version: 3},
+			},
+			expectedTokens: []string{"a", "b"},
+		},
+		{
+			name: "email in mask of many users, should be rejected", ids: []string{"a", "b"}, mask: []string{constants.Email}, caller: admin,
+			expectedError: constants.BatchEmailError,
+		},
+		{
+			name: "email in mask of single user, should update email and revoke tokens", ids: []string{"a"}, mask: []string{constants.Email}, caller: admin,
+			expectedErrors: []error{nil},
+			expectedUsers: map[string]dto.User{
+				"a": {ID: "a", Role: constants.User, Email: "new@example.com", Lat: 5, Long: 6, Name: "A", Version: 2},
+			},
+			expectedTokens: []string{"b"},
+		},
+		{
+			name: "stale version, should only reject stale user", ids: []string{"a", "b", "x"}, mask: []string{constants.Name},
+			versions: map[string]int64{"a": 1, "b": 1}, caller: admin,
+			expectedErrors: []error{nil, &dao.VersionError{Current: 2}, constants.UserNotFoundError},
+			expectedUsers: map[string]dto.User{
+				"a": {ID: "a", Role: constants.User, Email: "a@example.com", Lat: 5, Long: 6, Name: "New", Version: 2},
+			},
+			expectedTokens: []string{"a", "b"},
+		},
+		{
+			name: "user updating other user, should be denied per user", ids: []string{"a", "b"}, mask: []string{constants.Name},
+			caller:         &dto.User{ID: "a", Role: constants.User},
+			expectedErrors: []error{nil, constants.PermissionDeniedError},
+			expectedUsers: map[string]dto.User{
+				"a": {ID: "a", Role: constants.User, Email: "a@example.com", Lat: 5, Long: 6, Name: "New", Version: 2},
+			},
+			expectedTokens: []string{"a", "b"},
+		},
+	}
+
+	for _, test := range tests {
+		store := &memStore{users: map[string]*dto.User{
+			"a": {ID: "a", Role: constants.User, Email: "a@example.com", Lat: 5, Long: 6, Name: "A", Version: 1},
+			"b": {ID: "b", Role: constants.User, Email: "b@example.com", Lat: 7, Long: 8, Name: "B", Version: 2},
+		}, tokens: map[string]bool{"a": true, "b": true}}
+		m := newMemModel(store)
+
+		results, err := m.UpdateUsers(context.Background(), user, test.ids, test.mask, test.versions, test.caller)
+		assert.Equal(t, test.expectedError, err, test.name)
+		assert.Len(t, results, len(test.expectedErrors), test.name)
+		for i, r := range results {
+			assert.Equal(t, test.ids[i], r.ID, test.name)
+			assert.Equal(t, test.expectedErrors[i], r.Error, test.name)
+		}
+		for id, expected := range test.expectedUsers {
+			assert.Equal(t, expected, *store.users[id], test.name)
+		}
+		assert.Len(t, store.audits, len(test.expectedUsers), test.name)
+		if test.expectedError == nil {
+			assert.Equal(t, test.expectedTokens, tokenIDs(store.tokens), test.name)
+		}
+	}
+}
+
+// TestUpdateReports ...
+func TestUpdateReports(t *testing.T) {
+	tests := []struct {
+		name             string
+		ids              []string
+		versions         map[string]int64
+		expectedErrors   []error
+		expectedSymptoms map[string]bool
+	}{
+		{name: "no versions, should update all", ids: []string{"a", "b"}, expectedErrors: []error{nil, nil}, expectedSymptoms: map[string]bool{"a": true, "b": true}},
+		{name: "current versions, should update all", ids: []string{"a", "b"}, versions: map[string]int64{"a": 1, "b": 2}, expectedErrors: []error{nil, nil}, expectedSymptoms: map[string]bool{"a": true, "b": true}},
+		{name: "stale version, should only reject stale report", ids: []string{"a", "b", "x"}, versions: map[string]int64{"b": 1},
+			expectedErrors: []error{nil, &dao.VersionError{Current: 2}, constants.ReportNotFoundError}, expectedSymptoms: map[string]bool{"a": true, "b": false}},
+	}
+
+	for _, test := range tests {
+		store := &memStore{reports: map[string]*dto.Report{"a": {ID: "a", Version: 1}, "b": {ID: "b", Version: 2}}}
+		m := newMemModel(store)
+
+		results, err := m.UpdateReports(context.Background(), &dto.Report{HasSymptom: true}, test.ids, test.versions)
+		assert.Nil(t, err, test.name)
+		assert.Len(t, results, len(test.expectedErrors), test.name)
+		for i, r := range results {
+			assert.Equal(t, test.ids[i], r.ID, test.name)
+			assert.Equal(t, test.expectedErrors[i], r.Error, test.name)
+		}
+		for id, symptom := range test.expectedSymptoms {
+			assert.Equal(t, symptom, store.reports[id].HasSymptom, test.name)
+		}
+	}
+}
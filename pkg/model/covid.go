@@ -12,12 +12,12 @@ func (m *Model) GetCovid(ctx context.Context, id string) (*dto.Covid, error) {
 	if err != nil {
 		return nil, err
 	}
-	c.Content = utility.CrawlStory(id)
+	c.Content = utility.CrawlStory(ctx, id)
 	return c, nil
 }
 
-// QueryCovids queries covids by sort, range, filter
-func (m *Model) QueryCovids(ctx context.Context, sort *dto.SortData, itemsRange *dto.RangeData, filter *dto.FilterData) (int64, []*dto.Covid, error) {
+// QueryCovids queries covids by filters, sorts and range or page, returns total, covids and next page token
+func (m *Model) QueryCovids(ctx context.Context, query *dto.QueryData) (int64, []*dto.Covid, string, error) {
 
 	// Commented the block that crawls everything and updates database
 	//for i := 0; i < 10; i++ {
@@ -31,5 +31,5 @@ func (m *Model) QueryCovids(ctx context.Context, sort *dto.SortData, itemsRange
 	//	}
 	//}
 
-	return m.covidDAO.Query(ctx, sort, itemsRange, filter)
+	return m.covidDAO.Query(ctx, query)
 }
@@ -6,37 +6,79 @@ import (
 	"galasejahtera/pkg/dto"
 	"galasejahtera/pkg/utility"
 	"strconv"
+	"time"
 )
 
 // GetDaily gets latest daily
 func (m *Model) GetDaily(ctx context.Context) (*dto.Daily, error) {
-	_, dailies, err := m.dailyDAO.Query(ctx, &dto.SortData{
-		Item:  constants.LastUpdated,
-		Order: constants.DESC,
-	}, nil, nil)
+	_, dailies, err := m.dailyDAO.Query(ctx, &dto.QueryData{
+		Sorts: []*dto.SortData{{Item: constants.LastUpdated, Order: constants.DESC}},
+		Range: &dto.RangeData{From: 0, To: 0},
+	})
 	if err != nil {
 		return nil, err
 	}
 	if len(dailies) == 0 {
 		return &dto.Daily{}, nil
 	}
+
+	// daily is classified when it is created, or by next UpdateDailies if created before classification
 	return dailies[0], nil
 }
 
+// GetDailies gets dailies within time range
+func (m *Model) GetDailies(ctx context.Context, startTime int64, endTime int64) ([]*dto.Daily, error) {
+	_, dailies, err := m.dailyDAO.QueryByTimeRange(ctx, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	return dailies, nil
+}
+
 // UpdateDaily updates dailies (called by scheduler)
 func (m *Model) UpdateDailies(ctx context.Context) error {
-	daily := utility.CrawlDaily()
-	total, _, err := m.dailyDAO.Query(nil, nil, nil, &dto.FilterData{
-		Item:  constants.LastUpdated,
-		Value: strconv.FormatInt(daily.LastUpdated, 10),
+	daily := utility.CrawlDaily(ctx)
+	total, existing, err := m.dailyDAO.Query(ctx, &dto.QueryData{
+		Filters: []*dto.FilterData{{
+			Item:     constants.LastUpdated,
+			Operator: constants.EQ,
+			Value:    strconv.FormatInt(daily.LastUpdated, 10),
+		}},
 	})
 	if err != nil {
 		return err
 	}
 	if total != 0 {
-		return nil
+		// classify daily created before risk classification is available
+		if utility.IsClassified(existing[0]) {
+			return nil
+		}
+		if err = m.classifyDaily(ctx, existing[0]); err != nil {
+			return err
+		}
+		return m.dailyDAO.UpdateStates(ctx, existing[0])
 	}
+
+	// classify districts against previous dailies
+	err = m.classifyDaily(ctx, daily)
+	if err != nil {
+		return err
+	}
+
 	// create new daily
 	_, err = m.dailyDAO.Create(ctx, daily)
 	return err
 }
+
+// classifyDaily sets active cases, trend and risk of daily using dailies of the last 15 days
+func (m *Model) classifyDaily(ctx context.Context, daily *dto.Daily) error {
+	endTime := daily.LastUpdated - 1
+	startTime := utility.TimeToMilli(utility.MilliToTime(daily.LastUpdated).Add(-24 * (constants.ActiveCaseDays + 1) * time.Hour))
+	_, dailies, err := m.dailyDAO.QueryByTimeRange(ctx, startTime, endTime)
+	if err != nil {
+		return err
+	}
+	previous, past := utility.FindClassifyingDailies(daily, dailies)
+	utility.ClassifyDaily(daily, previous, past)
+	return nil
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/utility"
+)
+
+// ImportDistrictBoundaries imports district boundaries from GeoJSON file
+func (m *Model) ImportDistrictBoundaries(ctx context.Context, path string) (int64, error) {
+	districts, err := utility.LoadDistrictBoundaries(path)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, district := range districts {
+		_, err = m.districtDAO.Upsert(ctx, district)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return int64(len(districts)), nil
+}
+
+// GetUserDistrict gets district boundary and latest district stats of user location
+func (m *Model) GetUserDistrict(ctx context.Context, user *dto.User) (*dto.DistrictBoundary, *dto.District, error) {
+	boundary, err := m.districtDAO.GetByLocation(ctx, user.Location)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	daily, err := m.GetDaily(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// boundary names are spelled differently from daily at times, so they are matched like searched places
+	district, ok := utility.FindDistrict(daily, boundary.State, boundary.Name)
+	if !ok {
+		match, _ := utility.MatchPlace(daily, boundary.Name)
+		if match != nil && !match.IsState && utility.CanonicalPlace(match.District.State) == utility.CanonicalPlace(boundary.State) {
+			district, ok = match.District, true
+		}
+	}
+
+	// district missing from daily, or without daily at all, cannot be classified
+	if !ok {
+		district = &dto.District{
+			Name:  boundary.Name,
+			State: boundary.State,
+			Trend: constants.TrendUnknown,
+			Risk:  constants.UnknownZone,
+		}
+	}
+	return boundary, district, nil
+}
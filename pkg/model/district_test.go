@@ -0,0 +1,48 @@
+package model
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGetUserDistrict ...
+func TestGetUserDistrict(t *testing.T) {
+	daily := &dto.Daily{States: []*dto.State{
+		{Name: "Johor", Districts: []*dto.District{
+			{Name: "Johor Bahru", Total: 120, Trend: constants.TrendUp, Risk: constants.RedZone},
+		}},
+		{Name: "Sabah", Districts: []*dto.District{
+			{Name: "Kota Kinabalu", Total: 8, Trend: constants.TrendFlat, Risk: constants.YellowZone},
+		}},
+	}}
+	tests := []struct {
+		name          string
+		boundary      *dto.DistrictBoundary
+		dailies       []*dto.Daily
+		expectedTotal int64
+		expectedTrend string
+		expectedRisk  string
+	}{
+		{name: "same name, should return district of daily", boundary: &dto.DistrictBoundary{State: "Johor", Name: "Johor Bahru"}, dailies: []*dto.Daily{daily}, expectedTotal: 120, expectedTrend: constants.TrendUp, expectedRisk: constants.RedZone},
+		{name: "alias name, should return district of daily", boundary: &dto.DistrictBoundary{State: "Johor", Name: "Johor Baru"}, dailies: []*dto.Daily{daily}, expectedTotal: 120, expectedTrend: constants.TrendUp, expectedRisk: constants.RedZone},
+		{name: "misspelt name, should return district of daily", boundary: &dto.DistrictBoundary{State: "Sabah", Name: "Kota Kinabalo"}, dailies: []*dto.Daily{daily}, expectedTotal: 8, expectedTrend: constants.TrendFlat, expectedRisk: constants.YellowZone},
+		{name: "district of other state, should return unknown", boundary: &dto.DistrictBoundary{State: "Selangor", Name: "Johor Bahru"}, dailies: []*dto.Daily{daily}, expectedTrend: constants.TrendUnknown, expectedRisk: constants.UnknownZone},
+		{name: "name not in daily, should return unknown", boundary: &dto.DistrictBoundary{State: "Johor", Name: "Segamat"}, dailies: []*dto.Daily{daily}, expectedTrend: constants.TrendUnknown, expectedRisk: constants.UnknownZone},
+		{name: "no daily, should return unknown", boundary: &dto.DistrictBoundary{State: "Johor", Name: "Johor Bahru"}, expectedTrend: constants.TrendUnknown, expectedRisk: constants.UnknownZone},
+	}
+
+	for _, test := range tests {
+		m := newMemModel(&memStore{boundary: test.boundary, dailies: test.dailies})
+
+		boundary, district, err := m.GetUserDistrict(context.Background(), newCaller())
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.boundary, boundary, test.name)
+		assert.Equal(t, test.expectedTotal, district.Total, test.name)
+		assert.Equal(t, test.expectedTrend, district.Trend, test.name)
+		assert.Equal(t, test.expectedRisk, district.Risk, test.name)
+	}
+}
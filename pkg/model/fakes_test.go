@@ -0,0 +1,388 @@
+package model
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dao"
+	"galasejahtera/pkg/dto"
+	"sort"
+)
+
+// memStore is in-process state of fake DAOs. Fakes only store and return entities by ID, query semantics are covered by
+// filter and pipeline tests of dao package
+type memStore struct {
+	users     map[string]*dto.User
+	reports   map[string]*dto.Report
+	locations []*dto.LocationHistory
+	// tokens are user IDs holding tokens
+	tokens map[string]bool
+	audits []*dto.Audit
+
+	// patched are fields set by last user patch
+	patched []string
+
+	// reportErr fails report deletes, auditErr fails audit writes
+	reportErr error
+	auditErr  error
+
+	// nearby is result of nearby query, queries, radius and lastUpdated record its calls
+	nearby      []*dto.User
+	queries     int
+	radius      float64
+	lastUpdated int64
+
+	// boundary is district boundary of every location, dailies are latest first
+	boundary *dto.DistrictBoundary
+	dailies  []*dto.Daily
+}
+
+// newMemModel creates model with fake DAOs of store
+func newMemModel(store *memStore) *Model {
+	if store.users == nil {
+		store.users = map[string]*dto.User{}
+	}
+	if store.reports == nil {
+		store.reports = map[string]*dto.Report{}
+	}
+	if store.tokens == nil {
+		store.tokens = map[string]bool{}
+	}
+	return &Model{
+		userDAO:        &memUserDAO{store: store},
+		reportDAO:      &memReportDAO{store: store},
+		locationDAO:    &memLocationDAO{store: store},
+		authDAO:        &memAuthDAO{store: store},
+		auditDAO:       &memAuditDAO{store: store},
+		transactionDAO: &memTransactionDAO{store: store},
+		districtDAO:    &memDistrictDAO{store: store},
+		dailyDAO:       &memDailyDAO{store: store},
+	}
+}
+
+// notFound is error of DAOs for missing entities
+var notFound = &dao.Error{Kind: dao.ErrNotFound, Err: context.Canceled}
+
+// memUserDAO is in-process user store
+type memUserDAO struct {
+	dao.IUserDAO
+	store *memStore
+}
+
+func (v *memUserDAO) Get(ctx context.Context, id string) (*dto.User, error) {
+	u, ok := v.store.users[id]
+	if !ok || u.DeletedAt != 0 {
+		return nil, notFound
+	}
+	copied := *u
+	return &copied, nil
+}
+
+func (v *memUserDAO) BatchGet(ctx context.Context, ids []string) ([]*dto.User, error) {
+	var users []*dto.User
+	for _, id := range ids {
+		if u, ok := v.store.users[id]; ok && u.DeletedAt == 0 {
+			copied := *u
+			users = append(users, &copied)
+		}
+	}
+	return users, nil
+}
+
+func (v *memUserDAO) Patch(ctx context.Context, user *dto.User, fields []string) (*dto.User, error) {
+	u := v.store.users[user.ID]
+	if u.Version != user.Version {
+		return nil, &dao.VersionError{Current: u.Version}
+	}
+	v.store.patched = fields
+	patchUser(u, user, fields)
+	for _, field := range fields {
+		if field == constants.Password {
+			u.Password = user.Password
+		}
+	}
+	u.Version++
+	user.Version++
+	return user, nil
+}
+
+func (v *memUserDAO) UpdateMany(ctx context.Context, ids []string, user *dto.User, fields []string) (int64, error) {
+	var matched int64
+	for _, id := range ids {
+		if u, ok := v.store.users[id]; ok && u.DeletedAt == 0 {
+			patchUser(u, user, fields)
+			u.Version++
+			matched++
+		}
+	}
+	return matched, nil
+}
+
+func (v *memUserDAO) Delete(ctx context.Context, id string, deletedAt int64) error {
+	u, ok := v.store.users[id]
+	if !ok || u.DeletedAt != 0 {
+		return notFound
+	}
+	u.DeletedAt = deletedAt
+	return nil
+}
+
+func (v *memUserDAO) GetDeleted(ctx context.Context, deletedBefore int64, limit int64) ([]string, error) {
+	var ids []string
+	for _, id := range userIDs(v.store.users) {
+		u := v.store.users[id]
+		if u.DeletedAt != 0 && u.DeletedAt < deletedBefore && int64(len(ids)) < limit {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (v *memUserDAO) Purge(ctx context.Context, ids []string) (int64, error) {
+	var purged int64
+	for _, id := range ids {
+		if _, ok := v.store.users[id]; ok {
+			delete(v.store.users, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+func (v *memUserDAO) GetNearbySymptomaticUsers(ctx context.Context, user *dto.User, radius float64, lastUpdated int64) (int64, []*dto.User, error) {
+	v.store.queries++
+	v.store.radius = radius
+	v.store.lastUpdated = lastUpdated
+	return int64(len(v.store.nearby)), v.store.nearby, nil
+}
+
+// memReportDAO is in-process report store
+type memReportDAO struct {
+	dao.IReportDAO
+	store *memStore
+}
+
+func (v *memReportDAO) Get(ctx context.Context, id string) (*dto.Report, error) {
+	r, ok := v.store.reports[id]
+	if !ok || r.DeletedAt != 0 {
+		return nil, notFound
+	}
+	copied := *r
+	return &copied, nil
+}
+
+func (v *memReportDAO) BatchGet(ctx context.Context, ids []string) ([]*dto.Report, error) {
+	var reports []*dto.Report
+	for _, id := range ids {
+		if r, ok := v.store.reports[id]; ok && r.DeletedAt == 0 {
+			copied := *r
+			reports = append(reports, &copied)
+		}
+	}
+	return reports, nil
+}
+
+func (v *memReportDAO) Patch(ctx context.Context, report *dto.Report, fields []string) (*dto.Report, error) {
+	if current := v.store.reports[report.ID].Version; current != report.Version {
+		return nil, &dao.VersionError{Current: current}
+	}
+	report.Version++
+	copied := *report
+	v.store.reports[report.ID] = &copied
+	return report, nil
+}
+
+func (v *memReportDAO) UpdateMany(ctx context.Context, ids []string, report *dto.Report) (int64, error) {
+	var matched int64
+	for _, id := range ids {
+		if r, ok := v.store.reports[id]; ok && r.DeletedAt == 0 {
+			r.HasSymptom = report.HasSymptom
+			r.Version++
+			matched++
+		}
+	}
+	return matched, nil
+}
+
+func (v *memReportDAO) BatchDelete(ctx context.Context, ids []string, deletedAt int64) (int64, error) {
+	if v.store.reportErr != nil {
+		return 0, v.store.reportErr
+	}
+	var deleted int64
+	for _, id := range ids {
+		if r, ok := v.store.reports[id]; ok && r.DeletedAt == 0 {
+			r.DeletedAt = deletedAt
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (v *memReportDAO) Purge(ctx context.Context, deletedBefore int64) (int64, error) {
+	var purged int64
+	for id, r := range v.store.reports {
+		if r.DeletedAt != 0 && r.DeletedAt < deletedBefore {
+			delete(v.store.reports, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+func (v *memReportDAO) PurgeByUserIDs(ctx context.Context, userIDs []string) (int64, error) {
+	var purged int64
+	for id, r := range v.store.reports {
+		if contains(userIDs, r.UserID) {
+			delete(v.store.reports, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// memLocationDAO is in-process location history store
+type memLocationDAO struct {
+	dao.ILocationDAO
+	store *memStore
+}
+
+func (v *memLocationDAO) Create(ctx context.Context, location *dto.LocationHistory) (*dto.LocationHistory, error) {
+	v.store.locations = append(v.store.locations, location)
+	return location, nil
+}
+
+func (v *memLocationDAO) DeleteByUserIDs(ctx context.Context, userIDs []string) (int64, error) {
+	var locations []*dto.LocationHistory
+	for _, l := range v.store.locations {
+		if !contains(userIDs, l.UserID) {
+			locations = append(locations, l)
+		}
+	}
+	deleted := int64(len(v.store.locations) - len(locations))
+	v.store.locations = locations
+	return deleted, nil
+}
+
+// memAuthDAO is in-process token store keyed by user ID
+type memAuthDAO struct {
+	dao.IAuthDAO
+	store *memStore
+}
+
+func (v *memAuthDAO) DeleteByIDs(ctx context.Context, ids []string) error {
+	for _, id := range ids {
+		delete(v.store.tokens, id)
+	}
+	return nil
+}
+
+func (v *memAuthDAO) PurgeByUserIDs(ctx context.Context, userIDs []string) (int64, error) {
+	var purged int64
+	for _, id := range userIDs {
+		if v.store.tokens[id] {
+			delete(v.store.tokens, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// memAuditDAO is in-process audit log
+type memAuditDAO struct {
+	dao.IAuditDAO
+	store *memStore
+}
+
+func (v *memAuditDAO) BatchCreate(ctx context.Context, audits []*dto.Audit) error {
+	if v.store.auditErr != nil {
+		return v.store.auditErr
+	}
+	v.store.audits = append(v.store.audits, audits...)
+	return nil
+}
+
+// memTransactionDAO runs fn against store and restores all of it if fn fails
+type memTransactionDAO struct {
+	store *memStore
+}
+
+func (v *memTransactionDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	users := map[string]*dto.User{}
+	for id, u := range v.store.users {
+		copied := *u
+		users[id] = &copied
+	}
+	reports := map[string]*dto.Report{}
+	for id, r := range v.store.reports {
+		copied := *r
+		reports[id] = &copied
+	}
+	tokens := map[string]bool{}
+	for id, t := range v.store.tokens {
+		tokens[id] = t
+	}
+	locations := append([]*dto.LocationHistory(nil), v.store.locations...)
+	audits := append([]*dto.Audit(nil), v.store.audits...)
+
+	if err := fn(ctx); err != nil {
+		v.store.users = users
+		v.store.reports = reports
+		v.store.tokens = tokens
+		v.store.locations = locations
+		v.store.audits = audits
+		return err
+	}
+	return nil
+}
+
+// memDistrictDAO is in-process district boundary store
+type memDistrictDAO struct {
+	dao.IDistrictDAO
+	store *memStore
+}
+
+func (v *memDistrictDAO) GetByLocation(ctx context.Context, location *dto.Location) (*dto.DistrictBoundary, error) {
+	if v.store.boundary == nil {
+		return nil, notFound
+	}
+	return v.store.boundary, nil
+}
+
+// memDailyDAO is in-process daily store, queries return all dailies
+type memDailyDAO struct {
+	dao.IDailyDAO
+	store *memStore
+}
+
+func (v *memDailyDAO) Query(ctx context.Context, query *dto.QueryData) (int64, []*dto.Daily, error) {
+	return int64(len(v.store.dailies)), v.store.dailies, nil
+}
+
+// userIDs returns sorted IDs of users
+func userIDs(users map[string]*dto.User) []string {
+	var ids []string
+	for id := range users {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// reportIDs returns sorted IDs of reports
+func reportIDs(reports map[string]*dto.Report) []string {
+	var ids []string
+	for id := range reports {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// tokenIDs returns sorted IDs of users holding tokens
+func tokenIDs(tokens map[string]bool) []string {
+	var ids []string
+	for id := range tokens {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
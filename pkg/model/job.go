@@ -0,0 +1,21 @@
+package model
+
+import (
+	"context"
+	"galasejahtera/pkg/dto"
+)
+
+// AcquireJobLease acquires job lease for owner until leaseUntil unless job already ran since slot
+func (m *Model) AcquireJobLease(ctx context.Context, name string, owner string, now int64, leaseUntil int64, slot int64) (bool, error) {
+	return m.jobDAO.Acquire(ctx, name, owner, now, leaseUntil, slot)
+}
+
+// ReleaseJobLease releases job lease and records run status
+func (m *Model) ReleaseJobLease(ctx context.Context, job *dto.Job) error {
+	return m.jobDAO.Release(ctx, job)
+}
+
+// GetJobs gets run status of all jobs
+func (m *Model) GetJobs(ctx context.Context) ([]*dto.Job, error) {
+	return m.jobDAO.Query(ctx)
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/utility"
+	"time"
+
+	"github.com/twinj/uuid"
+)
+
+// RecordLocation appends user location to location history, kept for LOCATION_RETENTION
+func (m *Model) RecordLocation(ctx context.Context, user *dto.User) (*dto.LocationHistory, error) {
+	if user.Location == nil || len(user.Location.Coordinates) != 2 {
+		return nil, constants.InvalidArgumentError
+	}
+
+	now := utility.MalaysiaTime(time.Now())
+	retention := utility.GetEnvDuration("LOCATION_RETENTION", constants.LocationRetention)
+	return m.locationDAO.Create(ctx, &dto.LocationHistory{
+		ID:        uuid.NewV4().String(),
+		UserID:    user.ID,
+		Location:  user.Location,
+		Geohash:   utility.EncodeGeohash(user.Lat, user.Long, constants.LocationGeohashPrecision),
+		CreatedAt: utility.TimeToMilli(now),
+		TTL:       now.Add(retention),
+	})
+}
+
+// GetHeatmap gets number of active users per geohash cell within bounding box and time range
+func (m *Model) GetHeatmap(ctx context.Context, box *dto.BoundingBox, startTime int64, endTime int64, precision int) ([]*dto.HeatmapCell, error) {
+	cells, err := m.locationDAO.Heatmap(ctx, box, startTime, endTime, precision,
+		int64(utility.GetEnvInt("HEATMAP_MIN_COUNT", constants.HeatmapMinCount)), constants.MaxHeatmapCells)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cell := range cells {
+		cell.Lat, cell.Long, _, _ = utility.DecodeGeohash(cell.Geohash)
+	}
+	return cells, nil
+}
@@ -8,20 +8,32 @@ import (
 
 // Model ...
 type Model struct {
-	userDAO   dao.IUserDAO
-	authDAO   dao.IAuthDAO
-	reportDAO dao.IReportDAO
-	covidDAO  dao.ICovidDAO
-	dailyDAO  dao.IDailyDAO
+	userDAO     dao.IUserDAO
+	authDAO     dao.IAuthDAO
+	reportDAO   dao.IReportDAO
+	covidDAO    dao.ICovidDAO
+	dailyDAO    dao.IDailyDAO
+	districtDAO dao.IDistrictDAO
+	jobDAO      dao.IJobDAO
+	locationDAO dao.ILocationDAO
+	auditDAO    dao.IAuditDAO
+	// transactionDAO runs batch operations atomically
+	transactionDAO dao.ITransactionDAO
 }
 
 // InitModel ...
 func InitModel(client *mongo.Client) IModel {
 	return &Model{
-		userDAO:   dao.InitUserDAO(client),
-		authDAO:   dao.InitAuthDAO(client),
-		reportDAO: dao.InitReportDAO(client),
-		covidDAO:  dao.InitCovidDAO(client),
-		dailyDAO:  dao.InitDailyDAO(client),
+		userDAO:     dao.InitUserDAO(client),
+		authDAO:     dao.InitAuthDAO(client),
+		reportDAO:   dao.InitReportDAO(client),
+		covidDAO:    dao.InitCovidDAO(client),
+		dailyDAO:    dao.InitDailyDAO(client),
+		districtDAO: dao.InitDistrictDAO(client),
+		jobDAO:      dao.InitJobDAO(client),
+		locationDAO: dao.InitLocationDAO(client),
+		auditDAO:    dao.InitAuditDAO(client),
+
+		transactionDAO: dao.InitTransactionDAO(client),
 	}
 }
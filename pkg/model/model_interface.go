@@ -10,9 +10,9 @@ type IModel interface {
 	///////////// User models
 	// CreateUser creates new user
 	CreateUser(ctx context.Context, user *dto.User) (*dto.User, error)
-	// UpdateUser updates user
-	UpdateUser(ctx context.Context, user *dto.User) (*dto.User, error)
-	// UpdateUserPassword updates user password only
+	// UpdateUser updates fields of user in mask, all updatable fields if mask is empty, caller is checked for permission of each field
+	UpdateUser(ctx context.Context, user *dto.User, mask []string, caller *dto.User) (*dto.User, error)
+	// UpdateUserPassword updates user password only and records reset in audit log
 	UpdateUserPassword(ctx context.Context, user *dto.User) (*dto.User, error)
 	// CreateToken creates token with custom ttl
 	CreateToken(ctx context.Context, auth *dto.AuthObject) (*dto.AuthObject, error)
@@ -20,20 +20,29 @@ type IModel interface {
 	RevokeTokensByUserID(ctx context.Context, id string) error
 	// GetUserIDByToken gets userID by token
 	GetUserIDByToken(ctx context.Context, token string) (string, error)
-	// UpdateUsers update users
-	UpdateUsers(ctx context.Context, user *dto.User, ids []string) ([]string, error)
+	// UpdateUsers updates fields of users by IDs in mask in a transaction, users listed in versions only at their
+	// version, and records changes by caller in audit log, returns result per ID
+	UpdateUsers(ctx context.Context, user *dto.User, ids []string, mask []string, versions map[string]int64, caller *dto.User) ([]*dto.BatchResult, error)
 	// GetUser gets user by ID
 	GetUser(ctx context.Context, id string) (*dto.User, error)
+	// GetUserByEmail gets user by case-insensitive email
+	GetUserByEmail(ctx context.Context, email string) (*dto.User, error)
 	// BatchGetUsers get users by slice of IDs
 	BatchGetUsers(ctx context.Context, ids []string) ([]*dto.User, error)
-	// QueryUsers queries users by sort, range, filter
-	QueryUsers(ctx context.Context, sort *dto.SortData, itemsRange *dto.RangeData, filter *dto.FilterData) (int64, []*dto.User, error)
-	// DeleteUser deletes user by ID
-	DeleteUser(ctx context.Context, id string) (*dto.User, error)
+	// QueryUsers queries users by filters, sorts and range or page, returns total, users and next page token
+	QueryUsers(ctx context.Context, query *dto.QueryData) (int64, []*dto.User, string, error)
+	// DeleteUser soft deletes user and its reports by ID and revokes its tokens, deletion by caller is audited
+	DeleteUser(ctx context.Context, id string, caller *dto.User) (*dto.User, error)
+	// RestoreUser restores soft deleted user by ID along with reports deleted with it, restore by caller is audited
+	RestoreUser(ctx context.Context, id string, caller *dto.User) (*dto.User, error)
+	// ExportUserData gets all personal data held of user by ID, password is left out
+	ExportUserData(ctx context.Context, id string) (*dto.UserData, error)
+	// EraseUser hard deletes user by ID along with its reports, tokens and location history and records it in audit log
+	EraseUser(ctx context.Context, id string) error
 	// RevokeUserTokens revoke all user tokens
 	RevokeUserTokens(ctx context.Context) error
-	// DeleteUsers delete users by IDs
-	DeleteUsers(ctx context.Context, ids []string) ([]string, error)
+	// DeleteUsers deletes users and their tokens by IDs in a transaction, deletion by caller is audited, returns result per ID
+	DeleteUsers(ctx context.Context, ids []string, caller *dto.User) ([]*dto.BatchResult, error)
 	// Login verifies user by email and password and return tokens
 	Login(ctx context.Context, email string, password string) (*dto.User, error)
 	// VerifyUser verifies user by header
@@ -43,9 +52,15 @@ type IModel interface {
 	// Refresh returns new token to authorized user by header
 	Refresh(ctx context.Context, header string) (*dto.User, error)
 	// GetNearbyUsers get nearby users count given user
-	GetNearbyUsers(ctx context.Context, user *dto.User) (int64, []*dto.User, error)
-	// DisableInactiveUsers disable inactive users
-	DisableInactiveUsers(ctx context.Context) error
+	GetNearbyUsers(ctx context.Context, user *dto.User, query *dto.NearbyQuery) (int64, []*dto.User, error)
+	// GetNearbyCells get nearby users count given user, grouped by geohash cell
+	GetNearbyCells(ctx context.Context, user *dto.User, query *dto.NearbyQuery) (int64, []*dto.NearbyCell, error)
+	// DisableInactiveUsers disables users without recent location update, returns number of users disabled
+	DisableInactiveUsers(ctx context.Context) (int64, error)
+	// CountActiveUsers counts users with recent location update
+	CountActiveUsers(ctx context.Context) (int64, error)
+	// PurgeDeleted hard deletes users and reports soft deleted before retention along with their data, returns number of users and reports purged
+	PurgeDeleted(ctx context.Context) (int64, int64, error)
 	/////////////
 
 	///////////// Report models
@@ -55,27 +70,60 @@ type IModel interface {
 	GetReport(ctx context.Context, id string) (*dto.Report, error)
 	// BatchGetReports get reports by slice of IDs
 	BatchGetReports(ctx context.Context, ids []string) ([]*dto.Report, error)
-	// QueryReports queries reports by sort, range, filter
-	QueryReports(ctx context.Context, sort *dto.SortData, itemsRange *dto.RangeData, filter *dto.FilterData) (int64, []*dto.Report, error)
-	// DeleteReport deletes report by ID
-	DeleteReport(ctx context.Context, id string) (*dto.Report, error)
-	// DeleteReports delete reports by IDs
-	DeleteReports(ctx context.Context, ids []string) ([]string, error)
-	// UpdateZone updates zone
-	UpdateReport(ctx context.Context, report *dto.Report) (*dto.Report, error)
-	// UpdateZones update reports
-	UpdateReports(ctx context.Context, report *dto.Report, ids []string) ([]string, error)
+	// QueryReports queries reports by filters, sorts and range or page, returns total, reports and next page token
+	QueryReports(ctx context.Context, query *dto.QueryData) (int64, []*dto.Report, string, error)
+	// DeleteReport soft deletes report by ID, deletion by caller is audited
+	DeleteReport(ctx context.Context, id string, caller *dto.User) (*dto.Report, error)
+	// RestoreReport restores soft deleted report by ID, restore by caller is audited
+	RestoreReport(ctx context.Context, id string, caller *dto.User) (*dto.Report, error)
+	// DeleteReports deletes reports by IDs in a transaction, deletion by caller is audited, returns result per ID
+	DeleteReports(ctx context.Context, ids []string, caller *dto.User) ([]*dto.BatchResult, error)
+	// UpdateReport updates fields of report in mask, all updatable fields if mask is empty, caller must own report unless admin
+	UpdateReport(ctx context.Context, report *dto.Report, mask []string, caller *dto.User) (*dto.Report, error)
+	// UpdateReports updates reports by IDs in a transaction, reports listed in versions only at their version, returns
+	// result per ID
+	UpdateReports(ctx context.Context, report *dto.Report, ids []string, versions map[string]int64) ([]*dto.BatchResult, error)
 	/////////////
 
 	///////////// Covid models
 	// GetCovid gets activity by ID
 	GetCovid(ctx context.Context, id string) (*dto.Covid, error)
-	// QueryCovids queries covids by sort, range, filter
-	QueryCovids(ctx context.Context, sort *dto.SortData, itemsRange *dto.RangeData, filter *dto.FilterData) (int64, []*dto.Covid, error)
+	// QueryCovids queries covids by filters, sorts and range or page, returns total, covids and next page token
+	QueryCovids(ctx context.Context, query *dto.QueryData) (int64, []*dto.Covid, string, error)
 	/////////////
 
 	///////////// Daily models
 	GetDaily(ctx context.Context) (*dto.Daily, error)
+	// GetDailies gets dailies within time range
+	GetDailies(ctx context.Context, startTime int64, endTime int64) ([]*dto.Daily, error)
 	UpdateDailies(ctx context.Context) error
 	/////////////
+
+	///////////// District models
+	// ImportDistrictBoundaries imports district boundaries from GeoJSON file
+	ImportDistrictBoundaries(ctx context.Context, path string) (int64, error)
+	// GetUserDistrict gets district boundary and latest district stats of user location
+	GetUserDistrict(ctx context.Context, user *dto.User) (*dto.DistrictBoundary, *dto.District, error)
+	/////////////
+
+	///////////// Location models
+	// RecordLocation appends user location to location history
+	RecordLocation(ctx context.Context, user *dto.User) (*dto.LocationHistory, error)
+	// GetHeatmap gets number of active users per geohash cell within bounding box and time range
+	GetHeatmap(ctx context.Context, box *dto.BoundingBox, startTime int64, endTime int64, precision int) ([]*dto.HeatmapCell, error)
+	/////////////
+
+	///////////// Job models
+	// AcquireJobLease acquires job lease for owner until leaseUntil unless job already ran since slot
+	AcquireJobLease(ctx context.Context, name string, owner string, now int64, leaseUntil int64, slot int64) (bool, error)
+	// ReleaseJobLease releases job lease and records run status
+	ReleaseJobLease(ctx context.Context, job *dto.Job) error
+	// GetJobs gets run status of all jobs
+	GetJobs(ctx context.Context) ([]*dto.Job, error)
+	/////////////
+
+	///////////// Audit models
+	// QueryAudits queries audit records by filters, sorts and range or page, returns total, audit records and next page token
+	QueryAudits(ctx context.Context, query *dto.QueryData) (int64, []*dto.Audit, string, error)
+	/////////////
 }
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"sort"
+)
+
+// fieldPermission is who may update a field by mask
+type fieldPermission int
+
+const (
+	// ownerField can be updated by owner of entity and admins
+	ownerField fieldPermission = iota
+	// adminField can only be changed by admins, owner may set it to its current value
+	adminField
+)
+
+// userPatchFields lists fields of user updatable by mask
+var userPatchFields = map[string]fieldPermission{
+	constants.Role:     adminField,
+	constants.Email:    ownerField,
+	constants.IsActive: adminField,
+	constants.Lat:      ownerField,
+	constants.Long:     ownerField,
+	constants.Name:     ownerField,
+}
+
+// reportPatchFields lists fields of report updatable by mask
+var reportPatchFields = map[string]fieldPermission{
+	constants.HasSymptom: ownerField,
+}
+
+// controlFields are fields of payload that identify or version entity rather than update it, gateway fills them into
+// mask from body keys
+var controlFields = map[string]bool{
+	constants.ID:      true,
+	constants.Version: true,
+}
+
+// patchFields validates mask against updatable fields and returns fields to update, all updatable fields if mask is
+// empty. Caller other than admin must be owner and may not change admin fields, changed reports whether value of field
+// differs from current one. Nil caller is trusted.
+func patchFields(mask []string, fields map[string]fieldPermission, caller *dto.User, owner string, changed func(field string) bool) ([]string, error) {
+	selected, err := maskFields(mask, fields)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkFields(selected, fields, caller, owner, changed); err != nil {
+		return nil, err
+	}
+	return selected, nil
+}
+
+// maskFields validates mask against updatable fields and returns fields to update, all updatable fields if mask is
+// empty and none if it only has control fields
+func maskFields(mask []string, fields map[string]fieldPermission) ([]string, error) {
+	var selected []string
+	seen := map[string]bool{}
+	for _, field := range mask {
+		if controlFields[field] {
+			continue
+		}
+		if _, ok := fields[field]; !ok {
+			return nil, constants.InvalidFieldMaskError
+		}
+		if !seen[field] {
+			seen[field] = true
+			selected = append(selected, field)
+		}
+	}
+	if len(mask) == 0 {
+		for field := range fields {
+			selected = append(selected, field)
+		}
+		sort.Strings(selected)
+	}
+	return selected, nil
+}
+
+// checkFields checks permission of caller to update fields of entity of owner, see patchFields
+func checkFields(selected []string, fields map[string]fieldPermission, caller *dto.User, owner string, changed func(field string) bool) error {
+	if caller == nil || caller.Role == constants.Admin {
+		return nil
+	}
+	if caller.ID != owner {
+		return constants.PermissionDeniedError
+	}
+	for _, field := range selected {
+		if fields[field] == adminField && changed(field) {
+			return constants.PermissionDeniedError
+		}
+	}
+	return nil
+}
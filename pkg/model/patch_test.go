@@ -0,0 +1,45 @@
+package model
+
+import (
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPatchFields ...
+func TestPatchFields(t *testing.T) {
+	owner := &dto.User{ID: "owner", Role: constants.User}
+	admin := &dto.User{ID: "admin", Role: constants.Admin}
+	other := &dto.User{ID: "other", Role: constants.User}
+
+	tests := []struct {
+		name           string
+		mask           []string
+		caller         *dto.User
+		changed        bool
+		expectedResult []string
+		expectedError  error
+	}{
+		{name: "empty mask, should select all updatable fields", caller: admin, changed: true, expectedResult: []string{"email", "isActive", "lat", "long", "name", "role"}},
+		{name: "control fields, should be skipped", mask: []string{"id", "name", "version"}, caller: owner, expectedResult: []string{"name"}},
+		{name: "only control fields, should select nothing", mask: []string{"id", "version"}, caller: owner},
+		{name: "duplicated fields, should be selected once", mask: []string{"name", "name"}, caller: owner, expectedResult: []string{"name"}},
+		{name: "unknown field, should be rejected", mask: []string{"password"}, caller: admin, expectedError: constants.InvalidFieldMaskError},
+		{name: "owner changes own name, should be allowed", mask: []string{"name", "lat"}, caller: owner, changed: true, expectedResult: []string{"name", "lat"}},
+		{name: "owner changes own role, should be denied", mask: []string{"role"}, caller: owner, changed: true, expectedError: constants.PermissionDeniedError},
+		{name: "owner keeps own role, should be allowed", mask: []string{"role"}, caller: owner, expectedResult: []string{"role"}},
+		{name: "admin changes role, should be allowed", mask: []string{"role"}, caller: admin, changed: true, expectedResult: []string{"role"}},
+		{name: "other user changes name, should be denied", mask: []string{"name"}, caller: other, changed: true, expectedError: constants.PermissionDeniedError},
+		{name: "trusted caller changes role, should be allowed", mask: []string{"role"}, changed: true, expectedResult: []string{"role"}},
+	}
+
+	for _, test := range tests {
+		fields, err := patchFields(test.mask, userPatchFields, test.caller, owner.ID, func(field string) bool {
+			return test.changed
+		})
+		assert.Equal(t, test.expectedError, err, test.name)
+		assert.Equal(t, test.expectedResult, fields, test.name)
+	}
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/logger"
+	"galasejahtera/pkg/utility"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// PurgeDeleted hard deletes users and reports soft deleted before DELETED_RETENTION, purging a user also purges its
+// reports, tokens and location history. Returns number of users and reports purged.
+func (m *Model) PurgeDeleted(ctx context.Context) (int64, int64, error) {
+	retention := utility.GetEnvDuration("DELETED_RETENTION", constants.DeletedRetention)
+	deletedBefore := utility.TimeToMilli(utility.MalaysiaTime(time.Now().Add(-retention)))
+
+	// users are purged in batches, each batch with its data in a transaction, so that users restored meanwhile are kept
+	var users, reports int64
+	for {
+		var ids []string
+		var purged, purgedReports int64
+		err := m.transactionDAO.WithTransaction(ctx, func(ctx context.Context) error {
+			var err error
+			ids, err = m.userDAO.GetDeleted(ctx, deletedBefore, constants.PurgeBatchSize)
+			if err != nil || len(ids) == 0 {
+				return err
+			}
+			purgedReports, err = m.reportDAO.PurgeByUserIDs(ctx, ids)
+			if err != nil {
+				return err
+			}
+			if _, err = m.authDAO.PurgeByUserIDs(ctx, ids); err != nil {
+				return err
+			}
+			if _, err = m.locationDAO.DeleteByUserIDs(ctx, ids); err != nil {
+				return err
+			}
+			purged, err = m.userDAO.Purge(ctx, ids)
+			return err
+		})
+		if err != nil {
+			return users, reports, err
+		}
+		users += purged
+		reports += purgedReports
+		if purged == 0 {
+			break
+		}
+	}
+
+	// reports deleted on their own
+	purgedReports, err := m.reportDAO.Purge(ctx, deletedBefore)
+	if err != nil {
+		return users, reports, err
+	}
+	reports += purgedReports
+
+	logger.WithContext(ctx).Info("purged deleted users and reports", zap.Int64("users", users), zap.Int64("reports", reports),
+		zap.Duration("retention", retention))
+	return users, reports, nil
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/logger"
+	"galasejahtera/pkg/utility"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// TestPurgeDeleted ...
+func TestPurgeDeleted(t *testing.T) {
+	logger.Log = zap.NewNop()
+	now := utility.TimeToMilli(utility.MalaysiaTime(time.Now()))
+	expired := now - (constants.DeletedRetention + time.Hour).Milliseconds()
+
+	store := &memStore{users: map[string]*dto.User{
+		"active":  {ID: "active"},
+		"recent":  {ID: "recent", DeletedAt: now},
+		"expired": {ID: "expired", DeletedAt: expired},
+	}, reports: map[string]*dto.Report{
+		"active":         {ID: "active", UserID: "active"},
+		"active-expired": {ID: "active-expired", UserID: "active", DeletedAt: expired},
+		"recent":         {ID: "recent", UserID: "recent", DeletedAt: now},
+		"expired":        {ID: "expired", UserID: "expired", DeletedAt: expired},
+		"expired-active": {ID: "expired-active", UserID: "expired"},
+	}, locations: []*dto.LocationHistory{{UserID: "active"}, {UserID: "expired"}},
+		tokens: map[string]bool{"active": true, "expired": true}}
+	m := newMemModel(store)
+
+	purgedUsers, purgedReports, err := m.PurgeDeleted(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), purgedUsers)
+	assert.Equal(t, int64(3), purgedReports)
+	assert.Equal(t, []string{"active", "recent"}, userIDs(store.users))
+	assert.Equal(t, []string{"active", "recent"}, reportIDs(store.reports))
+	assert.Equal(t, []*dto.LocationHistory{{UserID: "active"}}, store.locations)
+	assert.Equal(t, map[string]bool{"active": true}, store.tokens)
+}
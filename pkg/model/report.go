@@ -2,11 +2,14 @@ package model
 
 import (
 	"context"
+	"errors"
 	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dao"
 	"galasejahtera/pkg/dto"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"galasejahtera/pkg/metrics"
+	"galasejahtera/pkg/utility"
+	"strconv"
+	"time"
 )
 
 // CreateReport creates new report
@@ -16,30 +19,51 @@ func (m *Model) CreateReport(ctx context.Context, report *dto.Report) (*dto.Repo
 	_, err := m.reportDAO.Get(ctx, report.ID)
 
 	// only can create report if not found
-	if err != nil && status.Code(err) == codes.Unknown {
-		return m.reportDAO.Create(ctx, report)
+	if errors.Is(err, dao.ErrNotFound) {
+		r, err := m.reportDAO.Create(ctx, report)
+		if err != nil {
+			return nil, err
+		}
+		metrics.ReportsCreated.WithLabelValues(strconv.FormatBool(r.HasSymptom)).Inc()
+		return r, nil
 	}
 
 	if err != nil {
 		return nil, err
 	}
 
-	return nil, status.Error(codes.AlreadyExists, "Report already exist!")
+	return nil, constants.ReportAlreadyExistError
 }
 
-// UpdateReport updates report
-func (m *Model) UpdateReport(ctx context.Context, report *dto.Report) (*dto.Report, error) {
+// UpdateReport updates fields of report in mask, all updatable fields if mask is empty, caller must own report unless admin.
+// Update is rejected with dao.VersionError if report is not at version of report, unless it is 0
+func (m *Model) UpdateReport(ctx context.Context, report *dto.Report, mask []string, caller *dto.User) (*dto.Report, error) {
 
-	// check if report exists
+	// check if report exists and is at version expected by client, if any
 	f, err := m.reportDAO.Get(ctx, report.ID)
 	if err != nil {
 		return nil, err
 	}
+	if report.Version != 0 && report.Version != f.Version {
+		return nil, &dao.VersionError{Current: f.Version}
+	}
+
+	fields, err := patchFields(mask, reportPatchFields, caller, f.UserID, func(field string) bool {
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	// patch report
-	f.HasSymptom = report.HasSymptom
+	for _, field := range fields {
+		switch field {
+		case constants.HasSymptom:
+			f.HasSymptom = report.HasSymptom
+		}
+	}
 
-	_, err = m.reportDAO.Update(ctx, f)
+	_, err = m.reportDAO.Patch(ctx, f, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -47,17 +71,48 @@ func (m *Model) UpdateReport(ctx context.Context, report *dto.Report) (*dto.Repo
 	return f, nil
 }
 
-// UpdateReports update reports
-func (m *Model) UpdateReports(ctx context.Context, report *dto.Report, ids []string) ([]string, error) {
-	if len(ids) > 1 {
-		return nil, constants.OperationUnsupportedError
+// UpdateReports updates reports by IDs in a transaction, report listed in versions is not updated with
+// dao.VersionError if it is not at its version, returns result per ID
+func (m *Model) UpdateReports(ctx context.Context, report *dto.Report, ids []string, versions map[string]int64) ([]*dto.BatchResult, error) {
+	ids, err := batchIDs(ids)
+	if err != nil {
+		return nil, err
 	}
-	report.ID = ids[0]
-	u, err := m.UpdateReport(ctx, report)
+
+	var results []*dto.BatchResult
+	err = m.transactionDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		reports, err := m.reportDAO.BatchGet(ctx, ids)
+		if err != nil {
+			return err
+		}
+
+		found := map[string]bool{}
+		failed := map[string]error{}
+		var updateIDs []string
+		for _, r := range reports {
+			found[r.ID] = true
+			if version := versions[r.ID]; version != 0 && version != r.Version {
+				failed[r.ID] = &dao.VersionError{Current: r.Version}
+				continue
+			}
+			updateIDs = append(updateIDs, r.ID)
+		}
+
+		if len(updateIDs) > 0 {
+			_, err = m.reportDAO.UpdateMany(ctx, updateIDs, report)
+			if err != nil {
+				return err
+			}
+		}
+
+		results = batchFailed(batchResults(ids, found, constants.ReportNotFoundError), failed)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	return []string{u.ID}, err
+
+	return results, nil
 }
 
 // GetReport gets report by ID
@@ -70,37 +125,110 @@ func (m *Model) BatchGetReports(ctx context.Context, ids []string) ([]*dto.Repor
 	return m.reportDAO.BatchGet(ctx, ids)
 }
 
-// QueryReports queries reports by sort, range, filter
-func (m *Model) QueryReports(ctx context.Context, sort *dto.SortData, itemsRange *dto.RangeData, filter *dto.FilterData) (int64, []*dto.Report, error) {
-	return m.reportDAO.Query(ctx, sort, itemsRange, filter)
+// QueryReports queries reports by filters, sorts and range or page, returns total, reports and next page token
+func (m *Model) QueryReports(ctx context.Context, query *dto.QueryData) (int64, []*dto.Report, string, error) {
+	return m.reportDAO.Query(ctx, query)
 }
 
-// DeleteReport deletes report by ID
-func (m *Model) DeleteReport(ctx context.Context, id string) (*dto.Report, error) {
-	// check if report exist
-	u, err := m.reportDAO.Get(ctx, id)
+// DeleteReport soft deletes report by ID, report is purged after DELETED_RETENTION. Deletion by caller is recorded in
+// audit log
+func (m *Model) DeleteReport(ctx context.Context, id string, caller *dto.User) (*dto.Report, error) {
+	var u *dto.Report
+	err := m.transactionDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		// check if report exist
+		var err error
+		u, err = m.reportDAO.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		u.DeletedAt = utility.TimeToMilli(utility.MalaysiaTime(time.Now()))
+		err = m.reportDAO.Delete(ctx, id, u.DeletedAt)
+		if err != nil {
+			return err
+		}
+
+		return m.audit(ctx, caller, constants.AuditDeleteReport, &dto.Audit{
+			Target: id,
+			After:  map[string]interface{}{constants.DeletedAt: u.DeletedAt},
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	err = m.reportDAO.Delete(ctx, id)
+	return u, nil
+}
+
+// RestoreReport restores soft deleted report by ID, restore by caller is recorded in audit log
+func (m *Model) RestoreReport(ctx context.Context, id string, caller *dto.User) (*dto.Report, error) {
+	var r *dto.Report
+	err := m.transactionDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		r, err = m.reportDAO.Restore(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return m.audit(ctx, caller, constants.AuditRestoreReport, &dto.Audit{
+			Target: id,
+			Before: map[string]interface{}{constants.DeletedAt: r.DeletedAt},
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return u, nil
+	r.DeletedAt = 0
+	r.Version++
+	return r, nil
 }
 
-// DeleteReports delete reports by IDs
-func (m *Model) DeleteReports(ctx context.Context, ids []string) ([]string, error) {
-	var deletedIDs []string
-	for _, id := range ids {
-		u, err := m.DeleteReport(ctx, id)
+// DeleteReports soft deletes reports by IDs in a transaction, deletion by caller is recorded in audit log. Returns
+// result per ID
+func (m *Model) DeleteReports(ctx context.Context, ids []string, caller *dto.User) ([]*dto.BatchResult, error) {
+	ids, err := batchIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	now := utility.TimeToMilli(utility.MalaysiaTime(time.Now()))
+
+	var results []*dto.BatchResult
+	err = m.transactionDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		reports, err := m.reportDAO.BatchGet(ctx, ids)
 		if err != nil {
-			return nil, err
+			return err
+		}
+
+		found := map[string]bool{}
+		var deleteIDs []string
+		var audits []*dto.Audit
+		for _, r := range reports {
+			found[r.ID] = true
+			deleteIDs = append(deleteIDs, r.ID)
+			audits = append(audits, &dto.Audit{
+				Target: r.ID,
+				After:  map[string]interface{}{constants.DeletedAt: now},
+			})
 		}
-		deletedIDs = append(deletedIDs, u.ID)
+
+		if len(deleteIDs) > 0 {
+			_, err = m.reportDAO.BatchDelete(ctx, deleteIDs, now)
+			if err != nil {
+				return err
+			}
+			err = m.audit(ctx, caller, constants.AuditDeleteReport, audits...)
+			if err != nil {
+				return err
+			}
+		}
+
+		results = batchResults(ids, found, constants.ReportNotFoundError)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return deletedIDs, nil
+	return results, nil
 }
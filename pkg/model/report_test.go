@@ -0,0 +1,37 @@
+package model
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dao"
+	"galasejahtera/pkg/dto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUpdateReport ...
+func TestUpdateReport(t *testing.T) {
+	tests := []struct {
+		name            string
+		version         int64
+		caller          *dto.User
+		expectedVersion int64
+		expectedError   error
+	}{
+		{name: "no expected version, should update", caller: &dto.User{ID: "owner", Role: constants.User}, expectedVersion: 4},
+		{name: "current version, should update", version: 3, caller: &dto.User{ID: "owner", Role: constants.User}, expectedVersion: 4},
+		{name: "stale version, should be rejected with current version", version: 2, caller: &dto.User{ID: "owner", Role: constants.User}, expectedVersion: 3, expectedError: &dao.VersionError{Current: 3}},
+		{name: "other user, should be denied", caller: &dto.User{ID: "other", Role: constants.User}, expectedVersion: 3, expectedError: constants.PermissionDeniedError},
+	}
+
+	for _, test := range tests {
+		store := &memStore{reports: map[string]*dto.Report{"a": {ID: "a", UserID: "owner", Version: 3}}}
+		m := newMemModel(store)
+
+		_, err := m.UpdateReport(context.Background(), &dto.Report{ID: "a", HasSymptom: true, Version: test.version}, []string{constants.HasSymptom}, test.caller)
+		assert.Equal(t, test.expectedError, err, test.name)
+		assert.Equal(t, test.expectedVersion, store.reports["a"].Version, test.name)
+		assert.Equal(t, test.expectedError == nil, store.reports["a"].HasSymptom, test.name)
+	}
+}
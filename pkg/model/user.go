@@ -2,10 +2,14 @@ package model
 
 import (
 	"context"
+	"errors"
 	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dao"
 	"galasejahtera/pkg/dto"
 	"galasejahtera/pkg/logger"
+	"galasejahtera/pkg/metrics"
 	"galasejahtera/pkg/utility"
+	"math/rand"
 	"os"
 	"strings"
 	"time"
@@ -14,95 +18,107 @@ import (
 	"github.com/twinj/uuid"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
-// DisableInactiveUsers disable inactive users
-func (m *Model) DisableInactiveUsers(ctx context.Context) error {
-	// get all users
-	_, users, err := m.userDAO.Query(ctx, nil, nil, &dto.FilterData{
-		Item:  constants.Role,
-		Value: constants.User,
-	})
+// DisableInactiveUsers disables users without location update within INACTIVE_USER_THRESHOLD, returns number of users disabled
+func (m *Model) DisableInactiveUsers(ctx context.Context) (int64, error) {
+	threshold := utility.GetEnvDuration("INACTIVE_USER_THRESHOLD", constants.InactiveUserThreshold)
+
+	lastUpdated := utility.TimeToMilli(utility.MalaysiaTime(time.Now().Add(-threshold)))
+	count, err := m.userDAO.DisableInactive(ctx, lastUpdated)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	// check user last updated time, set users whose updated time > 20 minutes to inactive
-	for _, user := range users {
-		if utility.TimeToMilli(utility.MalaysiaTime(time.Now()))-user.LastUpdated > 10*60*1000 {
-			user.IsActive = false
-			_, err = m.userDAO.Update(ctx, user)
-			if err != nil {
-				logger.Log.Error(err.Error())
-			}
-		}
-	}
-	return nil
+	metrics.InactiveUsersDisabled.Add(float64(count))
+	logger.WithContext(ctx).Info("disabled inactive users", zap.Int64("count", count), zap.Duration("threshold", threshold))
+	return count, nil
+}
+
+// CountActiveUsers counts users with recent location update
+func (m *Model) CountActiveUsers(ctx context.Context) (int64, error) {
+	return m.userDAO.CountActive(ctx)
 }
 
 // CreateUser creates new user
 func (m *Model) CreateUser(ctx context.Context, user *dto.User) (*dto.User, error) {
+	// existing ID or email is rejected by unique index
+	return m.userDAO.Create(ctx, user)
+}
 
-	// check if User exist
-	_, err := m.userDAO.Get(ctx, user.ID)
+// clientUserFields are fields of user updated by client
+var clientUserFields = []string{constants.IsActive, constants.Lat, constants.Long, constants.UserUpdated, constants.Name}
 
-	// only can create user if not found
-	if err != nil && status.Code(err) == codes.Unknown {
-		return m.userDAO.Create(ctx, user)
-	}
+// ClientUpdateUser updates activity, location and name of user by client, only changed fields are set. Update is rejected
+// with dao.VersionError if user is not at version of user, unless it is 0
+func (m *Model) ClientUpdateUser(ctx context.Context, user *dto.User) (*dto.User, error) {
 
+	// check if user exists and is at version expected by client, if any
+	u, err := m.userDAO.Get(ctx, user.ID)
 	if err != nil {
 		return nil, err
 	}
+	if user.Version != 0 && user.Version != u.Version {
+		return nil, &dao.VersionError{Current: u.Version}
+	}
 
-	return nil, status.Error(codes.AlreadyExists, "User already exist!")
-}
-
-// ClientUpdateUser updates user by client
-func (m *Model) ClientUpdateUser(ctx context.Context, user *dto.User) (*dto.User, error) {
+	// patch changed fields only
+	changed := userChanged(u, user)
+	var fields []string
+	for _, field := range clientUserFields {
+		if changed(field) {
+			fields = append(fields, field)
+		}
+	}
+	patchUser(u, user, fields)
 
-	// check if user exists
-	u, err := m.userDAO.Get(ctx, user.ID)
+	_, err = m.userDAO.Patch(ctx, u, fields)
 	if err != nil {
 		return nil, err
 	}
 
-	// patch user
-	u.IsActive = user.IsActive
-	u.Lat = user.Lat
-	u.Long = user.Long
-	u.LastUpdated = user.LastUpdated
-	u.Name = user.Name
+	return u, nil
+}
 
-	_, err = m.userDAO.Update(ctx, u)
+// UpdateUser updates fields of user in mask, all updatable fields if mask is empty, caller is checked for permission of
+// each field. Update is rejected with dao.VersionError if user is not at version of user, unless it is 0. Changes are
+// recorded in audit log in the same transaction
+func (m *Model) UpdateUser(ctx context.Context, user *dto.User, mask []string, caller *dto.User) (*dto.User, error) {
+	var u *dto.User
+	err := m.transactionDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		u, err = m.updateUser(ctx, user, mask, caller)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-
 	return u, nil
 }
 
-// UpdateUser updates user
-func (m *Model) UpdateUser(ctx context.Context, user *dto.User) (*dto.User, error) {
+// updateUser is UpdateUser within transaction of ctx
+func (m *Model) updateUser(ctx context.Context, user *dto.User, mask []string, caller *dto.User) (*dto.User, error) {
 
-	// check if user exists
+	// check if user exists and is at version expected by client, if any
 	u, err := m.userDAO.Get(ctx, user.ID)
 	if err != nil {
 		return nil, err
 	}
+	if user.Version != 0 && user.Version != u.Version {
+		return nil, &dao.VersionError{Current: u.Version}
+	}
+
+	fields, err := patchFields(mask, userPatchFields, caller, u.ID, userChanged(u, user))
+	if err != nil {
+		return nil, err
+	}
 
 	// patch user
+	before := userAuditFields(u)
 	oldEmail := u.Email
-	u.Role = user.Role
-	u.Email = user.Email
-	u.IsActive = user.IsActive
-	u.Lat = user.Lat
-	u.Long = user.Long
-	u.Name = user.Name
+	patchUser(u, user, fields)
 
-	_, err = m.userDAO.Update(ctx, u)
+	_, err = m.userDAO.Patch(ctx, u, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -115,46 +131,165 @@ func (m *Model) UpdateUser(ctx context.Context, user *dto.User) (*dto.User, erro
 		}
 	}
 
+	err = m.audit(ctx, caller, constants.AuditUpdateUser, changeAudit(u.ID, before, userAuditFields(u)))
+	if err != nil {
+		return nil, err
+	}
 	return u, nil
 }
 
-// UpdateUserPassword updates user password only
+// UpdateUserPassword updates user password only, reset is recorded in audit log as taken by the user
 func (m *Model) UpdateUserPassword(ctx context.Context, user *dto.User) (*dto.User, error) {
+	var u *dto.User
+	err := m.transactionDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		// check if user exists
+		var err error
+		u, err = m.userDAO.Get(ctx, user.ID)
+		if err != nil {
+			return err
+		}
 
-	// check if user exists
-	u, err := m.userDAO.Get(ctx, user.ID)
+		// hash password
+		u.Password, err = utility.HashPassword(user.Password)
+		if err != nil {
+			return err
+		}
+
+		// set password only, user is still at version read
+		_, err = m.userDAO.Patch(ctx, u, []string{constants.Password})
+		if err != nil {
+			return err
+		}
+
+		return m.audit(ctx, u, constants.AuditResetPassword, &dto.Audit{Target: u.ID})
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// patch user
-	u.Password = user.Password
+	return u, nil
+}
+
+// userChanged reports whether field of u differs from that of user, for admin fields of patchFields and fields updated
+// by client
+func userChanged(u *dto.User, user *dto.User) func(field string) bool {
+	return func(field string) bool {
+		switch field {
+		case constants.Role:
+			return u.Role != user.Role
+		case constants.IsActive:
+			return u.IsActive != user.IsActive
+		case constants.Lat:
+			return u.Lat != user.Lat
+		case constants.Long:
+			return u.Long != user.Long
+		case constants.UserUpdated:
+			return u.LastUpdated != user.LastUpdated
+		case constants.Name:
+			return u.Name != user.Name
+		}
+		return true
+	}
+}
 
-	// hash password
-	u.Password, err = utility.HashPassword(u.Password)
+// patchUser sets fields of u to those of user
+func patchUser(u *dto.User, user *dto.User, fields []string) {
+	for _, field := range fields {
+		switch field {
+		case constants.Role:
+			u.Role = user.Role
+		case constants.Email:
+			u.Email = user.Email
+		case constants.IsActive:
+			u.IsActive = user.IsActive
+		case constants.Lat:
+			u.Lat = user.Lat
+		case constants.Long:
+			u.Long = user.Long
+		case constants.UserUpdated:
+			u.LastUpdated = user.LastUpdated
+		case constants.Name:
+			u.Name = user.Name
+		}
+	}
+}
+
+// UpdateUsers updates fields of users by IDs in mask in a transaction, all updatable fields but email if mask is empty.
+// Email can only be updated for a single user by mask. User listed in versions is not updated with dao.VersionError if it is
+// not at its version. Changes are recorded in audit log, returns result per ID
+func (m *Model) UpdateUsers(ctx context.Context, user *dto.User, ids []string, mask []string, versions map[string]int64, caller *dto.User) ([]*dto.BatchResult, error) {
+	ids, err := batchIDs(ids)
 	if err != nil {
 		return nil, err
 	}
-
-	_, err = m.userDAO.Update(ctx, u)
+	fields, err := maskFields(mask, userPatchFields)
 	if err != nil {
 		return nil, err
 	}
+	if len(mask) == 0 {
+		fields = without(fields, constants.Email)
+	} else if len(ids) > 1 && contains(fields, constants.Email) {
+		return nil, constants.BatchEmailError
+	}
 
-	return u, nil
-}
+	var results []*dto.BatchResult
+	err = m.transactionDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		users, err := m.userDAO.BatchGet(ctx, ids)
+		if err != nil {
+			return err
+		}
 
-// UpdateUsers update users
-func (m *Model) UpdateUsers(ctx context.Context, user *dto.User, ids []string) ([]string, error) {
-	if len(ids) > 1 {
-		return nil, constants.OperationUnsupportedError
-	}
-	user.ID = ids[0]
-	u, err := m.UpdateUser(ctx, user)
+		found := map[string]bool{}
+		failed := map[string]error{}
+		var updateIDs, revokeIDs []string
+		var audits []*dto.Audit
+		for _, u := range users {
+			found[u.ID] = true
+			if version := versions[u.ID]; version != 0 && version != u.Version {
+				failed[u.ID] = &dao.VersionError{Current: u.Version}
+				continue
+			}
+			if err := checkFields(fields, userPatchFields, caller, u.ID, userChanged(u, user)); err != nil {
+				failed[u.ID] = err
+				continue
+			}
+
+			before := userAuditFields(u)
+			oldEmail := u.Email
+			patchUser(u, user, fields)
+			updateIDs = append(updateIDs, u.ID)
+			audits = append(audits, changeAudit(u.ID, before, userAuditFields(u)))
+			// revoke all tokens by user id if email is changed
+			if oldEmail != u.Email {
+				revokeIDs = append(revokeIDs, u.ID)
+			}
+		}
+
+		if len(updateIDs) > 0 {
+			_, err = m.userDAO.UpdateMany(ctx, updateIDs, user, fields)
+			if err != nil {
+				return err
+			}
+		}
+		if len(revokeIDs) > 0 {
+			err = m.authDAO.DeleteByIDs(ctx, revokeIDs)
+			if err != nil {
+				return err
+			}
+		}
+		err = m.audit(ctx, caller, constants.AuditUpdateUser, audits...)
+		if err != nil {
+			return err
+		}
+
+		results = batchFailed(batchResults(ids, found, constants.UserNotFoundError), failed)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	return []string{u.ID}, err
+
+	return results, nil
 }
 
 // GetUser gets user by ID
@@ -162,6 +297,15 @@ func (m *Model) GetUser(ctx context.Context, id string) (*dto.User, error) {
 	return m.userDAO.Get(ctx, id)
 }
 
+// GetUserByEmail gets user by case-insensitive email
+func (m *Model) GetUserByEmail(ctx context.Context, email string) (*dto.User, error) {
+	user, err := m.userDAO.GetByEmail(ctx, email)
+	if errors.Is(err, dao.ErrNotFound) {
+		return nil, constants.UserNotFoundError
+	}
+	return user, err
+}
+
 // CreateToken creates token with custom ttl
 func (m *Model) CreateToken(ctx context.Context, auth *dto.AuthObject) (*dto.AuthObject, error) {
 
@@ -210,37 +354,88 @@ func (m *Model) BatchGetUsers(ctx context.Context, ids []string) ([]*dto.User, e
 	return m.userDAO.BatchGet(ctx, ids)
 }
 
-// QueryUsers queries users by sort, range, filter
-func (m *Model) QueryUsers(ctx context.Context, sort *dto.SortData, itemsRange *dto.RangeData, filter *dto.FilterData) (int64, []*dto.User, error) {
-	return m.userDAO.Query(ctx, sort, itemsRange, filter)
+// QueryUsers queries users by filters, sorts and range or page, returns total, users and next page token
+func (m *Model) QueryUsers(ctx context.Context, query *dto.QueryData) (int64, []*dto.User, string, error) {
+	return m.userDAO.Query(ctx, query)
 }
 
-// DeleteUser deletes user by ID
-func (m *Model) DeleteUser(ctx context.Context, id string) (*dto.User, error) {
-	// check if user exist
-	u, err := m.userDAO.Get(ctx, id)
-	if err != nil {
-		return nil, err
-	}
+// DeleteUser soft deletes user and its reports by ID and revokes its tokens, user is purged after DELETED_RETENTION.
+// Deletion by caller is recorded in audit log
+func (m *Model) DeleteUser(ctx context.Context, id string, caller *dto.User) (*dto.User, error) {
+	var u *dto.User
+	now := utility.TimeToMilli(utility.MalaysiaTime(time.Now()))
+	err := m.transactionDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		// check if user exist
+		var err error
+		u, err = m.userDAO.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = m.userDAO.Delete(ctx, id, now)
+		if err != nil {
+			return err
+		}
 
-	err = m.userDAO.Delete(ctx, id)
+		// reports deleted along with user are restored along with user
+		_, err = m.reportDAO.DeleteByUserIDs(ctx, []string{id}, now)
+		if err != nil {
+			return err
+		}
+
+		// revoke all tokens
+		err = m.authDAO.DeleteByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return m.audit(ctx, caller, constants.AuditDeleteUser, &dto.Audit{
+			Target: id,
+			After:  map[string]interface{}{constants.DeletedAt: now},
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// revoke all tokens
-	err = m.authDAO.DeleteByID(ctx, id)
+	u.DeletedAt = now
+	return u, nil
+}
+
+// RestoreUser restores soft deleted user by ID along with reports deleted with it, restore by caller is recorded in
+// audit log
+func (m *Model) RestoreUser(ctx context.Context, id string, caller *dto.User) (*dto.User, error) {
+	var u *dto.User
+	err := m.transactionDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		u, err = m.userDAO.Restore(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		_, err = m.reportDAO.RestoreByUserID(ctx, id, u.DeletedAt)
+		if err != nil {
+			return err
+		}
+
+		return m.audit(ctx, caller, constants.AuditRestoreUser, &dto.Audit{
+			Target: id,
+			Before: map[string]interface{}{constants.DeletedAt: u.DeletedAt},
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	u.DeletedAt = 0
+	u.Version++
 	return u, nil
 }
 
 // RevokeUserTokens revoke all user tokens
 func (m *Model) RevokeUserTokens(ctx context.Context) error {
 	// force revoke all user tokens
-	_, users, err := m.QueryUsers(ctx, nil, nil, nil)
+	_, users, _, err := m.QueryUsers(ctx, nil)
 	for _, user := range users {
 		err = m.authDAO.DeleteByID(ctx, user.ID)
 		if err != nil {
@@ -250,34 +445,71 @@ func (m *Model) RevokeUserTokens(ctx context.Context) error {
 	return nil
 }
 
-// DeleteUsers delete users by IDs
-func (m *Model) DeleteUsers(ctx context.Context, ids []string) ([]string, error) {
-	var deletedIDs []string
-	for _, id := range ids {
-		u, err := m.DeleteUser(ctx, id)
+// DeleteUsers soft deletes users and their reports and revokes their tokens by IDs in a transaction, deletion by caller
+// is recorded in audit log. Returns result per ID
+func (m *Model) DeleteUsers(ctx context.Context, ids []string, caller *dto.User) ([]*dto.BatchResult, error) {
+	ids, err := batchIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	now := utility.TimeToMilli(utility.MalaysiaTime(time.Now()))
+
+	var results []*dto.BatchResult
+	err = m.transactionDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		users, err := m.userDAO.BatchGet(ctx, ids)
 		if err != nil {
-			return nil, err
+			return err
+		}
+
+		found := map[string]bool{}
+		var deleteIDs []string
+		var audits []*dto.Audit
+		for _, u := range users {
+			found[u.ID] = true
+			deleteIDs = append(deleteIDs, u.ID)
+			audits = append(audits, &dto.Audit{
+				Target: u.ID,
+				After:  map[string]interface{}{constants.DeletedAt: now},
+			})
+		}
+
+		if len(deleteIDs) > 0 {
+			_, err = m.userDAO.BatchDelete(ctx, deleteIDs, now)
+			if err != nil {
+				return err
+			}
+			_, err = m.reportDAO.DeleteByUserIDs(ctx, deleteIDs, now)
+			if err != nil {
+				return err
+			}
+			// revoke all tokens of deleted users
+			err = m.authDAO.DeleteByIDs(ctx, deleteIDs)
+			if err != nil {
+				return err
+			}
+			err = m.audit(ctx, caller, constants.AuditDeleteUser, audits...)
+			if err != nil {
+				return err
+			}
 		}
-		deletedIDs = append(deletedIDs, u.ID)
+
+		results = batchResults(ids, found, constants.UserNotFoundError)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return deletedIDs, nil
+	return results, nil
 }
 
 // Login verifies user by email and password and return tokens
 func (m *Model) Login(ctx context.Context, email string, password string) (*dto.User, error) {
-	// get users by email
-	_, users, err := m.userDAO.Query(ctx, nil, nil, &dto.FilterData{
-		Item:  constants.Email,
-		Value: email,
-	})
+	// get user by case-insensitive email
+	user, err := m.GetUserByEmail(ctx, email)
 	if err != nil {
 		return nil, err
 	}
-	if len(users) < 1 {
-		return nil, constants.UserNotFoundError
-	}
-	user := users[0]
 
 	// verify password
 	if !m.verifyPassword(user.Password, password) {
@@ -291,7 +523,7 @@ func (m *Model) Login(ctx context.Context, email string, password string) (*dto.
 	}
 
 	// create auth in db
-	err = m.createAuth(user.ID, ts)
+	err = m.createAuth(ctx, user.ID, ts)
 	if err != nil {
 		return nil, err
 	}
@@ -307,7 +539,7 @@ func (m *Model) VerifyUser(ctx context.Context, header string) (*dto.User, error
 	if err != nil {
 		return nil, err
 	}
-	id, err := m.fetchAuth(tokenAuth)
+	id, err := m.fetchAuth(ctx, tokenAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -345,7 +577,7 @@ func (m *Model) Refresh(ctx context.Context, header string) (*dto.User, error) {
 		return []byte(os.Getenv("REFRESH_SECRET")), nil
 	})
 	if err != nil {
-		return nil, err
+		return nil, constants.UnauthorizedAccessError
 	}
 	if _, ok := token.Claims.(jwt.Claims); !ok && !token.Valid {
 		return nil, constants.VerifyTokenFailedError
@@ -361,7 +593,7 @@ func (m *Model) Refresh(ctx context.Context, header string) (*dto.User, error) {
 			return nil, constants.VerifyTokenFailedError
 		}
 		// Delete the previous Refresh Token
-		err := m.deleteAuth(refreshUuid)
+		err := m.deleteAuth(ctx, refreshUuid)
 		if err != nil {
 			return nil, err
 		}
@@ -378,7 +610,7 @@ func (m *Model) Refresh(ctx context.Context, header string) (*dto.User, error) {
 			return nil, err
 		}
 		// save the tokens metadata to mongodb
-		err = m.createAuth(userId, ts)
+		err = m.createAuth(ctx, userId, ts)
 		if err != nil {
 			return nil, err
 		}
@@ -441,7 +673,7 @@ func (m *Model) createToken(user *dto.User) (*dto.User, error) {
 	return td, nil
 }
 
-func (m *Model) createAuth(id string, td *dto.User) error {
+func (m *Model) createAuth(ctx context.Context, id string, td *dto.User) error {
 	accessToken := &dto.AuthObject{
 		Token:  td.AccessUuid,
 		UserId: id,
@@ -454,7 +686,6 @@ func (m *Model) createAuth(id string, td *dto.User) error {
 		TTL:    utility.MilliToTime(td.RtExpires),
 		Type:   constants.Refresh,
 	}
-	ctx := context.TODO()
 	_, err := m.authDAO.Create(ctx, accessToken)
 	if err != nil {
 		return err
@@ -508,8 +739,8 @@ func (m *Model) extractTokenMetadata(header string) (*dto.User, error) {
 	return nil, constants.VerifyTokenFailedError
 }
 
-func (m *Model) fetchAuth(authD *dto.User) (string, error) {
-	authObject, err := m.authDAO.Get(context.TODO(), authD.AccessUuid)
+func (m *Model) fetchAuth(ctx context.Context, authD *dto.User) (string, error) {
+	authObject, err := m.authDAO.Get(ctx, authD.AccessUuid)
 	if err != nil {
 		return "", err
 	}
@@ -527,36 +758,55 @@ func (m *Model) extractToken(header string) string {
 	return ""
 }
 
-func (m *Model) deleteAuth(givenUuid string) error {
-	err := m.authDAO.Delete(context.TODO(), givenUuid)
+func (m *Model) deleteAuth(ctx context.Context, givenUuid string) error {
+	err := m.authDAO.Delete(ctx, givenUuid)
 	if err != nil {
-		logger.Log.Warn("DeleteAuth", zap.String("error", err.Error()), zap.String("token", givenUuid))
+		logger.WithContext(ctx).Warn("DeleteAuth", zap.String("error", err.Error()), zap.String("token", givenUuid))
 	}
 	return nil
 }
 
-// GetNearbyUsers get nearby users count given user
-func (m *Model) GetNearbyUsers(ctx context.Context, user *dto.User) (int64, []*dto.User, error) {
-	_, users, err := m.userDAO.GetNearbyUsers(ctx, user)
+// GetNearbyUsers get nearby users count given user, radius and max age are capped by NEARBY_MAX_RADIUS and NEARBY_MAX_AGE
+func (m *Model) GetNearbyUsers(ctx context.Context, user *dto.User, query *dto.NearbyQuery) (int64, []*dto.User, error) {
+	maxRadius := utility.GetEnvFloat("NEARBY_MAX_RADIUS", constants.MaxNearbyRadius)
+	radius := utility.GetEnvFloat("NEARBY_RADIUS", constants.NearbyRadius)
+	if query.Radius > 0 {
+		radius = query.Radius
+	}
+	if radius > maxRadius {
+		radius = maxRadius
+	}
+
+	maxAge := utility.GetEnvDuration("NEARBY_MAX_AGE", constants.NearbyMaxAge).Milliseconds()
+	if query.MaxAge > 0 && query.MaxAge < maxAge {
+		maxAge = query.MaxAge
+	}
+	lastUpdated := utility.TimeToMilli(utility.MalaysiaTime(time.Now())) - maxAge
+
+	// latest report of nearby users is joined in the same query
+	total, users, err := m.userDAO.GetNearbySymptomaticUsers(ctx, user, radius, lastUpdated)
 	if err != nil {
 		return 0, nil, err
 	}
 
-	// check if nearby users have reported symptoms
-	var symptomaticUsers []*dto.User
-	for _, u := range users {
-		_, reports, err := m.reportDAO.Query(ctx, &dto.SortData{
-			Item:  constants.CreatedAt,
-			Order: constants.DESC,
-		}, nil, &dto.FilterData{
-			Item:  constants.UserId,
-			Value: u.ID,
-		})
-		if err != nil || len(reports) == 0 || !reports[0].HasSymptom {
-			continue
-		}
-		symptomaticUsers = append(symptomaticUsers, u)
+	// keep location history, nearby users are still returned if it fails
+	if _, err := m.RecordLocation(ctx, user); err != nil {
+		logger.WithContext(ctx).Error("failed to record location history", zap.String("UserID", user.ID), zap.String("reason", err.Error()))
+	}
+	return total, users, nil
+}
+
+// GetNearbyCells gets nearby users count given user, grouped by geohash cell of NEARBY_GEOHASH_PRECISION, capped to
+// keep cells coarse
+func (m *Model) GetNearbyCells(ctx context.Context, user *dto.User, query *dto.NearbyQuery) (int64, []*dto.NearbyCell, error) {
+	total, users, err := m.GetNearbyUsers(ctx, user, query)
+	if err != nil {
+		return 0, nil, err
 	}
 
-	return int64(len(symptomaticUsers)), symptomaticUsers, nil
+	precision := utility.GetEnvInt("NEARBY_GEOHASH_PRECISION", constants.NearbyGeohashPrecision)
+	if precision > constants.MaxNearbyGeohashPrecision {
+		precision = constants.MaxNearbyGeohashPrecision
+	}
+	return total, utility.GroupNearbyUsers(user.Lat, user.Long, users, precision, rand.Float64), nil
 }
@@ -0,0 +1,124 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dao"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/utility"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newCaller creates requesting user
+func newCaller() *dto.User {
+	return &dto.User{
+		ID:       "caller",
+		Lat:      3.139,
+		Long:     101.6869,
+		Location: &dto.Location{Type: "Point", Coordinates: []float64{101.6869, 3.139}},
+	}
+}
+
+// TestGetNearbyUsers ...
+func TestGetNearbyUsers(t *testing.T) {
+	maxAge := constants.NearbyMaxAge.Milliseconds()
+	tests := []struct {
+		name           string
+		query          *dto.NearbyQuery
+		expectedRadius float64
+		expectedMaxAge int64
+	}{
+		{name: "default radius and age, should use defaults", query: &dto.NearbyQuery{}, expectedRadius: constants.NearbyRadius, expectedMaxAge: maxAge},
+		{name: "radius 15 meter, should be used", query: &dto.NearbyQuery{Radius: 15}, expectedRadius: 15, expectedMaxAge: maxAge},
+		{name: "radius above maximum, should be capped", query: &dto.NearbyQuery{Radius: 100000}, expectedRadius: constants.MaxNearbyRadius, expectedMaxAge: maxAge},
+		{name: "max age above maximum, should be capped", query: &dto.NearbyQuery{MaxAge: maxAge * 3}, expectedRadius: constants.NearbyRadius, expectedMaxAge: maxAge},
+		{name: "max age 5 minutes, should be used", query: &dto.NearbyQuery{MaxAge: 5 * 60 * 1000}, expectedRadius: constants.NearbyRadius, expectedMaxAge: 5 * 60 * 1000},
+	}
+
+	for _, test := range tests {
+		nearby := []*dto.User{{ID: "a", Distance: 5}, {ID: "b", Distance: 12}}
+		store := &memStore{nearby: nearby}
+		m := newMemModel(store)
+
+		now := utility.TimeToMilli(utility.MalaysiaTime(time.Now()))
+		total, users, err := m.GetNearbyUsers(context.Background(), newCaller(), test.query)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, int64(2), total, test.name)
+		assert.Equal(t, nearby, users, "nearby users should be returned as found")
+		assert.Equal(t, test.expectedRadius, store.radius, test.name)
+		assert.InDelta(t, now-test.expectedMaxAge, store.lastUpdated, 1000, test.name)
+		assert.Equal(t, 1, store.queries, "nearby users should be found in one query")
+		assert.Len(t, store.locations, 1, "caller location should be recorded")
+	}
+}
+
+// TestClientUpdateUser ...
+func TestClientUpdateUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		user           *dto.User
+		expectedError  error
+		expectedFields []string
+	}{
+		{name: "name changed, should only set name", user: &dto.User{ID: "owner", Name: "Siti", Lat: 3.139, Long: 101.6869, IsActive: true, LastUpdated: 10}, expectedFields: []string{constants.Name}},
+		{name: "location changed, should set location and last updated", user: &dto.User{ID: "owner", Name: "Ali", Lat: 3.2, Long: 101.7, IsActive: true, LastUpdated: 20}, expectedFields: []string{constants.Lat, constants.Long, constants.UserUpdated}},
+		{name: "nothing changed, should set nothing", user: &dto.User{ID: "owner", Name: "Ali", Lat: 3.139, Long: 101.6869, IsActive: true, LastUpdated: 10}},
+		{name: "current version, should be updated", user: &dto.User{ID: "owner", Name: "Siti", Lat: 3.139, Long: 101.6869, IsActive: true, LastUpdated: 10, Version: 2}, expectedFields: []string{constants.Name}},
+		{name: "stale version, should be rejected", user: &dto.User{ID: "owner", Name: "Siti", Version: 1}, expectedError: &dao.VersionError{Current: 2}},
+	}
+
+	for _, test := range tests {
+		store := &memStore{users: map[string]*dto.User{
+			"owner": {ID: "owner", Email: "ali@example.com", Password: "hash", Name: "Ali", Lat: 3.139, Long: 101.6869, IsActive: true, LastUpdated: 10, Version: 2},
+		}}
+		m := newMemModel(store)
+
+		_, err := m.ClientUpdateUser(context.Background(), test.user)
+		assert.Equal(t, test.expectedError, err, test.name)
+		assert.Equal(t, test.expectedFields, store.patched, test.name)
+		assert.Equal(t, "ali@example.com", store.users["owner"].Email, "fields not updated by client should be kept")
+		assert.Equal(t, "hash", store.users["owner"].Password, "fields not updated by client should be kept")
+	}
+}
+
+// TestUpdateUserPassword ...
+func TestUpdateUserPassword(t *testing.T) {
+	store := &memStore{users: map[string]*dto.User{
+		"owner": {ID: "owner", Email: "ali@example.com", Password: "hash", Name: "Ali", Version: 2},
+	}}
+	m := newMemModel(store)
+
+	_, err := m.UpdateUserPassword(context.Background(), &dto.User{ID: "owner", Password: "secret"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{constants.Password}, store.patched, "only password should be set")
+	assert.True(t, m.verifyPassword(store.users["owner"].Password, "secret"), "password should be hashed")
+	assert.Equal(t, int64(3), store.users["owner"].Version)
+	assert.Len(t, store.audits, 1, "reset should be recorded in audit log")
+}
+
+// BenchmarkGetNearbyUsers measures nearby lookup against in-process store, queries/op stays 1 regardless of crowd size
+func BenchmarkGetNearbyUsers(b *testing.B) {
+	for _, n := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("users=%d", n), func(b *testing.B) {
+			nearby := make([]*dto.User, n)
+			for i := range nearby {
+				nearby[i] = &dto.User{ID: fmt.Sprintf("user-%d", i), Distance: float64(i % 50)}
+			}
+			store := &memStore{nearby: nearby}
+			m := newMemModel(store)
+			caller := newCaller()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, _, err := m.GetNearbyUsers(context.Background(), caller, &dto.NearbyQuery{}); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(store.queries)/float64(b.N), "queries/op")
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/utility"
+	"time"
+)
+
+// ExportUserData gets all personal data held of user by ID, password is left out
+func (m *Model) ExportUserData(ctx context.Context, id string) (*dto.UserData, error) {
+	u, err := m.userDAO.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	u.Password = ""
+
+	reports, err := m.reportDAO.GetByUserID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := m.authDAO.GetByUserID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	locations, err := m.locationDAO.GetByUserID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.UserData{
+		ExportedAt: utility.TimeToMilli(utility.MalaysiaTime(time.Now())),
+		User:       u,
+		Reports:    reports,
+		Sessions:   sessions,
+		Locations:  locations,
+	}, nil
+}
+
+// EraseUser hard deletes user by ID along with its reports, tokens and location history at once in a transaction and
+// records the erasure in audit log, nothing is kept for restore
+func (m *Model) EraseUser(ctx context.Context, id string) error {
+	now := utility.TimeToMilli(utility.MalaysiaTime(time.Now()))
+	return m.transactionDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		// check if user exist
+		_, err := m.userDAO.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		ids := []string{id}
+		if _, err = m.reportDAO.PurgeByUserIDs(ctx, ids); err != nil {
+			return err
+		}
+		if _, err = m.authDAO.PurgeByUserIDs(ctx, ids); err != nil {
+			return err
+		}
+		if _, err = m.locationDAO.DeleteByUserIDs(ctx, ids); err != nil {
+			return err
+		}
+
+		// only soft deleted users are purged
+		if err = m.userDAO.Delete(ctx, id, now); err != nil {
+			return err
+		}
+		if _, err = m.userDAO.Purge(ctx, ids); err != nil {
+			return err
+		}
+
+		return m.audit(ctx, &dto.User{ID: id}, constants.AuditEraseAccount, &dto.Audit{Target: id})
+	})
+}
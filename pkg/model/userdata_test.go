@@ -0,0 +1,57 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dao"
+	"galasejahtera/pkg/dto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestEraseUser ...
+func TestEraseUser(t *testing.T) {
+	auditErr := errors.New("audit log unavailable")
+	tests := []struct {
+		name            string
+		id              string
+		auditErr        error
+		expectedReports []string
+		expectedAudits  int
+		expectedError   error
+	}{
+		{name: "user, should erase user and its data", id: "erased", expectedReports: []string{"kept"}, expectedAudits: 1},
+		{name: "audit log unavailable, should roll back", id: "erased", auditErr: auditErr, expectedReports: []string{"erased", "kept"}, expectedError: auditErr},
+		{name: "missing user, should not be found", id: "missing", expectedReports: []string{"erased", "kept"}, expectedError: &dao.Error{Kind: dao.ErrNotFound, Err: context.Canceled}},
+	}
+
+	for _, test := range tests {
+		store := &memStore{users: map[string]*dto.User{"erased": {ID: "erased"}, "kept": {ID: "kept"}},
+			reports: map[string]*dto.Report{
+				"erased": {ID: "erased", UserID: "erased"},
+				"kept":   {ID: "kept", UserID: "kept"},
+			},
+			locations: []*dto.LocationHistory{{UserID: "erased"}},
+			tokens:    map[string]bool{"erased": true},
+			auditErr:  test.auditErr}
+		m := newMemModel(store)
+
+		err := m.EraseUser(context.Background(), test.id)
+		assert.Equal(t, test.expectedError, err, test.name)
+		assert.Equal(t, test.expectedReports, reportIDs(store.reports), test.name)
+		assert.Len(t, store.audits, test.expectedAudits, test.name)
+		if test.expectedError == nil {
+			assert.Equal(t, []string{"kept"}, userIDs(store.users), test.name)
+			assert.Empty(t, store.locations, test.name)
+			assert.Empty(t, store.tokens, test.name)
+			assert.Equal(t, &dto.Audit{ID: store.audits[0].ID, Actor: "erased", Action: constants.AuditEraseAccount,
+				Target: "erased", CreatedAt: store.audits[0].CreatedAt}, store.audits[0], test.name)
+		} else {
+			assert.Equal(t, []string{"erased", "kept"}, userIDs(store.users), test.name)
+			assert.Len(t, store.locations, 1, test.name)
+			assert.Len(t, store.tokens, 1, test.name)
+		}
+	}
+}
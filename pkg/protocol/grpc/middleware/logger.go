@@ -32,12 +32,18 @@ func AddLogging(logger *zap.Logger, opts []grpc.ServerOption) []grpc.ServerOptio
 	// Add unary interceptor
 	opts = append(opts, grpc_middleware.WithUnaryServerChain(
 		grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
+		TracingUnaryServerInterceptor,
+		RequestIDUnaryServerInterceptor,
+		MetricsUnaryServerInterceptor,
 		grpc_zap.UnaryServerInterceptor(logger, o...),
 	))
 
 	// Add stream interceptor (added as an example here)
 	opts = append(opts, grpc_middleware.WithStreamServerChain(
 		grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
+		TracingStreamServerInterceptor,
+		RequestIDStreamServerInterceptor,
+		MetricsStreamServerInterceptor,
 		grpc_zap.StreamServerInterceptor(logger, o...),
 	))
 
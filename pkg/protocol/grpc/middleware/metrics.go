@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"context"
+	"galasejahtera/pkg/metrics"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// observe records status code and latency of a handled gRPC request
+func observe(method string, start time.Time, err error) {
+	metrics.RPCDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	metrics.RPCRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+}
+
+// MetricsUnaryServerInterceptor records request count, status code and latency per RPC
+func MetricsUnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	observe(info.FullMethod, start, err)
+	return resp, err
+}
+
+// MetricsStreamServerInterceptor records request count, status code and duration per streaming RPC
+func MetricsStreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, stream)
+	observe(info.FullMethod, start, err)
+	return err
+}
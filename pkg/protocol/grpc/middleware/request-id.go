@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"context"
+	"galasejahtera/pkg/logger"
+	"galasejahtera/pkg/utility"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/twinj/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestID gets request ID of incoming metadata, forwarded by REST gateway, or generates one for native gRPC clients
+// and IDs that are not safe to log and echo
+func requestID(ctx context.Context) string {
+	if id := utility.RequestID(ctx); id != "" {
+		return id
+	}
+	return uuid.NewV4().String()
+}
+
+// RequestIDUnaryServerInterceptor carries request ID in context of handler, tags gRPC logs with it and sends it back
+// in response header
+func RequestIDUnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	id := requestID(ctx)
+	ctx = utility.WithRequestID(ctx, id)
+	grpc_ctxtags.Extract(ctx).Set(logger.RequestIDField, id)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(utility.RequestIDHeader, id))
+	return handler(ctx, req)
+}
+
+// RequestIDStreamServerInterceptor is RequestIDUnaryServerInterceptor for streams
+func RequestIDStreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := stream.Context()
+	id := requestID(ctx)
+	grpc_ctxtags.Extract(ctx).Set(logger.RequestIDField, id)
+	_ = stream.SetHeader(metadata.Pairs(utility.RequestIDHeader, id))
+	wrapped := grpc_middleware.WrapServerStream(stream)
+	wrapped.WrappedContext = utility.WithRequestID(ctx, id)
+	return handler(srv, wrapped)
+}
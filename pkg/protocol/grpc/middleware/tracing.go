@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"context"
+	"galasejahtera/pkg/logger"
+	"galasejahtera/pkg/tracing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// startSpan starts server span of method as child of trace context in incoming metadata, forwarded by REST gateway,
+// and tags gRPC logs with its trace ID
+func startSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = tracing.Extract(ctx, md)
+	}
+	ctx, span := tracing.Tracer().Start(ctx, method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(semconv.RPCSystemKey.String("grpc")))
+	if sc := span.SpanContext(); sc.IsValid() {
+		grpc_ctxtags.Extract(ctx).Set(logger.TraceIDField, sc.TraceID().String())
+	}
+	return ctx, span
+}
+
+// endSpan records status code of handled request and ends span
+func endSpan(span trace.Span, err error) {
+	s := status.Convert(err)
+	span.SetAttributes(attribute.Int64("rpc.grpc.status_code", int64(s.Code())))
+	if err != nil {
+		span.SetStatus(codes.Error, s.Message())
+	}
+	span.End()
+}
+
+// TracingUnaryServerInterceptor traces request as server span continuing trace of caller
+func TracingUnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, span := startSpan(ctx, info.FullMethod)
+	resp, err := handler(ctx, req)
+	endSpan(span, err)
+	return resp, err
+}
+
+// TracingStreamServerInterceptor traces streaming request as server span continuing trace of caller
+func TracingStreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, span := startSpan(stream.Context(), info.FullMethod)
+	wrapped := grpc_middleware.WrapServerStream(stream)
+	wrapped.WrappedContext = ctx
+	err := handler(srv, wrapped)
+	endSpan(span, err)
+	return err
+}
@@ -4,17 +4,21 @@ import (
 	"context"
 	pb "galasejahtera/pkg/api"
 	"galasejahtera/pkg/handlers"
+	"galasejahtera/pkg/health"
 	"galasejahtera/pkg/logger"
 	"galasejahtera/pkg/protocol/grpc/middleware"
 	"net"
-	"os"
-	"os/signal"
 
 	"google.golang.org/grpc"
+	grpc_health "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
-// RunServer ...
-func RunServer(ctx context.Context, handler handlers.IHandlers, port string) error {
+// serviceName is name of gRPC service in health checks
+const serviceName = "pb.GalaSejahteraService"
+
+// RunServer runs gRPC server until ctx is done, then stops it gracefully
+func RunServer(ctx context.Context, handler handlers.IHandlers, port string, checker *health.Checker) error {
 	listen, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		return err
@@ -30,18 +34,21 @@ func RunServer(ctx context.Context, handler handlers.IHandlers, port string) err
 	server := grpc.NewServer(opts...)
 	pb.RegisterGalaSejahteraServiceServer(server, handler)
 
+	// register grpc.health.v1 service
+	healthServer := grpc_health.NewServer()
+	healthServer.SetServingStatus(serviceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+
 	// graceful shutdown
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt)
 	go func() {
-		for range c {
-			// sig is a ^C, handle it
-			logger.Log.Warn("shutting down gRPC server...")
-
-			server.GracefulStop()
-
-			<-ctx.Done()
-		}
+		// report NOT_SERVING while draining, so that clients stop sending requests before server stops
+		<-checker.Draining()
+		healthServer.Shutdown()
+	}()
+	go func() {
+		<-ctx.Done()
+		logger.Log.Warn("shutting down gRPC server...")
+		server.GracefulStop()
 	}()
 
 	// start gRPC server
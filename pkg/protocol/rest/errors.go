@@ -0,0 +1,37 @@
+package rest
+
+import (
+	"context"
+	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/utility"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusWriter replaces status code written by wrapped ResponseWriter
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader writes replacement status code
+func (w *statusWriter) WriteHeader(int) {
+	w.ResponseWriter.WriteHeader(w.status)
+}
+
+// httpError replies update rejected by version conflict with 412 Precondition Failed and current version as ETag,
+// other errors as gateway default
+func httpError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if s, ok := status.FromError(err); ok && s.Code() == codes.Aborted {
+		for _, detail := range s.Details() {
+			if conflict, ok := detail.(*pb.VersionConflict); ok {
+				w.Header().Set("ETag", utility.ETag(conflict.CurrentVersion))
+				w = &statusWriter{ResponseWriter: w, status: http.StatusPreconditionFailed}
+			}
+		}
+	}
+	runtime.DefaultHTTPError(ctx, mux, marshaler, w, r, err)
+}
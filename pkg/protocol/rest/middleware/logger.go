@@ -17,7 +17,7 @@ func AddLogger(logger *zap.Logger, h http.Handler) http.Handler {
 		// We do not want to be spammed by Kubernetes health check.
 		// Do not log Kubernetes health check.
 		// You can change this behavior as you wish.
-		if r.Header.Get("X-Liveness-Probe") == "Healthz" {
+		if r.Header.Get("X-Liveness-Probe") == "Healthz" || r.URL.Path == "/healthz" || r.URL.Path == "/readyz" {
 			h.ServeHTTP(w, r)
 			return
 		}
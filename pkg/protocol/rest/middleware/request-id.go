@@ -5,20 +5,17 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
+	"galasejahtera/pkg/utility"
 	"net/http"
 	"os"
 	"strings"
 	"sync/atomic"
+
+	"google.golang.org/grpc/metadata"
 )
 
 // Code is taken from: https://github.com/go-chi/chi/blob/master/middleware/request_id.go
 
-// Key to use when setting the request ID.
-type ctxKeyRequestID int
-
-// RequestIDKey is the key that holds th unique request ID in a request context.
-const RequestIDKey ctxKeyRequestID = 0
-
 var (
 	// prefix is const prefix for request ID
 	prefix string
@@ -57,12 +54,18 @@ func preflightHandler(w http.ResponseWriter, r *http.Request) {
 // request. A request ID is a string of the form "host.example.com/random-0001",
 // where "random" is a base62 random string that uniquely identifies this go
 // process, and where the last number is an atomically incremented request
-// counter.
+// counter. Request ID given by client in X-Request-ID header is kept if valid.
+// Request ID is echoed in X-Request-ID response header and forwarded to gRPC
+// handlers by RequestIDMetadata.
 func AddRequestID(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		myid := atomic.AddUint64(&reqID, 1)
-		ctx := r.Context()
-		ctx = context.WithValue(ctx, RequestIDKey, fmt.Sprintf("%s-%06d", prefix, myid))
+		id := r.Header.Get(utility.RequestIDHeader)
+		if !utility.ValidRequestID(id) {
+			myid := atomic.AddUint64(&reqID, 1)
+			id = fmt.Sprintf("%s-%06d", prefix, myid)
+		}
+		ctx := utility.WithRequestID(r.Context(), id)
+		w.Header().Set(utility.RequestIDHeader, id)
 
 		if origin := r.Header.Get("Origin"); origin != "" {
 			w.Header().Set("Access-Control-Allow-Origin", origin)
@@ -87,8 +90,14 @@ func GetReqID(ctx context.Context) string {
 	if ctx == nil {
 		return ""
 	}
-	if reqID, ok := ctx.Value(RequestIDKey).(string); ok {
-		return reqID
+	return utility.RequestID(ctx)
+}
+
+// RequestIDMetadata forwards request ID of HTTP request to gRPC handlers as metadata, for runtime.WithMetadata
+func RequestIDMetadata(ctx context.Context, r *http.Request) metadata.MD {
+	id := GetReqID(r.Context())
+	if id == "" {
+		return nil
 	}
-	return ""
+	return metadata.Pairs(utility.RequestIDHeader, id)
 }
@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"context"
+	"galasejahtera/pkg/tracing"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// statusRecorder records status code written by handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader ...
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// routeKey is context key of route of traced request
+type routeKey struct{}
+
+// route is route template of HTTP request, set by gateway client once request is routed to a gRPC method
+type route struct {
+	method   string
+	template string
+}
+
+// AddTracing traces request as server span named by its route template, continuing trace of client if it sends trace
+// context. Requests not routed to a gRPC method are named by HTTP method only, so that span names stay bounded
+func AddTracing(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracing.Tracer().Start(ctx, "HTTP "+r.Method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPMethod(r.Method),
+				semconv.HTTPTarget(r.URL.Path),
+				semconv.HTTPUserAgent(r.UserAgent())))
+		defer span.End()
+
+		rt := &route{method: r.Method}
+		ctx = context.WithValue(ctx, routeKey{}, rt)
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		h.ServeHTTP(rec, r.WithContext(ctx))
+
+		if rt.template != "" {
+			span.SetName("HTTP " + r.Method + " " + rt.template)
+			span.SetAttributes(semconv.HTTPRoute(rt.template))
+		}
+		span.SetAttributes(semconv.HTTPStatusCode(rec.status))
+		if rec.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(rec.status))
+		}
+	})
+}
+
+// RouteUnaryClientInterceptor records route template of gRPC method called by gateway for span of HTTP request, for
+// grpc.WithUnaryInterceptor of gateway connection
+func RouteUnaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if rt, ok := ctx.Value(routeKey{}).(*route); ok {
+		rt.template = routeTemplate(method, rt.method)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// routeTemplate gets path template of HTTP binding of gRPC method, e.g. "/v1/users/{id}" of
+// "/pb.GalaSejahteraService/GetUser" and GET, returns empty string if method has no binding of HTTP method
+func routeTemplate(fullMethod, httpMethod string) string {
+	name := strings.Replace(strings.TrimPrefix(fullMethod, "/"), "/", ".", 1)
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return ""
+	}
+	m, ok := d.(protoreflect.MethodDescriptor)
+	if !ok {
+		return ""
+	}
+	rule, ok := proto.GetExtension(m.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil {
+		return ""
+	}
+
+	for _, binding := range append([]*annotations.HttpRule{rule}, rule.AdditionalBindings...) {
+		var method, path string
+		switch p := binding.Pattern.(type) {
+		case *annotations.HttpRule_Get:
+			method, path = http.MethodGet, p.Get
+		case *annotations.HttpRule_Put:
+			method, path = http.MethodPut, p.Put
+		case *annotations.HttpRule_Post:
+			method, path = http.MethodPost, p.Post
+		case *annotations.HttpRule_Delete:
+			method, path = http.MethodDelete, p.Delete
+		case *annotations.HttpRule_Patch:
+			method, path = http.MethodPatch, p.Patch
+		case *annotations.HttpRule_Custom:
+			method, path = p.Custom.Kind, p.Custom.Path
+		}
+		if method == httpMethod {
+			return path
+		}
+	}
+	return ""
+}
+
+// TraceMetadata returns trace context of request as gRPC metadata, so that gRPC handlers continue trace of gateway
+func TraceMetadata(ctx context.Context, r *http.Request) metadata.MD {
+	md := metadata.MD{}
+	tracing.Inject(r.Context(), md)
+	return md
+}
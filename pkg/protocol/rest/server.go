@@ -3,11 +3,11 @@ package rest
 import (
 	"context"
 	pb "galasejahtera/pkg/api"
+	"galasejahtera/pkg/health"
 	"galasejahtera/pkg/logger"
+	"galasejahtera/pkg/metrics"
 	"galasejahtera/pkg/protocol/rest/middleware"
 	"net/http"
-	"os"
-	"os/signal"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -15,39 +15,54 @@ import (
 	"google.golang.org/grpc"
 )
 
-// RunServer runs HTTP/REST gateway
-func RunServer(ctx context.Context, grpcPort, httpPort string) error {
-	ctx, cancel := context.WithCancel(ctx)
+// RunServer runs HTTP/REST gateway until ctx is done, then shuts it down gracefully
+func RunServer(ctx context.Context, grpcPort, httpPort string, checker *health.Checker) error {
+	// connection to gRPC server outlives ctx, so that requests in flight complete during shutdown
+	connCtx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	//mux := runtime.NewServeMux()
-	mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}))
-	opts := []grpc.DialOption{grpc.WithInsecure()}
-	if err := pb.RegisterGalaSejahteraServiceHandlerFromEndpoint(ctx, mux, "localhost:"+grpcPort, opts); err != nil {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}),
+		runtime.WithProtoErrorHandler(httpError),
+		// forward request ID and trace context to gRPC handlers
+		runtime.WithMetadata(middleware.RequestIDMetadata),
+		runtime.WithMetadata(middleware.TraceMetadata))
+	// name HTTP request spans by route template of called gRPC method
+	opts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithUnaryInterceptor(middleware.RouteUnaryClientInterceptor)}
+	if err := pb.RegisterGalaSejahteraServiceHandlerFromEndpoint(connCtx, mux, "localhost:"+grpcPort, opts); err != nil {
 		logger.Log.Fatal("failed to start HTTP gateway", zap.String("reason", err.Error()))
 	}
 
+	// serve metrics and health checks alongside gateway
+	httpMux := http.NewServeMux()
+	httpMux.Handle("/metrics", metrics.Handler())
+	httpMux.Handle("/healthz", health.LiveHandler())
+	httpMux.Handle("/readyz", checker.ReadyHandler())
+	httpMux.Handle("/", mux)
+
 	srv := &http.Server{
 		Addr: ":" + httpPort,
 		// add handler with middleware
 		Handler: middleware.AddRequestID(
-			middleware.AddLogger(logger.Log, mux)),
+			middleware.AddTracing(
+				middleware.AddLogger(logger.Log, httpMux))),
 	}
 
 	// graceful shutdown
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt)
 	go func() {
-		for range c {
-			// sig is a ^C, handle it
-		}
+		<-ctx.Done()
+		logger.Log.Warn("shutting down HTTP/REST gateway...")
 
-		_, cancel := context.WithTimeout(ctx, 5*time.Second)
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
-		_ = srv.Shutdown(ctx)
+		_ = srv.Shutdown(shutdownCtx)
 	}()
 
 	logger.Log.Info("starting HTTP/REST gateway...")
-	return srv.ListenAndServe()
+	if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
 }
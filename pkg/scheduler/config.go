@@ -0,0 +1,46 @@
+package scheduler
+
+import (
+	"os"
+	"strings"
+	"time"
+)
+
+// JobConfig is configuration for a job
+type JobConfig struct {
+	// Spec is schedule of job, see ParseSpec
+	Spec string
+	// Timeout is maximum duration of a run
+	Timeout time.Duration
+	// Jitter is maximum random delay added to each activation
+	Jitter time.Duration
+	// RunOnStart runs job once when scheduler starts
+	RunOnStart bool
+	// Enabled disables job schedule if false, job can still be triggered manually
+	Enabled bool
+}
+
+// LoadJobConfig loads job configuration from environment variables, e.g. for job
+// "update-dailies": SCHEDULER_UPDATE_DAILIES_SPEC, SCHEDULER_UPDATE_DAILIES_TIMEOUT,
+// SCHEDULER_UPDATE_DAILIES_JITTER, SCHEDULER_UPDATE_DAILIES_RUN_ON_START and
+// SCHEDULER_UPDATE_DAILIES_ENABLED. Default is used for missing or invalid values.
+func LoadJobConfig(name string, def JobConfig) JobConfig {
+	prefix := "SCHEDULER_" + strings.ToUpper(strings.Replace(name, "-", "_", -1)) + "_"
+	cfg := def
+	if v := os.Getenv(prefix + "SPEC"); v != "" {
+		cfg.Spec = v
+	}
+	if d, err := time.ParseDuration(os.Getenv(prefix + "TIMEOUT")); err == nil && d > 0 {
+		cfg.Timeout = d
+	}
+	if d, err := time.ParseDuration(os.Getenv(prefix + "JITTER")); err == nil && d >= 0 {
+		cfg.Jitter = d
+	}
+	if v := os.Getenv(prefix + "RUN_ON_START"); v != "" {
+		cfg.RunOnStart = v == "true"
+	}
+	if v := os.Getenv(prefix + "ENABLED"); v != "" {
+		cfg.Enabled = v == "true"
+	}
+	return cfg
+}
@@ -0,0 +1,348 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/logger"
+	"galasejahtera/pkg/metrics"
+	"galasejahtera/pkg/tracing"
+	"galasejahtera/pkg/utility"
+	"math/rand"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
+)
+
+// leaseMargin is added to job timeout as lease duration, so lease outlives a run that hits the timeout
+const leaseMargin = time.Minute
+
+// lockTimeout is timeout of acquiring and releasing lease
+const lockTimeout = 10 * time.Second
+
+// ILocker holds job leases so that each run happens on one replica only
+type ILocker interface {
+	// AcquireJobLease acquires job lease for owner until leaseUntil unless job already ran since slot
+	AcquireJobLease(ctx context.Context, name string, owner string, now int64, leaseUntil int64, slot int64) (bool, error)
+	// ReleaseJobLease releases job lease and records run status
+	ReleaseJobLease(ctx context.Context, job *dto.Job) error
+	// GetJobs gets run status of all jobs
+	GetJobs(ctx context.Context) ([]*dto.Job, error)
+}
+
+// IScheduler ...
+type IScheduler interface {
+	// Jobs lists registered jobs with their last run status
+	Jobs(ctx context.Context) ([]*dto.Job, error)
+	// Trigger runs job immediately in background, fails if another replica is running it
+	Trigger(ctx context.Context, name string) (*dto.Job, error)
+}
+
+type job struct {
+	name   string
+	cfg    JobConfig
+	spec   Spec
+	fn     func(ctx context.Context) error
+	mu     sync.Mutex
+	status dto.Job
+	// scheduled is true while schedule of job is looping
+	scheduled bool
+	// runStart is start of current run
+	runStart time.Time
+}
+
+// Scheduler runs registered jobs by their schedule
+type Scheduler struct {
+	locker ILocker
+	owner  string
+	jobs   []*job
+	// ctx is cancelled by Stop, cancelling running jobs
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewScheduler creates scheduler, owner identifies this replica in job leases
+func NewScheduler(locker ILocker, owner string) *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Scheduler{
+		locker: locker,
+		owner:  owner,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Register registers job with configuration
+func (s *Scheduler) Register(name string, cfg JobConfig, fn func(ctx context.Context) error) error {
+	spec, err := ParseSpec(cfg.Spec)
+	if err != nil {
+		return fmt.Errorf("job %s: %v", name, err)
+	}
+	if cfg.Timeout <= 0 {
+		return fmt.Errorf("job %s: timeout must be positive", name)
+	}
+	for _, j := range s.jobs {
+		if j.name == name {
+			return fmt.Errorf("job %s: already registered", name)
+		}
+	}
+	s.jobs = append(s.jobs, &job{
+		name: name,
+		cfg:  cfg,
+		spec: spec,
+		fn:   fn,
+		status: dto.Job{
+			Name:    name,
+			Spec:    cfg.Spec,
+			Timeout: cfg.Timeout.Milliseconds(),
+		},
+	})
+	return nil
+}
+
+// Start starts schedule of all enabled jobs
+func (s *Scheduler) Start() {
+	for _, j := range s.jobs {
+		if !j.cfg.Enabled {
+			continue
+		}
+		j.mu.Lock()
+		j.scheduled = true
+		j.mu.Unlock()
+		s.wg.Add(1)
+		go s.loop(j)
+	}
+}
+
+// Stop stops schedule of all jobs, cancels running jobs and waits for them to return
+func (s *Scheduler) Stop() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// Alive returns error if scheduler is stopped, schedule of an enabled job has ended or a run is stuck past its timeout
+func (s *Scheduler) Alive() error {
+	if s.ctx.Err() != nil {
+		return errors.New("scheduler is stopped")
+	}
+
+	now := time.Now()
+	for _, j := range s.jobs {
+		if !j.cfg.Enabled {
+			continue
+		}
+		j.mu.Lock()
+		scheduled, running, runStart := j.scheduled, j.status.Running, j.runStart
+		j.mu.Unlock()
+		if !scheduled {
+			return fmt.Errorf("job %s is not scheduled", j.name)
+		}
+		if running && now.Sub(runStart) > j.cfg.Timeout+leaseMargin {
+			return fmt.Errorf("job %s is running past its timeout", j.name)
+		}
+	}
+	return nil
+}
+
+// Jobs lists registered jobs with their last run status
+func (s *Scheduler) Jobs(ctx context.Context) ([]*dto.Job, error) {
+	// run status is shared by replicas through job leases
+	persisted, err := s.locker.GetJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statuses := map[string]*dto.Job{}
+	for _, p := range persisted {
+		statuses[p.Name] = p
+	}
+
+	var jobs []*dto.Job
+	for _, j := range s.jobs {
+		j.mu.Lock()
+		status := j.status
+		j.mu.Unlock()
+		if p, ok := statuses[j.name]; ok {
+			status.Owner = p.Owner
+			status.LeaseUntil = p.LeaseUntil
+			status.LastRun = p.LastRun
+			status.LastDuration = p.LastDuration
+			status.LastError = p.LastError
+			status.RunCount = p.RunCount
+		}
+		jobs = append(jobs, &status)
+	}
+	return jobs, nil
+}
+
+// Trigger runs job immediately in background, fails if another replica is running it
+func (s *Scheduler) Trigger(ctx context.Context, name string) (*dto.Job, error) {
+	for _, j := range s.jobs {
+		if j.name != name {
+			continue
+		}
+		if !s.begin(j) {
+			// already running on this replica
+			j.mu.Lock()
+			status := j.status
+			j.mu.Unlock()
+			return &status, nil
+		}
+
+		// manual run is not bound to a slot, so only a run in progress on another replica blocks it
+		start := time.Now()
+		acquired, err := s.acquire(j, start, start)
+		if err != nil || !acquired {
+			s.end(j)
+			if err != nil {
+				return nil, err
+			}
+			return nil, constants.JobLeaseHeldError
+		}
+
+		j.mu.Lock()
+		status := j.status
+		j.mu.Unlock()
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer s.end(j)
+			s.execute(j, start)
+		}()
+		return &status, nil
+	}
+	return nil, constants.JobNotFoundError
+}
+
+func (s *Scheduler) loop(j *job) {
+	defer s.wg.Done()
+	defer func() {
+		j.mu.Lock()
+		j.scheduled = false
+		j.mu.Unlock()
+	}()
+	if j.cfg.RunOnStart {
+		s.run(j, time.Now())
+	}
+	for {
+		now := time.Now()
+		slot := j.spec.Next(now)
+		if slot.IsZero() {
+			logger.Log.Error("scheduler: job has no next run", zap.String("job", j.name))
+			return
+		}
+		next := slot
+		if j.cfg.Jitter > 0 {
+			next = next.Add(time.Duration(rand.Int63n(int64(j.cfg.Jitter))))
+		}
+		j.mu.Lock()
+		j.status.NextRun = utility.TimeToMilli(next)
+		j.mu.Unlock()
+
+		timer := time.NewTimer(next.Sub(now))
+		select {
+		case <-timer.C:
+			s.run(j, slot)
+		case <-s.ctx.Done():
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// run runs job of slot unless it is running on this replica, another replica holds its lease or already ran it since slot
+func (s *Scheduler) run(j *job, slot time.Time) {
+	if !s.begin(j) {
+		return
+	}
+	defer s.end(j)
+
+	start := time.Now()
+	acquired, err := s.acquire(j, start, slot)
+	if err != nil {
+		logger.Log.Error("scheduler: failed to acquire job lease", zap.String("job", j.name), zap.String("reason", err.Error()))
+		return
+	}
+	if !acquired {
+		logger.Log.Debug("scheduler: job lease is held by another replica or job already ran", zap.String("job", j.name))
+		return
+	}
+	s.execute(j, start)
+}
+
+// begin marks job as running, returns false if it is already running on this replica
+func (s *Scheduler) begin(j *job) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.status.Running {
+		return false
+	}
+	j.status.Running = true
+	j.runStart = time.Now()
+	return true
+}
+
+// end marks job as not running
+func (s *Scheduler) end(j *job) {
+	j.mu.Lock()
+	j.status.Running = false
+	j.mu.Unlock()
+}
+
+// acquire acquires lease so that only one replica runs the job, and only once per slot
+func (s *Scheduler) acquire(j *job, start time.Time, slot time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(s.ctx, lockTimeout)
+	defer cancel()
+	return s.locker.AcquireJobLease(ctx, j.name, s.owner,
+		utility.TimeToMilli(start), utility.TimeToMilli(start.Add(j.cfg.Timeout+leaseMargin)), utility.TimeToMilli(slot))
+}
+
+// execute runs job holding its lease with timeout, then records run status and releases lease
+func (s *Scheduler) execute(j *job, start time.Time) {
+	// run job with timeout, traced so that its queries and crawler requests are grouped by run
+	ctx, cancel := context.WithTimeout(s.ctx, j.cfg.Timeout)
+	ctx, span := tracing.Tracer().Start(ctx, "job "+j.name)
+	err := j.fn(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+	cancel()
+	end := time.Now()
+
+	status := &dto.Job{
+		Name:         j.name,
+		Owner:        s.owner,
+		LeaseUntil:   utility.TimeToMilli(end),
+		LastRun:      utility.TimeToMilli(start),
+		LastDuration: end.Sub(start).Milliseconds(),
+	}
+	metrics.JobDuration.WithLabelValues(j.name).Observe(end.Sub(start).Seconds())
+	if err != nil {
+		status.LastError = err.Error()
+		metrics.JobRuns.WithLabelValues(j.name, "failure").Inc()
+		logger.Log.Error("scheduler: job failed", zap.String("job", j.name), zap.String("reason", err.Error()))
+	} else {
+		metrics.JobRuns.WithLabelValues(j.name, "success").Inc()
+		logger.Log.Info("scheduler: job completed", zap.String("job", j.name), zap.Int64("duration-ms", status.LastDuration))
+	}
+
+	j.mu.Lock()
+	j.status.Owner = status.Owner
+	j.status.LastRun = status.LastRun
+	j.status.LastDuration = status.LastDuration
+	j.status.LastError = status.LastError
+	j.status.RunCount++
+	j.mu.Unlock()
+
+	// lease is released even if scheduler is stopping, so that another replica can take over
+	lockCtx, cancel := context.WithTimeout(context.Background(), lockTimeout)
+	defer cancel()
+	if err := s.locker.ReleaseJobLease(lockCtx, status); err != nil {
+		logger.Log.Error("scheduler: failed to release job lease", zap.String("job", j.name), zap.String("reason", err.Error()))
+	}
+}
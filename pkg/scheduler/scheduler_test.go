@@ -0,0 +1,104 @@
+package scheduler
+
+import (
+	"context"
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/logger"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// TestAlive ...
+func TestAlive(t *testing.T) {
+	noop := func(ctx context.Context) error { return nil }
+	cfg := JobConfig{Spec: "@every 1h", Timeout: time.Minute, Enabled: true}
+
+	s := NewScheduler(nil, "test")
+	assert.NoError(t, s.Register("enabled", cfg, noop))
+	disabled := cfg
+	disabled.Enabled = false
+	assert.NoError(t, s.Register("disabled", disabled, noop))
+	assert.Error(t, s.Alive(), "not started, should fail")
+
+	s.Start()
+	assert.NoError(t, s.Alive(), "started, should pass")
+
+	s.jobs[0].mu.Lock()
+	s.jobs[0].status.Running = true
+	s.jobs[0].runStart = time.Now().Add(-cfg.Timeout - leaseMargin - time.Second)
+	s.jobs[0].mu.Unlock()
+	assert.Error(t, s.Alive(), "run past timeout, should fail")
+
+	s.jobs[0].mu.Lock()
+	s.jobs[0].status.Running = false
+	s.jobs[0].mu.Unlock()
+	s.Stop()
+	assert.Error(t, s.Alive(), "stopped, should fail")
+}
+
+// fakeLocker grants leases if acquired is true and records released runs
+type fakeLocker struct {
+	acquired bool
+	slots    []int64
+	released []*dto.Job
+	mu       sync.Mutex
+}
+
+func (l *fakeLocker) AcquireJobLease(ctx context.Context, name string, owner string, now int64, leaseUntil int64, slot int64) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.slots = append(l.slots, slot)
+	return l.acquired, nil
+}
+
+func (l *fakeLocker) ReleaseJobLease(ctx context.Context, job *dto.Job) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.released = append(l.released, job)
+	return nil
+}
+
+func (l *fakeLocker) GetJobs(ctx context.Context) ([]*dto.Job, error) {
+	return nil, nil
+}
+
+// TestTrigger ...
+func TestTrigger(t *testing.T) {
+	logger.Log = zap.NewNop()
+	cfg := JobConfig{Spec: "@every 1h", Timeout: time.Minute}
+	tests := []struct {
+		name          string
+		acquired      bool
+		expectedError error
+		expectedRuns  int
+	}{
+		{name: "lease acquired, should run", acquired: true, expectedRuns: 1},
+		{name: "lease held by another replica, should fail", acquired: false, expectedError: constants.JobLeaseHeldError},
+	}
+
+	for _, test := range tests {
+		locker := &fakeLocker{acquired: test.acquired}
+		s := NewScheduler(locker, "test")
+		cancelled := false
+		assert.NoError(t, s.Register("job", cfg, func(ctx context.Context) error {
+			// block until scheduler stops, Stop should cancel and wait for run
+			<-ctx.Done()
+			cancelled = true
+			return ctx.Err()
+		}))
+
+		job, err := s.Trigger(context.Background(), "job")
+		assert.Equal(t, test.expectedError, err, test.name)
+		if err == nil {
+			assert.True(t, job.Running, test.name)
+		}
+		s.Stop()
+		assert.Equal(t, test.expectedRuns, len(locker.released), test.name)
+		assert.Equal(t, test.acquired, cancelled, test.name)
+	}
+}
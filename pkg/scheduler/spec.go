@@ -0,0 +1,151 @@
+package scheduler
+
+import (
+	"fmt"
+	"galasejahtera/pkg/utility"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Spec is schedule of a job
+type Spec interface {
+	// Next returns next activation time after t
+	Next(t time.Time) time.Time
+}
+
+// intervalSpec activates job at fixed interval
+type intervalSpec struct {
+	interval time.Duration
+}
+
+// Next returns next activation time after t
+func (s *intervalSpec) Next(t time.Time) time.Time {
+	return t.Add(s.interval)
+}
+
+// cronSpec activates job by standard 5 fields cron expression in Malaysia time
+type cronSpec struct {
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar are set if day of month or day of week is *, following cron day matching rule
+	domStar, dowStar bool
+}
+
+// maxCronLookahead limits search for next activation time
+const maxCronLookahead = 366 * 24 * time.Hour
+
+// Next returns next activation time after t
+func (s *cronSpec) Next(t time.Time) time.Time {
+	t = utility.MalaysiaTime(t).Truncate(time.Minute).Add(time.Minute)
+	end := t.Add(maxCronLookahead)
+	for ; t.Before(end); t = t.Add(time.Minute) {
+		if s.match(t) {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (s *cronSpec) match(t time.Time) bool {
+	if s.minute&(1<<uint(t.Minute())) == 0 ||
+		s.hour&(1<<uint(t.Hour())) == 0 ||
+		s.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// ParseSpec parses job schedule, supports "@every <duration>", "@hourly", "@daily" and
+// 5 fields cron expression "minute hour day-of-month month day-of-week"
+func ParseSpec(spec string) (Spec, error) {
+	spec = strings.TrimSpace(spec)
+	switch {
+	case strings.HasPrefix(spec, "@every "):
+		d, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil {
+			return nil, err
+		}
+		if d < time.Second {
+			return nil, fmt.Errorf("interval %v is too short", d)
+		}
+		return &intervalSpec{interval: d}, nil
+	case spec == "@hourly":
+		spec = "0 * * * *"
+	case spec == "@daily":
+		spec = "0 0 * * *"
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q, expected 5 fields", spec)
+	}
+	s := &cronSpec{}
+	var err error
+	if s.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, err
+	}
+	if s.dom, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, err
+	}
+	if s.dow, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, err
+	}
+	// both 0 and 7 are Sunday
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domStar = strings.HasPrefix(fields[2], "*")
+	s.dowStar = strings.HasPrefix(fields[4], "*")
+	return s, nil
+}
+
+// parseCronField parses comma separated list of *, n, n-m with optional /step into bit set
+func parseCronField(field string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step in %q", field)
+			}
+			step = n
+			part = part[:i]
+		}
+
+		lo, hi := min, max
+		if part != "*" {
+			bounds := strings.SplitN(part, "-", 2)
+			n, err := strconv.Atoi(bounds[0])
+			if err != nil {
+				return 0, fmt.Errorf("invalid value in %q", field)
+			}
+			lo, hi = n, n
+			if len(bounds) == 2 {
+				if hi, err = strconv.Atoi(bounds[1]); err != nil {
+					return 0, fmt.Errorf("invalid range in %q", field)
+				}
+			} else if step > 1 {
+				hi = max
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("value out of range in %q", field)
+		}
+		for i := lo; i <= hi; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
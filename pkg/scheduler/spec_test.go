@@ -0,0 +1,63 @@
+package scheduler
+
+import (
+	"galasejahtera/pkg/utility"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseSpec ...
+func TestParseSpec(t *testing.T) {
+	tests := []struct {
+		name          string
+		spec          string
+		expectedError bool
+	}{
+		{name: "interval, should pass", spec: "@every 10m", expectedError: false},
+		{name: "interval too short, should fail", spec: "@every 10ms", expectedError: true},
+		{name: "invalid interval, should fail", spec: "@every ten minutes", expectedError: true},
+		{name: "hourly, should pass", spec: "@hourly", expectedError: false},
+		{name: "daily, should pass", spec: "@daily", expectedError: false},
+		{name: "cron with step and list, should pass", spec: "*/15 0,12 * * 1-5", expectedError: false},
+		{name: "cron with 4 fields, should fail", spec: "0 0 * *", expectedError: true},
+		{name: "minute out of range, should fail", spec: "60 * * * *", expectedError: true},
+		{name: "reversed range, should fail", spec: "0 10-5 * * *", expectedError: true},
+		{name: "zero step, should fail", spec: "*/0 * * * *", expectedError: true},
+	}
+
+	for _, test := range tests {
+		_, err := ParseSpec(test.spec)
+		assert.Equal(t, test.expectedError, err != nil, test.name)
+	}
+}
+
+// TestSpecNext ...
+func TestSpecNext(t *testing.T) {
+	// Wednesday 10:20:30 in Malaysia time
+	now := utility.MalaysiaTime(time.Date(2021, 1, 6, 2, 20, 30, 0, time.UTC))
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2021, 1, day, hour, minute, 0, 0, now.Location())
+	}
+
+	tests := []struct {
+		name           string
+		spec           string
+		expectedResult time.Time
+	}{
+		{name: "interval, should add interval", spec: "@every 6h", expectedResult: now.Add(6 * time.Hour)},
+		{name: "hourly, should return next hour", spec: "@hourly", expectedResult: at(6, 11, 0)},
+		{name: "daily, should return next midnight", spec: "@daily", expectedResult: at(7, 0, 0)},
+		{name: "every 15 minutes, should return next quarter", spec: "*/15 * * * *", expectedResult: at(6, 10, 30)},
+		{name: "weekday list, should return Friday", spec: "0 9 * * 5", expectedResult: at(8, 9, 0)},
+		{name: "sunday as 7, should return Sunday", spec: "0 9 * * 7", expectedResult: at(10, 9, 0)},
+		{name: "day of month or day of week, should return earlier match", spec: "0 9 15 * 5", expectedResult: at(8, 9, 0)},
+	}
+
+	for _, test := range tests {
+		spec, err := ParseSpec(test.spec)
+		assert.Nil(t, err, test.name)
+		assert.True(t, test.expectedResult.Equal(spec.Next(now)), test.name)
+	}
+}
@@ -0,0 +1,43 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataCarrier carries trace context in gRPC metadata
+type MetadataCarrier metadata.MD
+
+// Get ...
+func (c MetadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Set ...
+func (c MetadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+// Keys ...
+func (c MetadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Inject adds trace context of ctx to md
+func Inject(ctx context.Context, md metadata.MD) {
+	otel.GetTextMapPropagator().Inject(ctx, MetadataCarrier(md))
+}
+
+// Extract returns ctx with trace context of md, if any
+func Extract(ctx context.Context, md metadata.MD) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, MetadataCarrier(md))
+}
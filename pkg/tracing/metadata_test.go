@@ -0,0 +1,37 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+// TestPropagation ...
+func TestPropagation(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected trace.SpanContext
+	}{
+		{name: "no span, should not propagate", ctx: context.Background(), expected: trace.SpanContext{}},
+		{name: "span, should continue trace", ctx: trace.ContextWithSpanContext(context.Background(), sc), expected: sc.WithRemote(true)},
+	}
+
+	for _, test := range tests {
+		md := metadata.MD{}
+		Inject(test.ctx, md)
+		ctx := Extract(context.Background(), md)
+		assert.Equal(t, test.expected, trace.SpanContextFromContext(ctx), test.name)
+	}
+}
@@ -0,0 +1,61 @@
+package tracing
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CommandMonitor traces every MongoDB command, e.g. each query and update of a DAO call, as a child of span in
+// context of the call, for options.Client().SetMonitor
+func CommandMonitor() *event.CommandMonitor {
+	// spans of commands in flight by connection and request ID
+	var spans sync.Map
+	key := func(connectionID string, requestID int64) string {
+		return connectionID + "/" + strconv.FormatInt(requestID, 10)
+	}
+
+	end := func(connectionID string, requestID int64, failure string) {
+		k := key(connectionID, requestID)
+		s, ok := spans.Load(k)
+		if !ok {
+			return
+		}
+		spans.Delete(k)
+		span := s.(trace.Span)
+		if failure != "" {
+			span.SetStatus(codes.Error, failure)
+		}
+		span.End()
+	}
+
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, e *event.CommandStartedEvent) {
+			attrs := []attribute.KeyValue{
+				semconv.DBSystemMongoDB,
+				semconv.DBName(e.DatabaseName),
+				semconv.DBOperation(e.CommandName),
+			}
+			name := "mongo." + e.CommandName
+			// command name is keyed by collection, e.g. {"find": "users", ...}
+			if collection, ok := e.Command.Lookup(e.CommandName).StringValueOK(); ok {
+				attrs = append(attrs, semconv.DBMongoDBCollection(collection))
+				name += " " + collection
+			}
+			_, span := Tracer().Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+			spans.Store(key(e.ConnectionID, e.RequestID), span)
+		},
+		Succeeded: func(ctx context.Context, e *event.CommandSucceededEvent) {
+			end(e.ConnectionID, e.RequestID, "")
+		},
+		Failed: func(ctx context.Context, e *event.CommandFailedEvent) {
+			end(e.ConnectionID, e.RequestID, e.Failure)
+		},
+	}
+}
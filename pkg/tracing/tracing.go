@@ -0,0 +1,60 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// name is name of service and its tracer
+const name = "galasejahtera"
+
+// Tracer returns tracer of the service, spans are dropped until Init sets up an exporter
+func Tracer() trace.Tracer {
+	return otel.Tracer(name)
+}
+
+// Init sets up tracing with exporter selected by TRACING_EXPORTER, "otlp" sends spans to collector at
+// OTEL_EXPORTER_OTLP_ENDPOINT, "stdout" writes spans to stdout for local runs, tracing is disabled otherwise.
+// Sampling is configured by OTEL_TRACES_SAMPLER. Returned function flushes pending spans and stops exporter
+func Init(ctx context.Context) (func(context.Context) error, error) {
+	// propagate W3C trace context between gateway, gRPC server and upstreams even if tracing is disabled
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch os.Getenv("TRACING_EXPORTER") {
+	case "otlp":
+		exporter, err = otlptracegrpc.New(ctx)
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	default:
+		return func(context.Context) error { return nil }, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace exporter: %v", err)
+	}
+
+	// service name can be overridden by OTEL_SERVICE_NAME
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceName(name)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithHost())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %v", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
@@ -0,0 +1,34 @@
+package utility
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// GetEnvDuration gets positive duration from environment variable, returns def if it is missing or invalid
+func GetEnvDuration(key string, def time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || d <= 0 {
+		return def
+	}
+	return d
+}
+
+// GetEnvFloat gets positive number from environment variable, returns def if it is missing or invalid
+func GetEnvFloat(key string, def float64) float64 {
+	f, err := strconv.ParseFloat(os.Getenv(key), 64)
+	if err != nil || f <= 0 {
+		return def
+	}
+	return f
+}
+
+// GetEnvInt gets positive integer from environment variable, returns def if it is missing or invalid
+func GetEnvInt(key string, def int) int {
+	i, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || i <= 0 {
+		return def
+	}
+	return i
+}
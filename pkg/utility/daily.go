@@ -0,0 +1,233 @@
+package utility
+
+import (
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"sort"
+	"time"
+)
+
+// RollingAverageDays is the number of calendar days in district history rolling average window
+const RollingAverageDays = 7
+
+// FindPlace finds district or state by name in daily, state total is returned as district
+func FindPlace(daily *dto.Daily, place string) (*dto.District, bool) {
+	if daily == nil {
+		return nil, false
+	}
+	name := CanonicalPlace(place)
+	for _, state := range daily.States {
+		if CanonicalPlace(state.Name) == name {
+			return &dto.District{
+				Name:   state.Name,
+				State:  state.Name,
+				Total:  state.Total,
+				Active: state.Active,
+				Trend:  state.Trend,
+				Risk:   state.Risk,
+			}, true
+		}
+		for _, district := range state.Districts {
+			if CanonicalPlace(district.Name) == name {
+				d := *district
+				d.State = state.Name
+				return &d, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// FindDistrict finds district by state and district name in daily
+func FindDistrict(daily *dto.Daily, state string, district string) (*dto.District, bool) {
+	if daily == nil {
+		return nil, false
+	}
+	for _, st := range daily.States {
+		if CanonicalPlace(st.Name) != CanonicalPlace(state) {
+			continue
+		}
+		for _, d := range st.Districts {
+			if CanonicalPlace(d.Name) == CanonicalPlace(district) {
+				found := *d
+				found.State = st.Name
+				return &found, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// BuildDistrictHistory builds daily time series of place total from dailies,
+// the latest snapshot of each day is used
+func BuildDistrictHistory(dailies []*dto.Daily, place string) (string, []*dto.DistrictHistory) {
+	sorted := make([]*dto.Daily, len(dailies))
+	copy(sorted, dailies)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LastUpdated < sorted[j].LastUpdated
+	})
+
+	name := ""
+	var history []*dto.DistrictHistory
+	// days are start of day of history points
+	var days []time.Time
+	for _, daily := range sorted {
+		d, ok := FindPlace(daily, place)
+		if !ok {
+			continue
+		}
+		name = d.Name
+
+		// keep only the latest snapshot of the day
+		t := MilliToTime(daily.LastUpdated)
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		point := &dto.DistrictHistory{
+			LastUpdated: daily.LastUpdated,
+			Total:       d.Total,
+		}
+		if len(days) > 0 && day.Equal(days[len(days)-1]) {
+			history[len(history)-1] = point
+		} else {
+			history = append(history, point)
+			days = append(days, day)
+		}
+	}
+
+	// calculate delta against the calendar day before and rolling average of points within the last RollingAverageDays
+	// calendar days, delta is left 0 if the day before has no snapshot and days without snapshot are left out of the
+	// average
+	var sum int64
+	start := 0
+	for i, point := range history {
+		if i > 0 && days[i-1].Equal(days[i].AddDate(0, 0, -1)) {
+			point.Delta = point.Total - history[i-1].Total
+		}
+		sum += point.Total
+		from := days[i].AddDate(0, 0, 1-RollingAverageDays)
+		for days[start].Before(from) {
+			sum -= history[start].Total
+			start++
+		}
+		point.Average = float64(sum) / float64(i-start+1)
+	}
+
+	return name, history
+}
+
+// GetDistrictRisk gets risk colour by active cases within 14 days
+func GetDistrictRisk(active int64) string {
+	if active <= 0 {
+		return constants.GreenZone
+	}
+	if active > constants.RedZoneThreshold {
+		return constants.RedZone
+	}
+	return constants.YellowZone
+}
+
+// GetDistrictTrend gets trend by comparing active cases with previous active cases
+func GetDistrictTrend(active, previousActive int64) string {
+	if active > previousActive {
+		return constants.TrendUp
+	}
+	if active < previousActive {
+		return constants.TrendDown
+	}
+	return constants.TrendFlat
+}
+
+// IsClassified checks if daily states and districts have been classified
+func IsClassified(daily *dto.Daily) bool {
+	for _, state := range daily.States {
+		if state.Risk == "" {
+			return false
+		}
+	}
+	return len(daily.States) > 0
+}
+
+// ClassifyDaily sets active cases, trend and risk of every state and district in daily.
+// Active cases are total minus total of past snapshot (14 days before), trend is
+// compared with active cases of previous snapshot. Place missing from past snapshot, or
+// every place if there is none, is of unknown risk and trend, trend is also unknown if
+// place is of unknown risk in previous snapshot.
+func ClassifyDaily(daily *dto.Daily, previous *dto.Daily, past *dto.Daily) {
+	pastTotals := placeTotals(past)
+	previousActives := placeActives(previous)
+
+	classify := func(key string, total int64) (int64, string, string) {
+		pastTotal, ok := pastTotals[key]
+		if !ok {
+			return 0, constants.UnknownZone, constants.TrendUnknown
+		}
+		active := total - pastTotal
+		previousActive, ok := previousActives[key]
+		if !ok {
+			return active, GetDistrictRisk(active), constants.TrendUnknown
+		}
+		return active, GetDistrictRisk(active), GetDistrictTrend(active, previousActive)
+	}
+
+	for _, state := range daily.States {
+		key := NormalizePlace(state.Name)
+		state.Active, state.Risk, state.Trend = classify(key, state.Total)
+		for _, district := range state.Districts {
+			key := NormalizePlace(state.Name) + "/" + NormalizePlace(district.Name)
+			district.Active, district.Risk, district.Trend = classify(key, district.Total)
+		}
+	}
+}
+
+// FindClassifyingDailies finds previous (previous day, classified) and past (14 days before) snapshots
+// of daily from dailies
+func FindClassifyingDailies(daily *dto.Daily, dailies []*dto.Daily) (*dto.Daily, *dto.Daily) {
+	var previous, past *dto.Daily
+	date := TimeToDateString(MilliToTime(daily.LastUpdated))
+	pastTime := TimeToMilli(MilliToTime(daily.LastUpdated).Add(-24 * constants.ActiveCaseDays * time.Hour))
+	for _, d := range dailies {
+		if d.LastUpdated >= daily.LastUpdated {
+			continue
+		}
+		if TimeToDateString(MilliToTime(d.LastUpdated)) != date && IsClassified(d) &&
+			(previous == nil || d.LastUpdated > previous.LastUpdated) {
+			previous = d
+		}
+		if d.LastUpdated <= pastTime && (past == nil || d.LastUpdated > past.LastUpdated) {
+			past = d
+		}
+	}
+	return previous, past
+}
+
+func placeTotals(daily *dto.Daily) map[string]int64 {
+	totals := map[string]int64{}
+	if daily == nil {
+		return totals
+	}
+	for _, state := range daily.States {
+		totals[NormalizePlace(state.Name)] = state.Total
+		for _, district := range state.Districts {
+			totals[NormalizePlace(state.Name)+"/"+NormalizePlace(district.Name)] = district.Total
+		}
+	}
+	return totals
+}
+
+// placeActives are active cases of places of known risk in daily
+func placeActives(daily *dto.Daily) map[string]int64 {
+	actives := map[string]int64{}
+	if daily == nil {
+		return actives
+	}
+	for _, state := range daily.States {
+		if state.Risk != constants.UnknownZone {
+			actives[NormalizePlace(state.Name)] = state.Active
+		}
+		for _, district := range state.Districts {
+			if district.Risk != constants.UnknownZone {
+				actives[NormalizePlace(state.Name)+"/"+NormalizePlace(district.Name)] = district.Active
+			}
+		}
+	}
+	return actives
+}
@@ -0,0 +1,183 @@
+package utility
+
+import (
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func dailyAt(day int, hour int, total int64) *dto.Daily {
+	location, _ := time.LoadLocation("Asia/Kuala_Lumpur")
+	return &dto.Daily{
+		LastUpdated: TimeToMilli(time.Date(2021, 1, day, hour, 0, 0, 0, location)),
+		States: []*dto.State{
+			{
+				Name:  "Selangor",
+				Total: total * 2,
+				Districts: []*dto.District{
+					{Name: "Petaling", Total: total},
+				},
+			},
+		},
+	}
+}
+
+// TestBuildDistrictHistory ...
+func TestBuildDistrictHistory(t *testing.T) {
+	tests := []struct {
+		name            string
+		dailies         []*dto.Daily
+		place           string
+		expectedName    string
+		expectedTotals  []int64
+		expectedDeltas  []int64
+		expectedAverage []float64
+	}{
+		{
+			name:         "unknown place, should return empty history",
+			dailies:      []*dto.Daily{dailyAt(1, 10, 5)},
+			place:        "Gombak",
+			expectedName: "",
+		},
+		{
+			name:            "district, should return delta and average",
+			dailies:         []*dto.Daily{dailyAt(2, 10, 8), dailyAt(1, 10, 5), dailyAt(3, 10, 7)},
+			place:           "petaling",
+			expectedName:    "Petaling",
+			expectedTotals:  []int64{5, 8, 7},
+			expectedDeltas:  []int64{0, 3, -1},
+			expectedAverage: []float64{5, 6.5, 20.0 / 3},
+		},
+		{
+			name:            "state with multiple snapshots a day, should keep latest snapshot",
+			dailies:         []*dto.Daily{dailyAt(1, 8, 1), dailyAt(1, 20, 2), dailyAt(2, 8, 3)},
+			place:           "SELANGOR",
+			expectedName:    "Selangor",
+			expectedTotals:  []int64{4, 6},
+			expectedDeltas:  []int64{0, 2},
+			expectedAverage: []float64{4, 5},
+		},
+		{
+			name: "more than 7 days, should average last 7 days",
+			dailies: []*dto.Daily{
+				dailyAt(1, 10, 1), dailyAt(2, 10, 2), dailyAt(3, 10, 3), dailyAt(4, 10, 4),
+				dailyAt(5, 10, 5), dailyAt(6, 10, 6), dailyAt(7, 10, 7), dailyAt(8, 10, 8),
+			},
+			place:           "Petaling",
+			expectedName:    "Petaling",
+			expectedTotals:  []int64{1, 2, 3, 4, 5, 6, 7, 8},
+			expectedDeltas:  []int64{0, 1, 1, 1, 1, 1, 1, 1},
+			expectedAverage: []float64{1, 1.5, 2, 2.5, 3, 3.5, 4, 5},
+		},
+		{
+			name: "missing days, should average snapshots of last 7 calendar days and leave delta after gap 0",
+			dailies: []*dto.Daily{
+				dailyAt(1, 10, 1), dailyAt(2, 10, 2), dailyAt(3, 10, 3), dailyAt(9, 10, 9), dailyAt(10, 10, 10),
+			},
+			place:           "Petaling",
+			expectedName:    "Petaling",
+			expectedTotals:  []int64{1, 2, 3, 9, 10},
+			expectedDeltas:  []int64{0, 1, 1, 0, 1},
+			expectedAverage: []float64{1, 1.5, 2, 6, 9.5},
+		},
+	}
+
+	for _, test := range tests {
+		name, history := BuildDistrictHistory(test.dailies, test.place)
+		assert.Equal(t, test.expectedName, name, test.name)
+		assert.Equal(t, len(test.expectedTotals), len(history), test.name)
+		for i, point := range history {
+			assert.Equal(t, test.expectedTotals[i], point.Total, test.name)
+			assert.Equal(t, test.expectedDeltas[i], point.Delta, test.name)
+			assert.InDelta(t, test.expectedAverage[i], point.Average, 0.0001, test.name)
+		}
+	}
+}
+
+// TestGetDistrictRisk ...
+func TestGetDistrictRisk(t *testing.T) {
+	tests := []struct {
+		name           string
+		active         int64
+		expectedResult string
+	}{
+		{
+			name:           "no active case, should return green",
+			active:         0,
+			expectedResult: constants.GreenZone,
+		},
+		{
+			name:           "1 active case, should return yellow",
+			active:         1,
+			expectedResult: constants.YellowZone,
+		},
+		{
+			name:           "40 active cases, should return yellow",
+			active:         40,
+			expectedResult: constants.YellowZone,
+		},
+		{
+			name:           "41 active cases, should return red",
+			active:         41,
+			expectedResult: constants.RedZone,
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expectedResult, GetDistrictRisk(test.active), test.name)
+	}
+}
+
+// TestClassifyDaily ...
+func TestClassifyDaily(t *testing.T) {
+	past := dailyAt(1, 10, 10)
+	earlier := dailyAt(14, 10, 40)
+	previous := dailyAt(15, 10, 50)
+	daily := dailyAt(15, 20, 60)
+	ClassifyDaily(earlier, nil, past)
+
+	dailies := []*dto.Daily{past, earlier, previous}
+	p, q := FindClassifyingDailies(daily, dailies)
+	assert.Equal(t, earlier, p, "previous should be snapshot of previous day")
+	assert.Equal(t, past, q, "past should be snapshot 14 days before")
+
+	ClassifyDaily(daily, p, q)
+	state := daily.States[0]
+	district := state.Districts[0]
+	assert.Equal(t, int64(50), district.Active)
+	assert.Equal(t, constants.RedZone, district.Risk)
+	assert.Equal(t, constants.TrendUp, district.Trend)
+	assert.Equal(t, int64(100), state.Active)
+	assert.Equal(t, constants.RedZone, state.Risk)
+	assert.True(t, IsClassified(daily))
+}
+
+// TestClassifyDailyUnknown ...
+func TestClassifyDailyUnknown(t *testing.T) {
+	past := dailyAt(1, 10, 10)
+	past.States[0].Districts = nil
+	unclassified := dailyAt(14, 10, 40)
+	daily := dailyAt(15, 20, 60)
+
+	p, q := FindClassifyingDailies(daily, []*dto.Daily{unclassified})
+	assert.Nil(t, p, "unclassified snapshot should not be compared with")
+	assert.Nil(t, q)
+
+	ClassifyDaily(daily, p, q)
+	state := daily.States[0]
+	assert.Equal(t, int64(0), state.Active)
+	assert.Equal(t, constants.UnknownZone, state.Risk, "risk without past snapshot should be unknown")
+	assert.Equal(t, constants.TrendUnknown, state.Trend)
+	assert.True(t, IsClassified(daily))
+
+	next := dailyAt(16, 20, 70)
+	ClassifyDaily(next, daily, past)
+	state = next.States[0]
+	assert.Equal(t, int64(120), state.Active)
+	assert.Equal(t, constants.RedZone, state.Risk)
+	assert.Equal(t, constants.TrendUnknown, state.Trend, "trend against unknown previous should be unknown")
+	assert.Equal(t, constants.UnknownZone, state.Districts[0].Risk, "district missing from past snapshot should be unknown")
+}
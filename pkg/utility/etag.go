@@ -0,0 +1,30 @@
+package utility
+
+import (
+	"galasejahtera/pkg/constants"
+	"strconv"
+	"strings"
+)
+
+// ETag formats version as strong entity tag, e.g. "3"
+func ETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// ParseETag parses version of If-Match entity tag, weak tag is accepted, empty tag and "*" match any version and are
+// returned as 0
+func ParseETag(tag string) (int64, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" || tag == "*" {
+		return 0, nil
+	}
+	tag = strings.TrimPrefix(tag, "W/")
+	if len(tag) >= 2 && strings.HasPrefix(tag, `"`) && strings.HasSuffix(tag, `"`) {
+		tag = tag[1 : len(tag)-1]
+	}
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, constants.InvalidETagError
+	}
+	return version, nil
+}
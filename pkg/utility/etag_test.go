@@ -0,0 +1,34 @@
+package utility
+
+import (
+	"galasejahtera/pkg/constants"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseETag ...
+func TestParseETag(t *testing.T) {
+	tests := []struct {
+		name           string
+		tag            string
+		expectedResult int64
+		expectedError  error
+	}{
+		{name: "strong tag, should parse version", tag: `"3"`, expectedResult: 3},
+		{name: "weak tag, should parse version", tag: `W/"3"`, expectedResult: 3},
+		{name: "unquoted tag, should parse version", tag: " 12 ", expectedResult: 12},
+		{name: "any, should match any version", tag: "*", expectedResult: 0},
+		{name: "empty, should match any version", tag: "", expectedResult: 0},
+		{name: "list of tags, should be rejected", tag: `"3", "4"`, expectedError: constants.InvalidETagError},
+		{name: "zero version, should be rejected", tag: `"0"`, expectedError: constants.InvalidETagError},
+		{name: "not a version, should be rejected", tag: `"abc"`, expectedError: constants.InvalidETagError},
+	}
+
+	for _, test := range tests {
+		version, err := ParseETag(test.tag)
+		assert.Equal(t, test.expectedError, err, test.name)
+		assert.Equal(t, test.expectedResult, version, test.name)
+	}
+	assert.Equal(t, `"7"`, ETag(7))
+}
@@ -0,0 +1,16 @@
+package utility
+
+import "math"
+
+// EarthRadius is mean radius of the earth in meter
+const EarthRadius = 6378100.0
+
+// Distance returns great-circle distance in meter between two coordinates
+func Distance(lat1, long1, lat2, long2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLong := toRad(long2 - long1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLong/2)*math.Sin(dLong/2)
+	return 2 * EarthRadius * math.Asin(math.Sqrt(a))
+}
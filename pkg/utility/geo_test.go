@@ -0,0 +1,25 @@
+package utility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDistance ...
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name           string
+		lat1, long1    float64
+		lat2, long2    float64
+		expectedResult float64
+	}{
+		{name: "same point, should return 0", lat1: 3.139, long1: 101.6869, lat2: 3.139, long2: 101.6869, expectedResult: 0},
+		{name: "0.001 degree latitude, should return about 111 meter", lat1: 3.139, long1: 101.6869, lat2: 3.140, long2: 101.6869, expectedResult: 111.3},
+		{name: "Kuala Lumpur to Petaling Jaya, should return about 9.6 km", lat1: 3.139, long1: 101.6869, lat2: 3.1073, long2: 101.6067, expectedResult: 9590},
+	}
+
+	for _, test := range tests {
+		assert.InDelta(t, test.expectedResult, Distance(test.lat1, test.long1, test.lat2, test.long2), test.expectedResult*0.01+0.01, test.name)
+	}
+}
@@ -0,0 +1,141 @@
+package utility
+
+import (
+	"galasejahtera/pkg/dto"
+	"math"
+	"strings"
+)
+
+// geohashBase32 is geohash alphabet
+const geohashBase32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// MaxGeohashPrecision limits geohash length, precision 12 is already below 4 centimeter
+const MaxGeohashPrecision = 12
+
+// compassDirections are 8 compass points starting from north, clockwise
+var compassDirections = []string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"}
+
+// EncodeGeohash encodes coordinate into geohash of given precision
+func EncodeGeohash(lat, long float64, precision int) string {
+	if precision < 1 {
+		precision = 1
+	}
+	if precision > MaxGeohashPrecision {
+		precision = MaxGeohashPrecision
+	}
+
+	latRange := [2]float64{-90, 90}
+	longRange := [2]float64{-180, 180}
+	var hash strings.Builder
+	bit, ch, even := 0, 0, true
+	for hash.Len() < precision {
+		// bits alternate between longitude and latitude, starting from longitude
+		if even {
+			mid := (longRange[0] + longRange[1]) / 2
+			if long >= mid {
+				ch |= 1 << uint(4-bit)
+				longRange[0] = mid
+			} else {
+				longRange[1] = mid
+			}
+		} else {
+			mid := (latRange[0] + latRange[1]) / 2
+			if lat >= mid {
+				ch |= 1 << uint(4-bit)
+				latRange[0] = mid
+			} else {
+				latRange[1] = mid
+			}
+		}
+		even = !even
+
+		if bit < 4 {
+			bit++
+			continue
+		}
+		hash.WriteByte(geohashBase32[ch])
+		bit, ch = 0, 0
+	}
+	return hash.String()
+}
+
+// DecodeGeohash decodes geohash into its cell center and half of cell height and width in degree
+func DecodeGeohash(hash string) (lat, long, latErr, longErr float64) {
+	latRange := [2]float64{-90, 90}
+	longRange := [2]float64{-180, 180}
+	even := true
+	for _, c := range strings.ToLower(hash) {
+		ch := strings.IndexRune(geohashBase32, c)
+		if ch < 0 {
+			break
+		}
+		for bit := 4; bit >= 0; bit-- {
+			r := &latRange
+			if even {
+				r = &longRange
+			}
+			mid := (r[0] + r[1]) / 2
+			if ch&(1<<uint(bit)) != 0 {
+				r[0] = mid
+			} else {
+				r[1] = mid
+			}
+			even = !even
+		}
+	}
+	latErr = (latRange[1] - latRange[0]) / 2
+	longErr = (longRange[1] - longRange[0]) / 2
+	return latRange[0] + latErr, longRange[0] + longErr, latErr, longErr
+}
+
+// SnapLocation snaps coordinate to a random point within its geohash cell, random returns number in [0, 1)
+func SnapLocation(lat, long float64, precision int, random func() float64) (string, float64, float64) {
+	hash := EncodeGeohash(lat, long, precision)
+	centerLat, centerLong, latErr, longErr := DecodeGeohash(hash)
+	return hash,
+		centerLat + (random()*2-1)*latErr,
+		centerLong + (random()*2-1)*longErr
+}
+
+// Bearing returns initial bearing in degree from first coordinate to second, clockwise from north
+func Bearing(lat1, long1, lat2, long2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLong := toRad(long2 - long1)
+	y := math.Sin(dLong) * math.Cos(toRad(lat2))
+	x := math.Cos(toRad(lat1))*math.Sin(toRad(lat2)) - math.Sin(toRad(lat1))*math.Cos(toRad(lat2))*math.Cos(dLong)
+	return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+}
+
+// CompassDirection converts bearing in degree into one of 8 compass points
+func CompassDirection(bearing float64) string {
+	return compassDirections[int(math.Mod(bearing+22.5, 360)/45)]
+}
+
+// GroupNearbyUsers groups users by geohash cell around given coordinate, so that exact user locations are not disclosed.
+// Cells keep order of their nearest user, each cell location is a random point within the cell, while direction and
+// distance are measured to the cell center.
+func GroupNearbyUsers(lat, long float64, users []*dto.User, precision int, random func() float64) []*dto.NearbyCell {
+	var cells []*dto.NearbyCell
+	byHash := map[string]*dto.NearbyCell{}
+	for _, u := range users {
+		hash := EncodeGeohash(u.Lat, u.Long, precision)
+		if cell, ok := byHash[hash]; ok {
+			cell.Count++
+			continue
+		}
+
+		centerLat, centerLong, _, _ := DecodeGeohash(hash)
+		_, cellLat, cellLong := SnapLocation(u.Lat, u.Long, precision, random)
+		cell := &dto.NearbyCell{
+			Geohash:   hash,
+			Lat:       cellLat,
+			Long:      cellLong,
+			Count:     1,
+			Direction: CompassDirection(Bearing(lat, long, centerLat, centerLong)),
+			Distance:  math.Round(Distance(lat, long, centerLat, centerLong)/10) * 10,
+		}
+		byHash[hash] = cell
+		cells = append(cells, cell)
+	}
+	return cells
+}
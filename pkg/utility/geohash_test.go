@@ -0,0 +1,113 @@
+package utility
+
+import (
+	"galasejahtera/pkg/dto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestEncodeGeohash ...
+func TestEncodeGeohash(t *testing.T) {
+	tests := []struct {
+		name           string
+		lat, long      float64
+		precision      int
+		expectedResult string
+	}{
+		{name: "Jutland, should return u4pruydqqvj", lat: 57.64911, long: 10.40744, precision: 11, expectedResult: "u4pruydqqvj"},
+		{name: "precision 5, should return prefix", lat: 57.64911, long: 10.40744, precision: 5, expectedResult: "u4pru"},
+		{name: "precision 0, should return 1 character", lat: 57.64911, long: 10.40744, precision: 0, expectedResult: "u"},
+		{name: "south west corner, should return 0", lat: -90, long: -180, precision: 3, expectedResult: "000"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expectedResult, EncodeGeohash(test.lat, test.long, test.precision), test.name)
+	}
+}
+
+// TestDecodeGeohash ...
+func TestDecodeGeohash(t *testing.T) {
+	tests := []struct {
+		name      string
+		lat, long float64
+		precision int
+	}{
+		{name: "precision 7, should return center of cell", lat: 3.139, long: 101.6869, precision: 7},
+		{name: "precision 6, should return center of cell", lat: 3.139, long: 101.6869, precision: 6},
+		{name: "north east corner, should return center of cell", lat: 90, long: 180, precision: 3},
+	}
+
+	for _, test := range tests {
+		hash := EncodeGeohash(test.lat, test.long, test.precision)
+		lat, long, latErr, longErr := DecodeGeohash(hash)
+		assert.Equal(t, hash, EncodeGeohash(lat, long, test.precision), test.name)
+		assert.InDelta(t, test.lat, lat, latErr, test.name)
+		assert.InDelta(t, test.long, long, longErr, test.name)
+	}
+}
+
+// TestSnapLocation ...
+func TestSnapLocation(t *testing.T) {
+	tests := []struct {
+		name      string
+		lat, long float64
+		precision int
+		random    float64
+	}{
+		{name: "lowest jitter, should stay in cell", lat: 3.139, long: 101.6869, precision: 7, random: 0},
+		{name: "no jitter, should return cell center", lat: 3.139, long: 101.6869, precision: 7, random: 0.5},
+		{name: "highest jitter, should stay in cell", lat: 3.139, long: 101.6869, precision: 6, random: 0.999},
+	}
+
+	for _, test := range tests {
+		hash, lat, long := SnapLocation(test.lat, test.long, test.precision, func() float64 { return test.random })
+		assert.Equal(t, EncodeGeohash(test.lat, test.long, test.precision), hash, test.name)
+		assert.Equal(t, hash, EncodeGeohash(lat, long, test.precision), test.name)
+	}
+}
+
+// TestCompassDirection ...
+func TestCompassDirection(t *testing.T) {
+	tests := []struct {
+		name           string
+		lat, long      float64
+		expectedResult string
+	}{
+		{name: "north, should return N", lat: 3.2, long: 101.6869, expectedResult: "N"},
+		{name: "north east, should return NE", lat: 3.2, long: 101.75, expectedResult: "NE"},
+		{name: "west, should return W", lat: 3.139, long: 101.6, expectedResult: "W"},
+		{name: "south, should return S", lat: 3.0, long: 101.6869, expectedResult: "S"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expectedResult, CompassDirection(Bearing(3.139, 101.6869, test.lat, test.long)), test.name)
+	}
+}
+
+// TestGroupNearbyUsers ...
+func TestGroupNearbyUsers(t *testing.T) {
+	users := []*dto.User{
+		{ID: "a", Lat: 3.13901, Long: 101.68691},
+		{ID: "b", Lat: 3.13902, Long: 101.68692},
+		{ID: "c", Lat: 3.15, Long: 101.6869},
+	}
+
+	cells := GroupNearbyUsers(3.139, 101.6869, users, 7, func() float64 { return 0.9 })
+	assert.Len(t, cells, 2)
+	assert.Equal(t, int64(2), cells[0].Count, "users in same cell should be grouped")
+	assert.Equal(t, "N", cells[1].Direction)
+	assert.Equal(t, float64(0), float64(int64(cells[1].Distance)%10), "distance should be rounded to 10 meter")
+	for _, cell := range cells {
+		assert.Equal(t, cell.Geohash, EncodeGeohash(cell.Lat, cell.Long, 7), "cell location should stay in cell")
+		for _, u := range users {
+			assert.False(t, cell.Lat == u.Lat && cell.Long == u.Long, "user location should not be disclosed")
+		}
+	}
+
+	centered := GroupNearbyUsers(3.139, 101.6869, users, 7, func() float64 { return 0.5 })
+	for i, cell := range cells {
+		assert.NotEqual(t, centered[i].Lat, cell.Lat, "cell location should be jittered")
+		assert.Equal(t, centered[i].Distance, cell.Distance, "distance should not depend on jitter")
+	}
+}
@@ -0,0 +1,69 @@
+package utility
+
+import (
+	"encoding/json"
+	"fmt"
+	"galasejahtera/pkg/dto"
+	"io/ioutil"
+)
+
+// state and district property keys supported in GeoJSON features, GADM datasets use NAME_1 and NAME_2
+var (
+	stateProperties    = []string{"state", "NAME_1"}
+	districtProperties = []string{"district", "name", "NAME_2"}
+)
+
+type featureCollection struct {
+	Features []*feature `json:"features"`
+}
+
+type feature struct {
+	Properties map[string]interface{} `json:"properties"`
+	Geometry   *dto.Geometry          `json:"geometry"`
+}
+
+// LoadDistrictBoundaries loads district boundaries from GeoJSON feature collection file
+func LoadDistrictBoundaries(path string) ([]*dto.DistrictBoundary, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDistrictBoundaries(b)
+}
+
+// ParseDistrictBoundaries parses district boundaries from GeoJSON feature collection,
+// only Polygon and MultiPolygon features with state and district names are returned
+func ParseDistrictBoundaries(b []byte) ([]*dto.DistrictBoundary, error) {
+	collection := &featureCollection{}
+	if err := json.Unmarshal(b, collection); err != nil {
+		return nil, err
+	}
+
+	var districts []*dto.DistrictBoundary
+	for i, f := range collection.Features {
+		if f.Geometry == nil || (f.Geometry.Type != "Polygon" && f.Geometry.Type != "MultiPolygon") {
+			continue
+		}
+		state := featureProperty(f, stateProperties)
+		name := featureProperty(f, districtProperties)
+		if state == "" || name == "" {
+			return nil, fmt.Errorf("feature %d: state or district name not found", i)
+		}
+		districts = append(districts, &dto.DistrictBoundary{
+			ID:       NormalizePlace(state) + "/" + NormalizePlace(name),
+			State:    state,
+			Name:     name,
+			Geometry: f.Geometry,
+		})
+	}
+	return districts, nil
+}
+
+func featureProperty(f *feature, keys []string) string {
+	for _, key := range keys {
+		if v, ok := f.Properties[key].(string); ok && v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,64 @@
+package utility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseDistrictBoundaries ...
+func TestParseDistrictBoundaries(t *testing.T) {
+	tests := []struct {
+		name          string
+		geojson       string
+		expectedIDs   []string
+		expectedNames []string
+		expectedErr   bool
+	}{
+		{
+			name: "GADM properties, should return districts",
+			geojson: `{"type":"FeatureCollection","features":[
+				{"type":"Feature","properties":{"NAME_1":"Selangor","NAME_2":"Petaling"},
+				 "geometry":{"type":"Polygon","coordinates":[[[101.5,3.0],[101.7,3.0],[101.7,3.2],[101.5,3.0]]]}},
+				{"type":"Feature","properties":{"NAME_1":"Selangor","NAME_2":"Hulu Langat"},
+				 "geometry":{"type":"MultiPolygon","coordinates":[[[[101.7,3.0],[101.9,3.0],[101.9,3.2],[101.7,3.0]]]]}}
+			]}`,
+			expectedIDs:   []string{"SELANGOR/PETALING", "SELANGOR/HULULANGAT"},
+			expectedNames: []string{"Petaling", "Hulu Langat"},
+		},
+		{
+			name: "point feature, should be skipped",
+			geojson: `{"type":"FeatureCollection","features":[
+				{"type":"Feature","properties":{"state":"Johor","district":"Muar"},
+				 "geometry":{"type":"Point","coordinates":[102.5,2.0]}}
+			]}`,
+		},
+		{
+			name: "missing district name, should return err",
+			geojson: `{"type":"FeatureCollection","features":[
+				{"type":"Feature","properties":{"state":"Johor"},
+				 "geometry":{"type":"Polygon","coordinates":[[[102.5,2.0],[102.6,2.0],[102.6,2.1],[102.5,2.0]]]}}
+			]}`,
+			expectedErr: true,
+		},
+		{
+			name:        "invalid json, should return err",
+			geojson:     `{`,
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		districts, err := ParseDistrictBoundaries([]byte(test.geojson))
+		if test.expectedErr {
+			assert.NotNil(t, err, test.name)
+			continue
+		}
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, len(test.expectedIDs), len(districts), test.name)
+		for i, d := range districts {
+			assert.Equal(t, test.expectedIDs[i], d.ID, test.name)
+			assert.Equal(t, test.expectedNames[i], d.Name, test.name)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package utility
+
+import (
+	"galasejahtera/pkg/dto"
+	"sort"
+	"strings"
+)
+
+// MaxPlaceSuggestions is the maximum number of suggestions returned for ambiguous place
+const MaxPlaceSuggestions = 5
+
+// placeAliases maps normalized alias to normalized canonical place name
+var placeAliases = map[string]string{
+	// states and federal territories
+	"KL":                            "KUALALUMPUR",
+	"WPKL":                          "KUALALUMPUR",
+	"WPKUALALUMPUR":                 "KUALALUMPUR",
+	"WILAYAHPERSEKUTUANKUALALUMPUR": "KUALALUMPUR",
+	"FEDERALTERRITORYOFKUALALUMPUR": "KUALALUMPUR",
+	"WPPUTRAJAYA":                   "PUTRAJAYA",
+	"WILAYAHPERSEKUTUANPUTRAJAYA":   "PUTRAJAYA",
+	"FEDERALTERRITORYOFPUTRAJAYA":   "PUTRAJAYA",
+	"WPLABUAN":                      "LABUAN",
+	"WILAYAHPERSEKUTUANLABUAN":      "LABUAN",
+	"FEDERALTERRITORYOFLABUAN":      "LABUAN",
+	"PENANG":                        "PULAUPINANG",
+	"MALACCA":                       "MELAKA",
+	"NS":                            "NEGERISEMBILAN",
+	"N9":                            "NEGERISEMBILAN",
+
+	// districts
+	"JB":                   "JOHORBAHRU",
+	"JOHORBARU":            "JOHORBAHRU",
+	"JOHORBAHARU":          "JOHORBAHRU",
+	"KK":                   "KOTAKINABALU",
+	"PJ":                   "PETALING",
+	"PETALINGJAYA":         "PETALING",
+	"NORTHEASTPENANG":      "TIMURLAUT",
+	"SOUTHWESTPENANG":      "BARATDAYA",
+	"NORTHSEBERANGPERAI":   "SEBERANGPERAIUTARA",
+	"CENTRALSEBERANGPERAI": "SEBERANGPERAITENGAH",
+	"SOUTHSEBERANGPERAI":   "SEBERANGPERAISELATAN",
+	"CENTRALMELAKA":        "MELAKATENGAH",
+	"CENTRALMALACCA":       "MELAKATENGAH",
+}
+
+// placePrefixes are removed from normalized place name before matching
+var placePrefixes = []string{"WILAYAHPERSEKUTUAN", "DAERAH", "DISTRICTOF"}
+
+// CanonicalPlace normalizes place name and resolves its alias
+func CanonicalPlace(place string) string {
+	name := NormalizePlace(place)
+	if alias, ok := placeAliases[name]; ok {
+		return alias
+	}
+	for _, prefix := range placePrefixes {
+		if strings.HasPrefix(name, prefix) && name != prefix {
+			name = strings.TrimPrefix(name, prefix)
+			break
+		}
+	}
+	if alias, ok := placeAliases[name]; ok {
+		return alias
+	}
+	return name
+}
+
+// EditDistance calculates Levenshtein distance between two strings
+func EditDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(minInt(prev[j]+1, curr[j-1]+1), prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// MatchPlace matches place name against states and districts of daily.
+// Exact matches (after alias resolution) are preferred, otherwise places within
+// edit distance of a quarter of the name length are matched. It returns the
+// matched place, or nil with candidate suggestions when the name is ambiguous.
+func MatchPlace(daily *dto.Daily, place string) (*dto.PlaceMatch, []*dto.PlaceMatch) {
+	if daily == nil {
+		return nil, nil
+	}
+	query := CanonicalPlace(place)
+	if query == "" {
+		return nil, nil
+	}
+
+	var candidates []*dto.PlaceMatch
+	for _, state := range daily.States {
+		candidates = append(candidates, &dto.PlaceMatch{
+			District: &dto.District{
+				Name:   state.Name,
+				State:  state.Name,
+				Total:  state.Total,
+				Active: state.Active,
+				Trend:  state.Trend,
+				Risk:   state.Risk,
+			},
+			IsState:  true,
+			Distance: EditDistance(query, CanonicalPlace(state.Name)),
+		})
+		for _, district := range state.Districts {
+			d := *district
+			d.State = state.Name
+			candidates = append(candidates, &dto.PlaceMatch{
+				District: &d,
+				Distance: EditDistance(query, CanonicalPlace(district.Name)),
+			})
+		}
+	}
+
+	// keep candidates within threshold
+	threshold := len(query) / 4
+	var matches []*dto.PlaceMatch
+	for _, c := range candidates {
+		if c.Distance <= threshold {
+			matches = append(matches, c)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, nil
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Distance < matches[j].Distance
+	})
+
+	// best matches share the minimum distance
+	var best []*dto.PlaceMatch
+	for _, m := range matches {
+		if m.Distance == matches[0].Distance {
+			best = append(best, m)
+		}
+	}
+	if len(best) == 1 {
+		return best[0], nil
+	}
+
+	// state is preferred over its own districts of the same name, e.g. Melaka
+	if state := sameStateMatch(best); state != nil {
+		return state, nil
+	}
+
+	if len(matches) > MaxPlaceSuggestions {
+		matches = matches[:MaxPlaceSuggestions]
+	}
+	return nil, matches
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// sameStateMatch returns the state match if every other match is a district of that state
+func sameStateMatch(matches []*dto.PlaceMatch) *dto.PlaceMatch {
+	var state *dto.PlaceMatch
+	for _, m := range matches {
+		if m.IsState {
+			if state != nil {
+				return nil
+			}
+			state = m
+		}
+	}
+	if state == nil {
+		return nil
+	}
+	for _, m := range matches {
+		if !m.IsState && m.District.State != state.District.Name {
+			return nil
+		}
+	}
+	return state
+}
@@ -0,0 +1,114 @@
+package utility
+
+import (
+	"galasejahtera/pkg/dto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestEditDistance ...
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		name           string
+		a              string
+		b              string
+		expectedResult int
+	}{
+		{name: "same string, should return 0", a: "PETALING", b: "PETALING", expectedResult: 0},
+		{name: "empty string, should return length", a: "", b: "GOMBAK", expectedResult: 6},
+		{name: "one substitution, should return 1", a: "KLANG", b: "KLUNG", expectedResult: 1},
+		{name: "one insertion, should return 1", a: "SEPANG", b: "SEPPANG", expectedResult: 1},
+		{name: "kitten sitting, should return 3", a: "KITTEN", b: "SITTING", expectedResult: 3},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expectedResult, EditDistance(test.a, test.b), test.name)
+	}
+}
+
+// TestCanonicalPlace ...
+func TestCanonicalPlace(t *testing.T) {
+	tests := []struct {
+		name           string
+		place          string
+		expectedResult string
+	}{
+		{name: "KL, should return Kuala Lumpur", place: "KL", expectedResult: "KUALALUMPUR"},
+		{name: "WP Kuala Lumpur, should return Kuala Lumpur", place: "W.P. Kuala Lumpur", expectedResult: "KUALALUMPUR"},
+		{name: "Wilayah Persekutuan Labuan, should return Labuan", place: "Wilayah Persekutuan Labuan", expectedResult: "LABUAN"},
+		{name: "Penang, should return Pulau Pinang", place: "penang", expectedResult: "PULAUPINANG"},
+		{name: "Negeri Sembilan, should stay the same", place: "Negeri Sembilan", expectedResult: "NEGERISEMBILAN"},
+		{name: "Daerah Gombak, should return Gombak", place: "Daerah Gombak", expectedResult: "GOMBAK"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expectedResult, CanonicalPlace(test.place), test.name)
+	}
+}
+
+// TestMatchPlace ...
+func TestMatchPlace(t *testing.T) {
+	daily := &dto.Daily{
+		States: []*dto.State{
+			{
+				Name:  "WP Kuala Lumpur",
+				Total: 100,
+				Districts: []*dto.District{
+					{Name: "Kuala Lumpur", Total: 100},
+				},
+			},
+			{
+				Name:  "Selangor",
+				Total: 50,
+				Districts: []*dto.District{
+					{Name: "Petaling", Total: 30},
+					{Name: "Klang", Total: 20},
+				},
+			},
+			{
+				Name:  "Perak",
+				Total: 10,
+				Districts: []*dto.District{
+					{Name: "Kinta", Total: 10},
+				},
+			},
+			{
+				Name:  "Johor",
+				Total: 10,
+				Districts: []*dto.District{
+					{Name: "Kluang", Total: 10},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		place               string
+		expectedName        string
+		expectedState       string
+		expectedSuggestions int
+	}{
+		{name: "KL, should return state", place: "KL", expectedName: "WP Kuala Lumpur", expectedState: "WP Kuala Lumpur"},
+		{name: "Kuala Lumpur, should return state", place: "Kuala Lumpur", expectedName: "WP Kuala Lumpur", expectedState: "WP Kuala Lumpur"},
+		{name: "PJ, should return district with state", place: "PJ", expectedName: "Petaling", expectedState: "Selangor"},
+		{name: "typo, should return closest district", place: "Petalin", expectedName: "Petaling", expectedState: "Selangor"},
+		{name: "typo closest to one district, should return it", place: "Kluan", expectedName: "Kluang", expectedState: "Johor"},
+		{name: "ambiguous between Klang and Kluang, should return suggestions", place: "Klung", expectedSuggestions: 2},
+		{name: "unknown, should return nothing", place: "Tokyo"},
+	}
+
+	for _, test := range tests {
+		match, suggestions := MatchPlace(daily, test.place)
+		if test.expectedName == "" {
+			assert.Nil(t, match, test.name)
+			assert.Equal(t, test.expectedSuggestions, len(suggestions), test.name)
+			continue
+		}
+		if assert.NotNil(t, match, test.name) {
+			assert.Equal(t, test.expectedName, match.District.Name, test.name)
+			assert.Equal(t, test.expectedState, match.District.State, test.name)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package utility
+
+import (
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"strings"
+)
+
+// filterOperators are operators of filters, other second parts of a filter are part of its value
+var filterOperators = map[string]bool{
+	constants.EQ: true, constants.NE: true, constants.IN: true, constants.GT: true, constants.GTE: true,
+	constants.LT: true, constants.LTE: true, constants.RANGE: true, constants.PREFIX: true,
+}
+
+// ParseQuery parses filters "field:operator:value" and sorts "field:order" of list requests,
+// operator is eq if omitted, e.g. "hasSymptom:true", and order is ASC if omitted. Value takes the remainder of the
+// filter, so it may contain colons, e.g. "email:prefix:a:b" or "email:a:b"
+func ParseQuery(filters []string, sorts []string) (*dto.QueryData, error) {
+	query := &dto.QueryData{}
+	for _, f := range filters {
+		parts := strings.SplitN(f, ":", 3)
+		if len(parts) < 2 || parts[0] == "" {
+			return nil, constants.InvalidFilterError
+		}
+		filter := &dto.FilterData{Item: parts[0], Operator: constants.EQ, Value: strings.SplitN(f, ":", 2)[1]}
+		if operator := strings.ToLower(parts[1]); len(parts) == 3 && filterOperators[operator] {
+			filter.Operator = operator
+			filter.Value = parts[2]
+		}
+		query.Filters = append(query.Filters, filter)
+	}
+	for _, s := range sorts {
+		parts := strings.SplitN(s, ":", 2)
+		sort := &dto.SortData{Item: parts[0], Order: constants.ASC}
+		if len(parts) == 2 {
+			sort.Order = strings.ToUpper(parts[1])
+		}
+		if sort.Item == "" {
+			return nil, constants.InvalidSortError
+		}
+		query.Sorts = append(query.Sorts, sort)
+	}
+	return query, nil
+}
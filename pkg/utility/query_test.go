@@ -0,0 +1,58 @@
+package utility
+
+import (
+	"galasejahtera/pkg/constants"
+	"galasejahtera/pkg/dto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseQuery ...
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name           string
+		filters        []string
+		sorts          []string
+		expectedResult *dto.QueryData
+		expectedError  error
+	}{
+		{
+			name:    "filters and sorts, should parse all",
+			filters: []string{"createdAt:range:1,2", "hasSymptom:true", "userId:prefix:a:b", "userId:a:b"},
+			sorts:   []string{"createdAt:desc", "userId"},
+			expectedResult: &dto.QueryData{
+				Filters: []*dto.FilterData{
+					{Item: "createdAt", Operator: constants.RANGE, Value: "1,2"},
+					{Item: "hasSymptom", Operator: constants.EQ, Value: "true"},
+					{Item: "userId", Operator: constants.PREFIX, Value: "a:b"},
+					{Item: "userId", Operator: constants.EQ, Value: "a:b"},
+				},
+				Sorts: []*dto.SortData{
+					{Item: "createdAt", Order: constants.DESC},
+					{Item: "userId", Order: constants.ASC},
+				},
+			},
+		},
+		{
+			name:           "empty, should return empty query",
+			expectedResult: &dto.QueryData{},
+		},
+		{
+			name:          "filter without value, should return error",
+			filters:       []string{"hasSymptom"},
+			expectedError: constants.InvalidFilterError,
+		},
+		{
+			name:          "sort without field, should return error",
+			sorts:         []string{":desc"},
+			expectedError: constants.InvalidSortError,
+		},
+	}
+
+	for _, test := range tests {
+		query, err := ParseQuery(test.filters, test.sorts)
+		assert.Equal(t, test.expectedError, err, test.name)
+		assert.Equal(t, test.expectedResult, query, test.name)
+	}
+}
@@ -0,0 +1,73 @@
+package utility
+
+import (
+	"sync"
+	"time"
+)
+
+// maxIdleBuckets triggers removal of full buckets, so that limiter memory is bounded by active keys
+const maxIdleBuckets = 10000
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// RateLimiter limits requests per key with token bucket, it allows burst requests and refills at limit per interval
+type RateLimiter struct {
+	limit    float64
+	burst    float64
+	interval time.Duration
+	mu       sync.Mutex
+	buckets  map[string]*bucket
+	now      func() time.Time
+}
+
+// NewRateLimiter creates rate limiter allowing limit requests per interval with burst
+func NewRateLimiter(limit int, interval time.Duration, burst int) *RateLimiter {
+	return &RateLimiter{
+		limit:    float64(limit),
+		burst:    float64(burst),
+		interval: interval,
+		buckets:  map[string]*bucket{},
+		now:      time.Now,
+	}
+}
+
+// Allow takes a token of key, returns false if key has exceeded its limit
+func (l *RateLimiter) Allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxIdleBuckets {
+			l.prune(now)
+		}
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+
+	// refill tokens since last request
+	b.tokens += now.Sub(b.last).Seconds() / l.interval.Seconds() * l.limit
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// prune removes buckets that have refilled, they behave the same as new buckets
+func (l *RateLimiter) prune(now time.Time) {
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()/l.interval.Seconds()*l.limit >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
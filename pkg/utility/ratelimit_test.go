@@ -0,0 +1,35 @@
+package utility
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRateLimiter ...
+func TestRateLimiter(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(6, time.Minute, 2)
+	limiter.now = func() time.Time { return now }
+
+	tests := []struct {
+		name           string
+		key            string
+		elapsed        time.Duration
+		expectedResult bool
+	}{
+		{name: "first request, should allow", key: "a", expectedResult: true},
+		{name: "burst request, should allow", key: "a", expectedResult: true},
+		{name: "over burst, should deny", key: "a", expectedResult: false},
+		{name: "other key, should allow", key: "b", expectedResult: true},
+		{name: "before refill, should deny", key: "a", elapsed: 5 * time.Second, expectedResult: false},
+		{name: "after refill, should allow", key: "a", elapsed: 5 * time.Second, expectedResult: true},
+		{name: "refilled token used, should deny", key: "a", expectedResult: false},
+	}
+
+	for _, test := range tests {
+		now = now.Add(test.elapsed)
+		assert.Equal(t, test.expectedResult, limiter.Allow(test.key), test.name)
+	}
+}
@@ -0,0 +1,46 @@
+package utility
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDHeader is header and gRPC metadata key carrying request ID
+const RequestIDHeader = "x-request-id"
+
+// maxRequestIDLength is maximum length of request ID given by client
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// WithRequestID returns copy of ctx carrying request ID
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID gets request ID carried by ctx, or of incoming gRPC metadata if ctx does not carry one, empty if none
+func RequestID(ctx context.Context) string {
+	if id, ok := ctx.Value(requestIDKey{}).(string); ok {
+		return id
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(RequestIDHeader); len(ids) > 0 && ValidRequestID(ids[0]) {
+			return ids[0]
+		}
+	}
+	return ""
+}
+
+// ValidRequestID reports whether request ID given by client is short and printable, so that it is safe to log and echo
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		if c < '!' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
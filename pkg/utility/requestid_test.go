@@ -0,0 +1,29 @@
+package utility
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+// TestRequestID ...
+func TestRequestID(t *testing.T) {
+	incoming := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, "from-metadata"))
+	invalid := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, "forged\nline"))
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+	}{
+		{name: "no request ID, should be empty", ctx: context.Background(), expected: ""},
+		{name: "request ID in metadata, should be used", ctx: incoming, expected: "from-metadata"},
+		{name: "unprintable request ID in metadata, should be empty", ctx: invalid, expected: ""},
+		{name: "request ID in context, should take precedence", ctx: WithRequestID(incoming, "from-context"), expected: "from-context"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, RequestID(test.ctx), test.name)
+	}
+}
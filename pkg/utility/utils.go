@@ -2,10 +2,13 @@ package utility
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"galasejahtera/pkg/constants"
 	"galasejahtera/pkg/dto"
+	"galasejahtera/pkg/metrics"
+	"galasejahtera/pkg/tracing"
 	"github.com/PuerkitoBio/goquery"
 	"html/template"
 	"log"
@@ -43,6 +46,11 @@ func ValidateEmail(email string) bool {
 	return emailRegexp.MatchString(email)
 }
 
+// NormalizeEmail normalizes email for uniqueness and case-insensitive lookups
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
 // MalaysiaTime gets Malaysia time
 func MalaysiaTime(t time.Time) time.Time {
 	// Load required location
@@ -160,9 +168,21 @@ func GetZoneRisk(radius float64, capacity, usersWithin int64) int64 {
 	return constants.MinimumRisk
 }
 
-func CrawlStory(id string) string {
+// crawlClient is used by crawlers so that requests to upstreams are recorded in metrics and traced
+var crawlClient = &http.Client{Transport: metrics.Transport(tracing.Transport(http.DefaultTransport))}
+
+// crawl requests url, as part of trace in ctx
+func crawl(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return crawlClient.Do(req)
+}
+
+func CrawlStory(ctx context.Context, id string) string {
 	// Request the HTML page.https://www.malaysiakini.com/news/559862
-	res, err := http.Get(fmt.Sprintf("https://www.malaysiakini.com/news/%s", id))
+	res, err := crawl(ctx, fmt.Sprintf("https://www.malaysiakini.com/news/%s", id))
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -189,9 +209,9 @@ func CrawlStory(id string) string {
 	return contents
 }
 
-func CrawlDaily() *dto.Daily {
+func CrawlDaily(ctx context.Context) *dto.Daily {
 	// Request the HTML page.
-	res, err := http.Get("https://knowyourzone.xyz/api/data/covid19/latest")
+	res, err := crawl(ctx, "https://knowyourzone.xyz/api/data/covid19/latest")
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -219,9 +239,9 @@ func CrawlDaily() *dto.Daily {
 	return daily
 }
 
-func CrawlStories(page int64) []*dto.Covid {
+func CrawlStories(ctx context.Context, page int64) []*dto.Covid {
 	// Request the HTML page.
-	res, err := http.Get(fmt.Sprintf("https://www.malaysiakini.com/en/tag/covid-19?alt=json&page=%d", page))
+	res, err := crawl(ctx, fmt.Sprintf("https://www.malaysiakini.com/en/tag/covid-19?alt=json&page=%d", page))
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -255,9 +275,9 @@ func CrawlStories(page int64) []*dto.Covid {
 	return stories.Stories
 }
 
-func CrawlGeneral() *dto.General {
+func CrawlGeneral(ctx context.Context) *dto.General {
 	// Request the HTML page.
-	res, err := http.Get("https://api.coronatracker.com/v3/stats/worldometer/country?countryCode=MY")
+	res, err := crawl(ctx, "https://api.coronatracker.com/v3/stats/worldometer/country?countryCode=MY")
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -284,9 +304,9 @@ func CrawlGeneral() *dto.General {
 	return stats[0]
 }
 
-func CrawlCasesByDate(startDate string, endDate string) []*dto.Kase {
+func CrawlCasesByDate(ctx context.Context, startDate string, endDate string) []*dto.Kase {
 	// Request the HTML page.
-	res, err := http.Get(fmt.Sprintf("https://api.coronatracker.com/v5/analytics/newcases/country?countryCode=MY&startDate=%s&endDate=%s", startDate, endDate))
+	res, err := crawl(ctx, fmt.Sprintf("https://api.coronatracker.com/v5/analytics/newcases/country?countryCode=MY&startDate=%s&endDate=%s", startDate, endDate))
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -206,3 +206,20 @@ func TestRemoveZeroWidth(t *testing.T) {
 		assert.Equal(t, test.expectedResult, RemoveZeroWidth(test.text), test.name)
 	}
 }
+
+// TestNormalizeEmail ...
+func TestNormalizeEmail(t *testing.T) {
+	tests := []struct {
+		name           string
+		email          string
+		expectedResult string
+	}{
+		{name: "mixed case, should return lowercase", email: "John.Doe@Example.COM", expectedResult: "john.doe@example.com"},
+		{name: "surrounding spaces, should be trimmed", email: "  john@example.com\t", expectedResult: "john@example.com"},
+		{name: "normalized, should stay the same", email: "john@example.com", expectedResult: "john@example.com"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expectedResult, NormalizeEmail(test.email), test.name)
+	}
+}